@@ -9,6 +9,7 @@ import (
 	"github.com/ava-labs/avalanchego/api"
 	"github.com/ava-labs/avalanchego/database/rpcdb"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/consensus/snowball"
 	"github.com/ava-labs/avalanchego/utils/formatting"
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/utils/rpc"
@@ -31,6 +32,9 @@ type Client interface {
 	GetLoggerLevel(ctx context.Context, loggerName string, options ...rpc.Option) (map[string]LogAndDisplayLevels, error)
 	GetConfig(ctx context.Context, options ...rpc.Option) (interface{}, error)
 	DBGet(ctx context.Context, key []byte, options ...rpc.Option) ([]byte, error)
+	CreateSnapshot(ctx context.Context, name string, options ...rpc.Option) (*CreateSnapshotReply, error)
+	ReloadConnectionPolicy(context.Context, ...rpc.Option) error
+	SetConsensusParameters(ctx context.Context, chain string, params snowball.Parameters, adaptive bool, options ...rpc.Option) error
 }
 
 // Client implementation for the Avalanche Platform Info API Endpoint
@@ -163,3 +167,23 @@ func (c *client) DBGet(ctx context.Context, key []byte, options ...rpc.Option) (
 	}
 	return formatting.Decode(formatting.HexNC, res.Value)
 }
+
+func (c *client) CreateSnapshot(ctx context.Context, name string, options ...rpc.Option) (*CreateSnapshotReply, error) {
+	res := &CreateSnapshotReply{}
+	err := c.requester.SendRequest(ctx, "admin.createSnapshot", &CreateSnapshotArgs{
+		Name: name,
+	}, res, options...)
+	return res, err
+}
+
+func (c *client) ReloadConnectionPolicy(ctx context.Context, options ...rpc.Option) error {
+	return c.requester.SendRequest(ctx, "admin.reloadConnectionPolicy", struct{}{}, &api.EmptyReply{}, options...)
+}
+
+func (c *client) SetConsensusParameters(ctx context.Context, chain string, params snowball.Parameters, adaptive bool, options ...rpc.Option) error {
+	return c.requester.SendRequest(ctx, "admin.setConsensusParameters", &SetConsensusParametersArgs{
+		Chain:      chain,
+		Parameters: params,
+		Adaptive:   adaptive,
+	}, &api.EmptyReply{}, options...)
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/ava-labs/avalanchego/api"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/consensus/snowball"
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/utils/rpc"
 )
@@ -65,6 +66,9 @@ func (mc *mockClient) SendRequest(_ context.Context, _ string, _ interface{}, re
 	case *LoggerLevelReply:
 		response := mc.response.(*LoggerLevelReply)
 		*p = *response
+	case *CreateSnapshotReply:
+		response := mc.response.(*CreateSnapshotReply)
+		*p = *response
 	case *interface{}:
 		response := mc.response.(*interface{})
 		*p = *response
@@ -356,3 +360,41 @@ func TestGetConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateSnapshot(t *testing.T) {
+	require := require.New(t)
+
+	expectedReply := &CreateSnapshotReply{
+		Path:     "db.snapshot",
+		NumKeys:  1,
+		Checksum: ids.GenerateTestID(),
+	}
+	mockClient := client{requester: NewMockClient(expectedReply, nil)}
+	reply, err := mockClient.CreateSnapshot(context.Background(), "db.snapshot")
+	require.NoError(err)
+	require.Equal(expectedReply, reply)
+
+	mockClient = client{requester: NewMockClient(nil, errTest)}
+	_, err = mockClient.CreateSnapshot(context.Background(), "db.snapshot")
+	require.ErrorIs(err, errTest)
+}
+
+func TestReloadConnectionPolicy(t *testing.T) {
+	for _, test := range SuccessResponseTests {
+		t.Run(test.name, func(t *testing.T) {
+			mockClient := client{requester: NewMockClient(&api.EmptyReply{}, test.expectedErr)}
+			err := mockClient.ReloadConnectionPolicy(context.Background())
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
+
+func TestSetConsensusParameters(t *testing.T) {
+	for _, test := range SuccessResponseTests {
+		t.Run(test.name, func(t *testing.T) {
+			mockClient := client{requester: NewMockClient(&api.EmptyReply{}, test.expectedErr)}
+			err := mockClient.SetConsensusParameters(context.Background(), "P", snowball.DefaultParameters, true)
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
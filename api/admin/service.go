@@ -5,9 +5,13 @@ package admin
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
+	"os"
 	"path"
+	"path/filepath"
 	"sync"
+	"time"
 
 	"github.com/gorilla/rpc/v2"
 	"go.uber.org/zap"
@@ -17,7 +21,10 @@ import (
 	"github.com/ava-labs/avalanchego/chains"
 	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/database/rpcdb"
+	"github.com/ava-labs/avalanchego/database/snapshot"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/network"
+	"github.com/ava-labs/avalanchego/snow/consensus/snowball"
 	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/formatting"
@@ -25,6 +32,7 @@ import (
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/utils/perms"
 	"github.com/ava-labs/avalanchego/utils/profiler"
+	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/vms"
 	"github.com/ava-labs/avalanchego/vms/registry"
 
@@ -36,23 +44,36 @@ const (
 
 	// Name of file that stacktraces are written to
 	stacktraceFile = "stacktrace.txt"
+
+	snapshotFileExtension = ".snapshot"
 )
 
 var (
-	errAliasTooLong = errors.New("alias length is too long")
-	errNoLogLevel   = errors.New("need to specify either displayLevel or logLevel")
+	errAliasTooLong         = errors.New("alias length is too long")
+	errNoLogLevel           = errors.New("need to specify either displayLevel or logLevel")
+	errSnapshotsUnsupported = errors.New("database doesn't support snapshots")
+	errInvalidSnapshotName  = errors.New("invalid snapshot name")
+	errSnapshotInProgress   = errors.New("snapshot is already being created")
+	errNoConnectionPolicy   = errors.New("node wasn't started with a connection policy file")
 )
 
 type Config struct {
-	Log          logging.Logger
-	ProfileDir   string
-	LogFactory   logging.Factory
-	NodeConfig   interface{}
-	DB           database.Database
-	ChainManager chains.Manager
-	HTTPServer   server.PathAdderWithReadLock
-	VMRegistry   registry.VMRegistry
-	VMManager    vms.Manager
+	Log        logging.Logger
+	ProfileDir string
+	LogFactory logging.Factory
+	NodeConfig interface{}
+	DB         database.Database
+	// DBSnapshotter may be nil if the database doesn't support snapshots
+	DBSnapshotter database.Snapshotter
+	SnapshotDir   string
+	ChainManager  chains.Manager
+	HTTPServer    server.PathAdderWithReadLock
+	VMRegistry    registry.VMRegistry
+	VMManager     vms.Manager
+	Network       network.Network
+	// ConnectionPolicyFile may be empty if the node wasn't started with a
+	// connection policy file
+	ConnectionPolicyFile string
 }
 
 // Admin is the API service for node admin management
@@ -60,6 +81,9 @@ type Admin struct {
 	Config
 	lock     sync.RWMutex
 	profiler profiler.Profiler
+	// snapshotsInProgress are the names of the snapshots that are currently
+	// being written
+	snapshotsInProgress set.Set[string]
 }
 
 // NewService returns a new admin API service.
@@ -413,3 +437,132 @@ func (a *Admin) DbGet(_ *http.Request, args *DBGetArgs, reply *DBGetReply) error
 	reply.Value, err = formatting.Encode(formatting.HexNC, value)
 	return err
 }
+
+type CreateSnapshotArgs struct {
+	// Name of the snapshot file to create in the snapshot directory. If empty,
+	// a name is generated from the current time.
+	Name string `json:"name"`
+}
+
+type CreateSnapshotReply struct {
+	Path     string      `json:"path"`
+	NumKeys  json.Uint64 `json:"numKeys"`
+	Checksum ids.ID      `json:"checksum"`
+}
+
+// CreateSnapshot writes a consistent, checksummed snapshot of the node's
+// database to the snapshot directory
+func (a *Admin) CreateSnapshot(_ *http.Request, args *CreateSnapshotArgs, reply *CreateSnapshotReply) error {
+	a.Log.Debug("API called",
+		zap.String("service", "admin"),
+		zap.String("method", "createSnapshot"),
+		logging.UserString("name", args.Name),
+	)
+
+	if a.DBSnapshotter == nil {
+		return errSnapshotsUnsupported
+	}
+
+	name := args.Name
+	if name == "" {
+		name = fmt.Sprintf("snapshot-%d%s", time.Now().Unix(), snapshotFileExtension)
+	}
+	if name != filepath.Base(name) || name == "." || name == ".." {
+		return fmt.Errorf("%w: %q", errInvalidSnapshotName, args.Name)
+	}
+
+	// The lock is only held while the snapshot is taken so that other admin
+	// calls aren't blocked while the snapshot is written.
+	a.lock.Lock()
+	if a.snapshotsInProgress.Contains(name) {
+		a.lock.Unlock()
+		return fmt.Errorf("%w: %q", errSnapshotInProgress, name)
+	}
+	it, err := a.DBSnapshotter.NewSnapshotIterator()
+	if err != nil {
+		a.lock.Unlock()
+		return fmt.Errorf("couldn't create snapshot: %w", err)
+	}
+	a.snapshotsInProgress.Add(name)
+	a.lock.Unlock()
+
+	defer func() {
+		it.Release()
+
+		a.lock.Lock()
+		a.snapshotsInProgress.Remove(name)
+		a.lock.Unlock()
+	}()
+
+	snapshotPath := filepath.Join(a.SnapshotDir, name)
+	summary, err := snapshot.WriteIteratorFile(snapshotPath, it)
+	if err != nil {
+		return fmt.Errorf("couldn't create snapshot: %w", err)
+	}
+
+	reply.Path = snapshotPath
+	reply.NumKeys = json.Uint64(summary.NumKeys)
+	reply.Checksum = summary.Checksum
+	return nil
+}
+
+// ReloadConnectionPolicy re-reads the connection policy file and applies it.
+// Peers that are no longer allowed are disconnected.
+func (a *Admin) ReloadConnectionPolicy(_ *http.Request, _ *struct{}, _ *api.EmptyReply) error {
+	a.Log.Debug("API called",
+		zap.String("service", "admin"),
+		zap.String("method", "reloadConnectionPolicy"),
+	)
+
+	if a.ConnectionPolicyFile == "" {
+		return errNoConnectionPolicy
+	}
+
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	policyBytes, err := os.ReadFile(a.ConnectionPolicyFile)
+	if err != nil {
+		return fmt.Errorf("couldn't read connection policy: %w", err)
+	}
+	policy, err := network.ParseConnectionPolicy(policyBytes)
+	if err != nil {
+		return err
+	}
+
+	a.Network.SetConnectionPolicy(policy)
+	a.Log.Info("reloaded connection policy",
+		zap.String("path", a.ConnectionPolicyFile),
+	)
+	return nil
+}
+
+// SetConsensusParametersArgs are the arguments for calling
+// SetConsensusParameters
+type SetConsensusParametersArgs struct {
+	Chain      string              `json:"chain"`
+	Parameters snowball.Parameters `json:"parameters"`
+	Adaptive   bool                `json:"adaptive"`
+}
+
+// SetConsensusParameters replaces the consensus parameters of a chain until
+// the node is restarted
+func (a *Admin) SetConsensusParameters(_ *http.Request, args *SetConsensusParametersArgs, _ *api.EmptyReply) error {
+	a.Log.Debug("API called",
+		zap.String("service", "admin"),
+		zap.String("method", "setConsensusParameters"),
+		logging.UserString("chain", args.Chain),
+		zap.Reflect("parameters", args.Parameters),
+		zap.Bool("adaptive", args.Adaptive),
+	)
+
+	chainID, err := a.ChainManager.Lookup(args.Chain)
+	if err != nil {
+		return err
+	}
+
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	return a.ChainManager.SetConsensusParameters(chainID, args.Parameters, args.Adaptive)
+}
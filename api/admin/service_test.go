@@ -4,16 +4,28 @@
 package admin
 
 import (
+	"fmt"
+	"io/fs"
 	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
+	"github.com/ava-labs/avalanchego/chains"
+	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/database/snapshot"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/network"
+	"github.com/ava-labs/avalanchego/snow/consensus/snowball"
 	"github.com/ava-labs/avalanchego/utils/formatting"
+	"github.com/ava-labs/avalanchego/utils/json"
 	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/perms"
 	"github.com/ava-labs/avalanchego/vms"
 	"github.com/ava-labs/avalanchego/vms/registry"
 
@@ -167,3 +179,211 @@ func TestServiceDBGet(t *testing.T) {
 		})
 	}
 }
+
+func TestServiceCreateSnapshot(t *testing.T) {
+	db := memdb.New()
+	require.NoError(t, db.Put([]byte("hello"), []byte("world")))
+
+	tests := []struct {
+		name            string
+		snapshotter     database.Snapshotter
+		snapshotName    string
+		expectedNumKeys json.Uint64
+		expectedErr     error
+	}{
+		{
+			name:            "named snapshot",
+			snapshotter:     db,
+			snapshotName:    "db.snapshot",
+			expectedNumKeys: 1,
+		},
+		{
+			name:            "generated name",
+			snapshotter:     db,
+			expectedNumKeys: 1,
+		},
+		{
+			name:         "name escapes snapshot directory",
+			snapshotter:  db,
+			snapshotName: "../db.snapshot",
+			expectedErr:  errInvalidSnapshotName,
+		},
+		{
+			name:        "snapshots unsupported",
+			expectedErr: errSnapshotsUnsupported,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			a := &Admin{Config: Config{
+				Log:           logging.NoLog{},
+				DB:            db,
+				DBSnapshotter: test.snapshotter,
+				SnapshotDir:   t.TempDir(),
+			}}
+
+			reply := &CreateSnapshotReply{}
+			err := a.CreateSnapshot(
+				nil,
+				&CreateSnapshotArgs{
+					Name: test.snapshotName,
+				},
+				reply,
+			)
+			require.ErrorIs(err, test.expectedErr)
+			if test.expectedErr != nil {
+				return
+			}
+
+			require.Equal(a.SnapshotDir, filepath.Dir(reply.Path))
+			require.Equal(test.expectedNumKeys, reply.NumKeys)
+
+			summary, err := snapshot.VerifyFile(reply.Path)
+			require.NoError(err)
+			require.Equal(reply.Checksum, summary.Checksum)
+		})
+	}
+}
+
+// lockCheckingSnapshotter records whether [lock] was held while the snapshot
+// was being written.
+type lockCheckingSnapshotter struct {
+	database.Snapshotter
+	lock     *sync.RWMutex
+	lockHeld bool
+}
+
+func (s *lockCheckingSnapshotter) NewSnapshotIterator() (database.Iterator, error) {
+	it, err := s.Snapshotter.NewSnapshotIterator()
+	return &lockCheckingIterator{
+		Iterator:    it,
+		snapshotter: s,
+	}, err
+}
+
+type lockCheckingIterator struct {
+	database.Iterator
+	snapshotter *lockCheckingSnapshotter
+}
+
+func (it *lockCheckingIterator) Next() bool {
+	if it.snapshotter.lock.TryLock() {
+		it.snapshotter.lock.Unlock()
+	} else {
+		it.snapshotter.lockHeld = true
+	}
+	return it.Iterator.Next()
+}
+
+func TestServiceCreateSnapshotDoesNotHoldLock(t *testing.T) {
+	require := require.New(t)
+
+	db := memdb.New()
+	require.NoError(db.Put([]byte("hello"), []byte("world")))
+
+	a := &Admin{Config: Config{
+		Log:         logging.NoLog{},
+		DB:          db,
+		SnapshotDir: t.TempDir(),
+	}}
+	snapshotter := &lockCheckingSnapshotter{
+		Snapshotter: db,
+		lock:        &a.lock,
+	}
+	a.DBSnapshotter = snapshotter
+
+	reply := &CreateSnapshotReply{}
+	require.NoError(a.CreateSnapshot(nil, &CreateSnapshotArgs{}, reply))
+	require.Equal(json.Uint64(1), reply.NumKeys)
+	require.False(snapshotter.lockHeld)
+	require.Empty(a.snapshotsInProgress)
+}
+
+type testNetwork struct {
+	network.Network
+
+	policy *network.ConnectionPolicy
+}
+
+func (n *testNetwork) SetConnectionPolicy(policy *network.ConnectionPolicy) {
+	n.policy = policy
+}
+
+func TestServiceReloadConnectionPolicy(t *testing.T) {
+	require := require.New(t)
+
+	policyFile := filepath.Join(t.TempDir(), "policy.json")
+	net := &testNetwork{}
+	a := &Admin{Config: Config{
+		Log:                  logging.NoLog{},
+		Network:              net,
+		ConnectionPolicyFile: policyFile,
+	}}
+
+	// The current policy is kept if the file can't be read.
+	err := a.ReloadConnectionPolicy(nil, nil, nil)
+	require.ErrorIs(err, fs.ErrNotExist)
+	require.Nil(net.policy)
+
+	nodeID := ids.GenerateTestNodeID()
+	policy := fmt.Sprintf(`{"deniedNodeIDs": ["%s"]}`, nodeID)
+	require.NoError(os.WriteFile(policyFile, []byte(policy), perms.ReadWrite))
+	require.NoError(a.ReloadConnectionPolicy(nil, nil, nil))
+	require.NotNil(net.policy)
+	require.False(net.policy.AllowNodeID(nodeID))
+
+	a.ConnectionPolicyFile = ""
+	err = a.ReloadConnectionPolicy(nil, nil, nil)
+	require.ErrorIs(err, errNoConnectionPolicy)
+}
+
+type testChainManager struct {
+	chains.Manager
+
+	chainID  ids.ID
+	params   snowball.Parameters
+	adaptive bool
+}
+
+func (m *testChainManager) SetConsensusParameters(chainID ids.ID, params snowball.Parameters, adaptive bool) error {
+	if err := params.Verify(); err != nil {
+		return err
+	}
+	m.chainID = chainID
+	m.params = params
+	m.adaptive = adaptive
+	return nil
+}
+
+func TestServiceSetConsensusParameters(t *testing.T) {
+	require := require.New(t)
+
+	chainManager := &testChainManager{Manager: chains.TestManager}
+	a := &Admin{Config: Config{
+		Log:          logging.NoLog{},
+		ChainManager: chainManager,
+	}}
+
+	chainID := ids.GenerateTestID()
+	params := snowball.DefaultParameters
+	params.K = 5
+	params.AlphaPreference = 4
+	params.AlphaConfidence = 4
+	require.NoError(a.SetConsensusParameters(nil, &SetConsensusParametersArgs{
+		Chain:      chainID.String(),
+		Parameters: params,
+		Adaptive:   true,
+	}, nil))
+	require.Equal(chainID, chainManager.chainID)
+	require.Equal(params, chainManager.params)
+	require.True(chainManager.adaptive)
+
+	params.AlphaPreference = 1
+	err := a.SetConsensusParameters(nil, &SetConsensusParametersArgs{
+		Chain:      chainID.String(),
+		Parameters: params,
+	}, nil)
+	require.ErrorIs(err, snowball.ErrParametersInvalid)
+}
@@ -4,9 +4,12 @@
 package info
 
 import (
+	"cmp"
 	"errors"
 	"fmt"
 	"net/http"
+	"slices"
+	"time"
 
 	"github.com/gorilla/rpc/v2"
 	"go.uber.org/zap"
@@ -15,6 +18,7 @@ import (
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/network"
 	"github.com/ava-labs/avalanchego/network/peer"
+	"github.com/ava-labs/avalanchego/network/reputation"
 	"github.com/ava-labs/avalanchego/snow/networking/benchlist"
 	"github.com/ava-labs/avalanchego/snow/validators"
 	"github.com/ava-labs/avalanchego/utils/constants"
@@ -42,6 +46,7 @@ type Info struct {
 	chainManager chains.Manager
 	vmManager    vms.Manager
 	benchlist    benchlist.Manager
+	reputation   reputation.Tracker
 }
 
 type Parameters struct {
@@ -70,6 +75,7 @@ func NewService(
 	myIP ips.DynamicIPPort,
 	network network.Network,
 	benchlist benchlist.Manager,
+	reputation reputation.Tracker,
 ) (http.Handler, error) {
 	server := rpc.NewServer()
 	codec := json.NewCodec()
@@ -85,6 +91,7 @@ func NewService(
 			myIP:         myIP,
 			networking:   network,
 			benchlist:    benchlist,
+			reputation:   reputation,
 		},
 		"info",
 	)
@@ -256,6 +263,66 @@ func (i *Info) Peers(_ *http.Request, args *PeersArgs, reply *PeersReply) error
 	return nil
 }
 
+// PeerScoresArgs are the arguments for calling PeerScores
+type PeerScoresArgs struct {
+	NodeIDs []ids.NodeID `json:"nodeIDs"`
+}
+
+type PeerScore struct {
+	NodeID ids.NodeID `json:"nodeID"`
+	// Reputation score of the peer in (0, 1]
+	Score json.Float64 `json:"score"`
+	// True if the peer's score is high enough for it to be sampled
+	Sampled bool `json:"sampled"`
+	// True if the peer's score is so low that it is disconnected
+	Disconnected bool `json:"disconnected"`
+
+	// The below counts decay over time
+	Responses       json.Float64 `json:"responses"`
+	Timeouts        json.Float64 `json:"timeouts"`
+	Throttled       json.Float64 `json:"throttled"`
+	InvalidMessages json.Float64 `json:"invalidMessages"`
+	// Average bandwidth of the peer's responses, in bytes per second
+	Bandwidth   json.Float64 `json:"bandwidth"`
+	LastUpdated time.Time    `json:"lastUpdated"`
+}
+
+// PeerScoresReply are the results from calling PeerScores
+type PeerScoresReply struct {
+	Scores []PeerScore `json:"scores"`
+}
+
+// PeerScores returns the reputation scores of peers, ordered from the lowest
+// score to the highest score
+func (i *Info) PeerScores(_ *http.Request, args *PeerScoresArgs, reply *PeerScoresReply) error {
+	i.log.Debug("API called",
+		zap.String("service", "info"),
+		zap.String("method", "peerScores"),
+	)
+
+	scores := i.reputation.Scores(args.NodeIDs)
+	slices.SortStableFunc(scores, func(a, b reputation.Score) int {
+		return cmp.Compare(a.Score, b.Score)
+	})
+
+	reply.Scores = make([]PeerScore, len(scores))
+	for index, score := range scores {
+		reply.Scores[index] = PeerScore{
+			NodeID:          score.NodeID,
+			Score:           json.Float64(score.Score),
+			Sampled:         i.reputation.ShouldSample(score.NodeID),
+			Disconnected:    i.reputation.ShouldDisconnect(score.NodeID),
+			Responses:       json.Float64(score.Responses),
+			Timeouts:        json.Float64(score.Timeouts),
+			Throttled:       json.Float64(score.Throttled),
+			InvalidMessages: json.Float64(score.InvalidMessages),
+			Bandwidth:       json.Float64(score.Bandwidth),
+			LastUpdated:     score.LastUpdated,
+		}
+	}
+	return nil
+}
+
 // IsBootstrappedArgs are the arguments for calling IsBootstrapped
 type IsBootstrappedArgs struct {
 	// Alias of the chain
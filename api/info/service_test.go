@@ -6,11 +6,14 @@ package info
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
+	"github.com/ava-labs/avalanchego/database/memdb"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/network/reputation"
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/vms"
 )
@@ -94,3 +97,58 @@ func TestGetVMsGetAliasesFails(t *testing.T) {
 	err := resources.info.GetVMs(nil, nil, &reply)
 	require.ErrorIs(t, err, errTest)
 }
+
+func TestPeerScores(t *testing.T) {
+	require := require.New(t)
+
+	tracker, err := reputation.NewTracker(
+		reputation.Config{
+			HalfLife:            time.Hour,
+			SampleThreshold:     .5,
+			DisconnectThreshold: .1,
+		},
+		memdb.New(),
+	)
+	require.NoError(err)
+
+	info := &Info{
+		log:        logging.NoLog{},
+		reputation: tracker,
+	}
+
+	goodNodeID := ids.GenerateTestNodeID()
+	badNodeID := ids.GenerateTestNodeID()
+	tracker.RegisterResponse(goodNodeID)
+	tracker.RegisterBandwidth(goodNodeID, 1024)
+	tracker.RegisterInvalidMessage(badNodeID)
+	tracker.RegisterInvalidMessage(badNodeID)
+
+	reply := PeerScoresReply{}
+	require.NoError(info.PeerScores(nil, &PeerScoresArgs{}, &reply))
+	require.Len(reply.Scores, 2)
+
+	// The lowest score is first.
+	bad := reply.Scores[0]
+	require.Equal(badNodeID, bad.NodeID)
+	require.InDelta(1.0/21, float64(bad.Score), 1e-6)
+	require.InDelta(2, float64(bad.InvalidMessages), 1e-6)
+	require.False(bad.Sampled)
+	require.True(bad.Disconnected)
+
+	good := reply.Scores[1]
+	require.Equal(goodNodeID, good.NodeID)
+	require.InDelta(1, float64(good.Score), 1e-6)
+	require.InDelta(1, float64(good.Responses), 1e-6)
+	require.InDelta(1024, float64(good.Bandwidth), 1e-6)
+	require.True(good.Sampled)
+	require.False(good.Disconnected)
+
+	// Peers without a record have a perfect score.
+	unknownNodeID := ids.GenerateTestNodeID()
+	require.NoError(info.PeerScores(nil, &PeerScoresArgs{NodeIDs: []ids.NodeID{unknownNodeID}}, &reply))
+	require.Equal([]PeerScore{{
+		NodeID:  unknownNodeID,
+		Score:   1,
+		Sampled: true,
+	}}, reply.Scores)
+}
@@ -0,0 +1,42 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"os/signal"
+	"syscall"
+
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/node"
+	"github.com/ava-labs/avalanchego/utils/logging"
+)
+
+// MigrateDatabase migrates the database of the node to the database type
+// [config.DatabaseConfig.MigrateToType] without starting the node. Interrupting
+// the process stops the migration after the current batch, so that it can be
+// resumed later.
+func MigrateDatabase(config node.Config) error {
+	logFactory := logging.NewFactory(config.LoggingConfig)
+	defer logFactory.Close()
+
+	log, err := logFactory.Make("main")
+	if err != nil {
+		return fmt.Errorf("failed to initialize log: %w", err)
+	}
+	defer log.Stop()
+
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	if err := node.MigrateDatabase(ctx, config.DatabaseConfig, log); err != nil {
+		log.Error("database migration failed",
+			zap.Error(err),
+		)
+		return err
+	}
+	return nil
+}
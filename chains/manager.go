@@ -28,7 +28,9 @@ import (
 	"github.com/ava-labs/avalanchego/message"
 	"github.com/ava-labs/avalanchego/network"
 	"github.com/ava-labs/avalanchego/network/p2p"
+	"github.com/ava-labs/avalanchego/network/reputation"
 	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/snow/consensus/snowball"
 	"github.com/ava-labs/avalanchego/snow/engine/avalanche/bootstrap/queue"
 	"github.com/ava-labs/avalanchego/snow/engine/avalanche/state"
 	"github.com/ava-labs/avalanchego/snow/engine/avalanche/vertex"
@@ -94,6 +96,7 @@ var (
 	errUnknownVMType           = errors.New("the vm should have type avalanche.DAGVM or snowman.ChainVM")
 	errCreatePlatformVM        = errors.New("attempted to create a chain running the PlatformVM")
 	errNotBootstrapped         = errors.New("subnets not bootstrapped")
+	errUnknownChain            = errors.New("unknown chain")
 	errPartialSyncAsAValidator = errors.New("partial sync should not be configured for a validator")
 
 	fxs = map[ids.ID]fx.Factory{
@@ -133,6 +136,12 @@ type Manager interface {
 	// Returns true iff the chain with the given ID exists and is finished bootstrapping
 	IsBootstrapped(ids.ID) bool
 
+	// Replaces the snowman consensus parameters of the chain with the given ID.
+	// If [adaptive] is true, the parameters are scaled down while the chain's
+	// subnet has fewer than K validators. The change isn't
+	// persisted across restarts.
+	SetConsensusParameters(chainID ids.ID, params snowball.Parameters, adaptive bool) error
+
 	// Starts the chain creator with the initial platform chain parameters, must
 	// be called once.
 	StartChainCreator(platformChain ChainParameters) error
@@ -157,10 +166,11 @@ type ChainParameters struct {
 }
 
 type chain struct {
-	Name    string
-	Context *snow.ConsensusContext
-	VM      common.VM
-	Handler handler.Handler
+	Name          string
+	Context       *snow.ConsensusContext
+	VM            common.VM
+	Handler       handler.Handler
+	SnowmanEngine *smeng.Transitive
 }
 
 // ChainConfig is configuration settings for the current execution.
@@ -175,7 +185,7 @@ type ManagerConfig struct {
 	SybilProtectionEnabled bool
 	StakingTLSSigner       crypto.Signer
 	StakingTLSCert         *staking.Certificate
-	StakingBLSKey          *bls.SecretKey
+	StakingBLSKey          bls.Signer
 	TracingEnabled         bool
 	// Must not be used unless [TracingEnabled] is true as this may be nil.
 	Tracer                    trace.Tracer
@@ -227,6 +237,9 @@ type ManagerConfig struct {
 	// Tracks CPU/disk usage caused by each peer.
 	ResourceTracker timetracker.ResourceTracker
 
+	// Scores peers based on their behavior.
+	Reputation reputation.Tracker
+
 	StateSyncBeacons []ids.NodeID
 
 	ChainDataDir string
@@ -256,6 +269,9 @@ type manager struct {
 	// Key: Chain's ID
 	// Value: The chain
 	chains map[ids.ID]handler.Handler
+	// Key: Chain's ID
+	// Value: The chain's snowman consensus engine
+	snowmanEngines map[ids.ID]*smeng.Transitive
 
 	// snowman++ related interface to allow validators retrieval
 	validatorState validators.State
@@ -267,6 +283,7 @@ func New(config *ManagerConfig) Manager {
 		Aliaser:                ids.NewAliaser(),
 		ManagerConfig:          *config,
 		chains:                 make(map[ids.ID]handler.Handler),
+		snowmanEngines:         make(map[ids.ID]*smeng.Transitive),
 		chainsQueue:            buffer.NewUnboundedBlockingDeque[ChainParameters](initialQueueSize),
 		unblockChainCreatorCh:  make(chan struct{}),
 		chainCreatorShutdownCh: make(chan struct{}),
@@ -363,6 +380,7 @@ func (m *manager) createChain(chainParams ChainParameters) {
 
 	m.chainsLock.Lock()
 	m.chains[chainParams.ID] = chain.Handler
+	m.snowmanEngines[chainParams.ID] = chain.SnowmanEngine
 	m.chainsLock.Unlock()
 
 	// Associate the newly created chain with its default alias
@@ -446,7 +464,7 @@ func (m *manager) buildChain(chainParams ChainParameters, sb subnets.Subnet) (*c
 			SubnetID:  chainParams.SubnetID,
 			ChainID:   chainParams.ID,
 			NodeID:    m.NodeID,
-			PublicKey: bls.PublicFromSecretKey(m.StakingBLSKey),
+			PublicKey: m.StakingBLSKey.PublicKey(),
 
 			XChainID:    m.XChainID,
 			CChainID:    m.CChainID,
@@ -765,6 +783,7 @@ func (m *manager) createAvalancheChain(
 		ctx.Registerer,
 		set.Of(ctx.NodeID),
 		nil,
+		m.Reputation,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("error creating peer tracker: %w", err)
@@ -818,14 +837,15 @@ func (m *manager) createAvalancheChain(
 		Validators:          vdrs,
 		ConnectedValidators: connectedValidators,
 		Params:              consensusParams,
+		AdaptiveParams:      sb.Config().AdaptiveConsensusParameters,
 		Consensus:           snowmanConsensus,
 	}
-	var snowmanEngine common.Engine
-	snowmanEngine, err = smeng.New(snowmanEngineConfig)
+	transitiveEngine, err := smeng.New(snowmanEngineConfig)
 	if err != nil {
 		return nil, fmt.Errorf("error initializing snowman engine: %w", err)
 	}
 
+	var snowmanEngine common.Engine = transitiveEngine
 	if m.TracingEnabled {
 		snowmanEngine = common.TraceEngine(snowmanEngine, m.Tracer)
 	}
@@ -924,10 +944,11 @@ func (m *manager) createAvalancheChain(
 	}
 
 	return &chain{
-		Name:    chainAlias,
-		Context: ctx,
-		VM:      dagVM,
-		Handler: h,
+		Name:          chainAlias,
+		Context:       ctx,
+		VM:            dagVM,
+		Handler:       h,
+		SnowmanEngine: transitiveEngine,
 	}, nil
 }
 
@@ -1110,6 +1131,7 @@ func (m *manager) createSnowmanChain(
 		ctx.Registerer,
 		set.Of(ctx.NodeID),
 		nil,
+		m.Reputation,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("error creating peer tracker: %w", err)
@@ -1163,15 +1185,16 @@ func (m *manager) createSnowmanChain(
 		Validators:          vdrs,
 		ConnectedValidators: connectedValidators,
 		Params:              consensusParams,
+		AdaptiveParams:      sb.Config().AdaptiveConsensusParameters,
 		Consensus:           consensus,
 		PartialSync:         m.PartialSyncPrimaryNetwork && ctx.ChainID == constants.PlatformChainID,
 	}
-	var engine common.Engine
-	engine, err = smeng.New(engineConfig)
+	transitiveEngine, err := smeng.New(engineConfig)
 	if err != nil {
 		return nil, fmt.Errorf("error initializing snowman engine: %w", err)
 	}
 
+	var engine common.Engine = transitiveEngine
 	if m.TracingEnabled {
 		engine = common.TraceEngine(engine, m.Tracer)
 	}
@@ -1244,13 +1267,38 @@ func (m *manager) createSnowmanChain(
 	}
 
 	return &chain{
-		Name:    chainAlias,
-		Context: ctx,
-		VM:      vm,
-		Handler: h,
+		Name:          chainAlias,
+		Context:       ctx,
+		VM:            vm,
+		Handler:       h,
+		SnowmanEngine: transitiveEngine,
 	}, nil
 }
 
+func (m *manager) SetConsensusParameters(chainID ids.ID, params snowball.Parameters, adaptive bool) error {
+	m.chainsLock.Lock()
+	engine, exists := m.snowmanEngines[chainID]
+	m.chainsLock.Unlock()
+	if !exists {
+		return fmt.Errorf("%w: %s", errUnknownChain, chainID)
+	}
+
+	ctx := engine.Context()
+	ctx.Lock.Lock()
+	defer ctx.Lock.Unlock()
+
+	if err := engine.SetParameters(context.TODO(), params, adaptive); err != nil {
+		return err
+	}
+
+	m.Log.Info("updated consensus parameters",
+		zap.Stringer("chainID", chainID),
+		zap.Reflect("parameters", params),
+		zap.Bool("adaptive", adaptive),
+	)
+	return nil
+}
+
 func (m *manager) IsBootstrapped(id ids.ID) bool {
 	m.chainsLock.Lock()
 	chain, exists := m.chains[id]
@@ -3,7 +3,10 @@
 
 package chains
 
-import "github.com/ava-labs/avalanchego/ids"
+import (
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/consensus/snowball"
+)
 
 // TestManager implements Manager but does nothing. Always returns nil error.
 // To be used only in tests
@@ -49,6 +52,10 @@ func (testManager) IsBootstrapped(ids.ID) bool {
 	return false
 }
 
+func (testManager) SetConsensusParameters(ids.ID, snowball.Parameters, bool) error {
+	return nil
+}
+
 func (testManager) Lookup(s string) (ids.ID, error) {
 	return ids.FromString(s)
 }
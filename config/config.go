@@ -5,6 +5,7 @@ package config
 
 import (
 	"crypto/tls"
+	"crypto/x509"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
@@ -23,7 +24,9 @@ import (
 	"github.com/ava-labs/avalanchego/genesis"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/network"
+	"github.com/ava-labs/avalanchego/network/capture"
 	"github.com/ava-labs/avalanchego/network/dialer"
+	"github.com/ava-labs/avalanchego/network/reputation"
 	"github.com/ava-labs/avalanchego/network/throttling"
 	"github.com/ava-labs/avalanchego/node"
 	"github.com/ava-labs/avalanchego/snow/consensus/snowball"
@@ -31,6 +34,7 @@ import (
 	"github.com/ava-labs/avalanchego/snow/networking/router"
 	"github.com/ava-labs/avalanchego/snow/networking/tracker"
 	"github.com/ava-labs/avalanchego/staking"
+	"github.com/ava-labs/avalanchego/staking/gsigner"
 	"github.com/ava-labs/avalanchego/subnets"
 	"github.com/ava-labs/avalanchego/trace"
 	"github.com/ava-labs/avalanchego/utils/compression"
@@ -80,6 +84,10 @@ var (
 	errStakingKeyContentUnset                 = fmt.Errorf("%s key not set but %s set", StakingTLSKeyContentKey, StakingCertContentKey)
 	errStakingCertContentUnset                = fmt.Errorf("%s key set but %s not set", StakingTLSKeyContentKey, StakingCertContentKey)
 	errMissingStakingSigningKeyFile           = errors.New("missing staking signing key file")
+	errMissingStakingCertFile                 = errors.New("missing staking certificate file")
+	errStakingRPCSignerTLSIncomplete          = fmt.Errorf("%s, %s and %s must all be set to use TLS", StakingRPCSignerTLSCAFileKey, StakingRPCSignerTLSCertFileKey, StakingRPCSignerTLSKeyFileKey)
+	errInvalidStakingRPCSignerCA              = errors.New("invalid signer CA certificate")
+	errInvalidStakingRPCSignerTimeout         = fmt.Errorf("%s must be positive", StakingRPCSignerTimeoutKey)
 	errTracingEndpointEmpty                   = fmt.Errorf("%s cannot be empty", TracingEndpointKey)
 	errPluginDirNotADirectory                 = errors.New("plugin dir is not a directory")
 	errCannotReadDirectory                    = errors.New("cannot read directory")
@@ -176,8 +184,9 @@ func getHTTPConfig(v *viper.Viper) (node.HTTPConfig, error) {
 		},
 		APIConfig: node.APIConfig{
 			APIIndexerConfig: node.APIIndexerConfig{
-				IndexAPIEnabled:      v.GetBool(IndexEnabledKey),
-				IndexAllowIncomplete: v.GetBool(IndexAllowIncompleteKey),
+				IndexAPIEnabled:           v.GetBool(IndexEnabledKey),
+				IndexAllowIncomplete:      v.GetBool(IndexAllowIncompleteKey),
+				IndexSubscriptionsEnabled: v.GetBool(IndexSubscriptionsEnabledKey),
 			},
 			AdminAPIEnabled:    v.GetBool(AdminAPIEnabledKey),
 			InfoAPIEnabled:     v.GetBool(InfoAPIEnabledKey),
@@ -364,6 +373,20 @@ func getNetworkConfig(
 			InitialReconnectDelay: v.GetDuration(NetworkInitialReconnectDelayKey),
 		},
 
+		ReputationConfig: reputation.Config{
+			HalfLife:            v.GetDuration(NetworkReputationHalfLifeKey),
+			SampleThreshold:     v.GetFloat64(NetworkReputationSampleThresholdKey),
+			DisconnectThreshold: v.GetFloat64(NetworkReputationDisconnectThresholdKey),
+			UpdateFrequency:     v.GetDuration(NetworkReputationUpdateFrequencyKey),
+		},
+
+		CaptureConfig: capture.Config{
+			File:     GetExpandedArg(v, NetworkCaptureFileKey),
+			MaxSize:  int(v.GetUint(NetworkCaptureMaxSizeKey)),
+			MaxFiles: int(v.GetUint(NetworkCaptureMaxFilesKey)),
+			Compress: v.GetBool(NetworkCaptureCompressEnabledKey),
+		},
+
 		MaxClockDifference:           v.GetDuration(NetworkMaxClockDifferenceKey),
 		CompressionType:              compressionType,
 		PingFrequency:                v.GetDuration(NetworkPingFrequencyKey),
@@ -414,6 +437,26 @@ func getNetworkConfig(
 		return network.Config{}, fmt.Errorf("%s must be >= 0", NetworkReadHandshakeTimeoutKey)
 	case config.MaxClockDifference < 0:
 		return network.Config{}, fmt.Errorf("%s must be >= 0", NetworkMaxClockDifferenceKey)
+	case config.ReputationConfig.HalfLife <= 0:
+		return network.Config{}, fmt.Errorf("%s must be > 0", NetworkReputationHalfLifeKey)
+	case config.ReputationConfig.SampleThreshold < 0 || config.ReputationConfig.SampleThreshold > 1:
+		return network.Config{}, fmt.Errorf("%s must be in [0,1]", NetworkReputationSampleThresholdKey)
+	case config.ReputationConfig.DisconnectThreshold < 0 || config.ReputationConfig.DisconnectThreshold > 1:
+		return network.Config{}, fmt.Errorf("%s must be in [0,1]", NetworkReputationDisconnectThresholdKey)
+	case config.ReputationConfig.UpdateFrequency <= 0:
+		return network.Config{}, fmt.Errorf("%s must be > 0", NetworkReputationUpdateFrequencyKey)
+	}
+
+	if v.IsSet(NetworkConnectionPolicyFileKey) {
+		config.ConnectionPolicyFile = GetExpandedArg(v, NetworkConnectionPolicyFileKey)
+		policyBytes, err := os.ReadFile(config.ConnectionPolicyFile)
+		if err != nil {
+			return network.Config{}, fmt.Errorf("couldn't read %s: %w", NetworkConnectionPolicyFileKey, err)
+		}
+		config.ConnectionPolicy, err = network.ParseConnectionPolicy(policyBytes)
+		if err != nil {
+			return network.Config{}, err
+		}
 	}
 	return config, nil
 }
@@ -643,6 +686,75 @@ func getStakingTLSCert(v *viper.Viper) (tls.Certificate, error) {
 	}
 }
 
+// getStakingRPCSignerTLSConfig returns the TLS config used to connect to the
+// gRPC signer. Returns nil if TLS isn't configured.
+func getStakingRPCSignerTLSConfig(v *viper.Viper) (*tls.Config, error) {
+	var (
+		caPath   = GetExpandedArg(v, StakingRPCSignerTLSCAFileKey)
+		certPath = GetExpandedArg(v, StakingRPCSignerTLSCertFileKey)
+		keyPath  = GetExpandedArg(v, StakingRPCSignerTLSKeyFileKey)
+	)
+	switch {
+	case caPath == "" && certPath == "" && keyPath == "":
+		return nil, nil
+	case caPath == "" || certPath == "" || keyPath == "":
+		return nil, errStakingRPCSignerTLSIncomplete
+	}
+
+	caBytes, err := os.ReadFile(caPath)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't read signer CA certificate: %w", err)
+	}
+	rootCAs := x509.NewCertPool()
+	if !rootCAs.AppendCertsFromPEM(caBytes) {
+		return nil, fmt.Errorf("%w at %s", errInvalidStakingRPCSignerCA, caPath)
+	}
+
+	clientCert, err := tls.LoadX509KeyPair(certPath, keyPath)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't load signer client certificate: %w", err)
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{clientCert},
+		RootCAs:      rootCAs,
+		MinVersion:   tls.VersionTLS13,
+	}, nil
+}
+
+// getStakingRPCSignerConfig sets the settings used to connect to the gRPC
+// signer at [config.StakingRPCSignerEndpoint]. The signer isn't contacted until
+// the node starts.
+func getStakingRPCSignerConfig(v *viper.Viper, config *node.StakingConfig) error {
+	config.StakingRPCSignerTimeout = v.GetDuration(StakingRPCSignerTimeoutKey)
+	if config.StakingRPCSignerTimeout <= 0 {
+		return errInvalidStakingRPCSignerTimeout
+	}
+
+	tlsConfig, err := getStakingRPCSignerTLSConfig(v)
+	if err != nil {
+		return err
+	}
+	if err := gsigner.VerifyEndpoint(config.StakingRPCSignerEndpoint, tlsConfig != nil); err != nil {
+		return err
+	}
+	config.StakingRPCSignerTLSConfig = tlsConfig
+
+	if v.IsSet(StakingCertContentKey) {
+		config.StakingRPCSignerCert, err = base64.StdEncoding.DecodeString(v.GetString(StakingCertContentKey))
+		if err != nil {
+			return fmt.Errorf("unable to decode base64 content: %w", err)
+		}
+		return nil
+	}
+
+	certPath := GetExpandedArg(v, StakingCertPathKey)
+	config.StakingRPCSignerCert, err = os.ReadFile(certPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("%w at %s", errMissingStakingCertFile, certPath)
+	}
+	return err
+}
+
 func getStakingSigner(v *viper.Viper) (*bls.SecretKey, error) {
 	if v.GetBool(StakingEphemeralSignerEnabledKey) {
 		key, err := bls.NewSecretKey()
@@ -710,6 +822,7 @@ func getStakingConfig(v *viper.Viper, networkID uint32) (node.StakingConfig, err
 		StakingKeyPath:                GetExpandedArg(v, StakingTLSKeyPathKey),
 		StakingCertPath:               GetExpandedArg(v, StakingCertPathKey),
 		StakingSignerPath:             GetExpandedArg(v, StakingSignerKeyPathKey),
+		StakingRPCSignerEndpoint:      v.GetString(StakingRPCSignerEndpointKey),
 	}
 	if !config.SybilProtectionEnabled && config.SybilProtectionDisabledWeight == 0 {
 		return node.StakingConfig{}, errSybilProtectionDisabledStakerWeights
@@ -720,13 +833,20 @@ func getStakingConfig(v *viper.Viper, networkID uint32) (node.StakingConfig, err
 	}
 
 	var err error
-	config.StakingTLSCert, err = getStakingTLSCert(v)
-	if err != nil {
-		return node.StakingConfig{}, err
-	}
-	config.StakingSigningKey, err = getStakingSigner(v)
-	if err != nil {
-		return node.StakingConfig{}, err
+	if config.StakingRPCSignerEndpoint != "" {
+		if err := getStakingRPCSignerConfig(v, &config); err != nil {
+			return node.StakingConfig{}, err
+		}
+	} else {
+		config.StakingTLSCert, err = getStakingTLSCert(v)
+		if err != nil {
+			return node.StakingConfig{}, err
+		}
+		signingKey, err := getStakingSigner(v)
+		if err != nil {
+			return node.StakingConfig{}, err
+		}
+		config.StakingSigningKey = bls.NewLocalSigner(signingKey)
 	}
 	if networkID != constants.MainnetID && networkID != constants.FujiID {
 		config.UptimeRequirement = v.GetFloat64(UptimeRequirementKey)
@@ -845,7 +965,10 @@ func getDatabaseConfig(v *viper.Viper, networkID uint32) (node.DatabaseConfig, e
 			GetExpandedArg(v, DBPathKey),
 			constants.NetworkName(networkID),
 		),
-		Config: configBytes,
+		Config:              configBytes,
+		SnapshotDir:         GetExpandedArg(v, DBSnapshotDirKey),
+		RestoreSnapshotFile: GetExpandedArg(v, DBRestoreSnapshotFileKey),
+		MigrateToType:       v.GetString(DBMigrateToTypeKey),
 	}, nil
 }
 
@@ -1332,6 +1455,10 @@ func GetNodeConfig(v *viper.Viper) (node.Config, error) {
 
 	// Tx Fee
 	nodeConfig.StaticConfig = getTxFeeConfig(v, nodeConfig.NetworkID)
+	nodeConfig.DynamicFeeConfig = genesis.GetDynamicFeeConfig(nodeConfig.NetworkID)
+	if err := nodeConfig.DynamicFeeConfig.Verify(); err != nil {
+		return node.Config{}, fmt.Errorf("invalid dynamic fee config: %w", err)
+	}
 
 	// Genesis Data
 	genesisStakingCfg := nodeConfig.StakingConfig.StakingConfig
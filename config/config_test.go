@@ -18,7 +18,9 @@ import (
 
 	"github.com/ava-labs/avalanchego/chains"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/node"
 	"github.com/ava-labs/avalanchego/snow/consensus/snowball"
+	"github.com/ava-labs/avalanchego/staking/gsigner"
 	"github.com/ava-labs/avalanchego/subnets"
 )
 
@@ -323,6 +325,46 @@ func TestGetVMAliasesFromFlag(t *testing.T) {
 	}
 }
 
+func TestGetStakingRPCSignerValidation(t *testing.T) {
+	tests := map[string]struct {
+		setup       func(v *viper.Viper)
+		expectedErr error
+	}{
+		"plaintext non-loopback endpoint": {
+			setup: func(v *viper.Viper) {
+				v.Set(StakingRPCSignerEndpointKey, "10.0.0.1:9999")
+			},
+			expectedErr: gsigner.ErrInsecureEndpoint,
+		},
+		"incomplete tls config": {
+			setup: func(v *viper.Viper) {
+				v.Set(StakingRPCSignerEndpointKey, "10.0.0.1:9999")
+				v.Set(StakingRPCSignerTLSCAFileKey, "ca.pem")
+			},
+			expectedErr: errStakingRPCSignerTLSIncomplete,
+		},
+		"non-positive timeout": {
+			setup: func(v *viper.Viper) {
+				v.Set(StakingRPCSignerEndpointKey, "127.0.0.1:9999")
+				v.Set(StakingRPCSignerTimeoutKey, 0)
+			},
+			expectedErr: errInvalidStakingRPCSignerTimeout,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			v := setupViperFlags()
+			test.setup(v)
+
+			config := node.StakingConfig{
+				StakingRPCSignerEndpoint: v.GetString(StakingRPCSignerEndpointKey),
+			}
+			err := getStakingRPCSignerConfig(v, &config)
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
+
 func TestGetVMAliasesDefaultDir(t *testing.T) {
 	require := require.New(t)
 	root := t.TempDir()
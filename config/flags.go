@@ -42,6 +42,7 @@ var (
 	defaultDBDir                = filepath.Join(defaultUnexpandedDataDir, "db")
 	defaultLogDir               = filepath.Join(defaultUnexpandedDataDir, "logs")
 	defaultProfileDir           = filepath.Join(defaultUnexpandedDataDir, "profiles")
+	defaultDBSnapshotDir        = filepath.Join(defaultUnexpandedDataDir, "snapshots")
 	defaultStakingPath          = filepath.Join(defaultUnexpandedDataDir, "staking")
 	defaultStakingTLSKeyPath    = filepath.Join(defaultStakingPath, "staker.key")
 	defaultStakingCertPath      = filepath.Join(defaultStakingPath, "staker.crt")
@@ -114,6 +115,9 @@ func addNodeFlags(fs *pflag.FlagSet) {
 	fs.String(DBPathKey, defaultDBDir, "Path to database directory")
 	fs.String(DBConfigFileKey, "", fmt.Sprintf("Path to database config file. Ignored if %s is specified", DBConfigContentKey))
 	fs.String(DBConfigContentKey, "", "Specifies base64 encoded database config content")
+	fs.String(DBSnapshotDirKey, defaultDBSnapshotDir, "Path to the directory that database snapshots created with the admin API are written to")
+	fs.String(DBMigrateToTypeKey, "", fmt.Sprintf("If set, copies the database of type --%s into a new database of this type, verifies the copy and exits. An interrupted migration is resumed when run again", DBTypeKey))
+	fs.String(DBRestoreSnapshotFileKey, "", "Path to a database snapshot to restore on startup. The database must be empty. The snapshot is verified before it is restored. Once restored, the restore is skipped on subsequent startups")
 
 	// Logging
 	fs.String(LogsDirKey, defaultLogDir, "Logging directory for Avalanche")
@@ -153,7 +157,7 @@ func addNodeFlags(fs *pflag.FlagSet) {
 	fs.Duration(NetworkPingTimeoutKey, constants.DefaultPingPongTimeout, "Timeout value for Ping-Pong with a peer")
 	fs.Duration(NetworkPingFrequencyKey, constants.DefaultPingFrequency, "Frequency of pinging other peers")
 
-	fs.String(NetworkCompressionTypeKey, constants.DefaultNetworkCompressionType.String(), fmt.Sprintf("Compression type for outbound messages. Must be one of [%s, %s]", compression.TypeZstd, compression.TypeNone))
+	fs.String(NetworkCompressionTypeKey, constants.DefaultNetworkCompressionType.String(), fmt.Sprintf("Preferred compression type for outbound messages. Peers that don't support it use the best compression type that both nodes support. Must be one of [%s, %s, %s, %s]", compression.TypeZstd, compression.TypeZstdDict, compression.TypeSnappy, compression.TypeNone))
 
 	fs.Duration(NetworkMaxClockDifferenceKey, constants.DefaultNetworkMaxClockDifference, "Max allowed clock difference value between this node and peers")
 	// Note: The default value is set to false here because the default
@@ -161,6 +165,7 @@ func addNodeFlags(fs *pflag.FlagSet) {
 	// based on the networkID.
 	fs.Bool(NetworkAllowPrivateIPsKey, false, fmt.Sprintf("Allows the node to initiate outbound connection attempts to peers with private IPs. If the provided --%s is one of [%s, %s] the default is false. Oterhwise, the default is true", NetworkNameKey, constants.MainnetName, constants.FujiName))
 	fs.Bool(NetworkRequireValidatorToConnectKey, constants.DefaultNetworkRequireValidatorToConnect, "If true, this node will only maintain a connection with another node if this node is a validator, the other node is a validator, or the other node is a beacon")
+	fs.String(NetworkConnectionPolicyFileKey, "", "Specifies a JSON file that restricts the peers this node connects to. The file can be reloaded with the admin API")
 	fs.Uint(NetworkPeerReadBufferSizeKey, constants.DefaultNetworkPeerReadBufferSize, "Size, in bytes, of the buffer that we read peer messages into (there is one buffer per peer)")
 	fs.Uint(NetworkPeerWriteBufferSizeKey, constants.DefaultNetworkPeerWriteBufferSize, "Size, in bytes, of the buffer that we write peer messages into (there is one buffer per peer)")
 
@@ -174,6 +179,18 @@ func addNodeFlags(fs *pflag.FlagSet) {
 
 	fs.String(NetworkTLSKeyLogFileKey, "", "TLS key log file path. Should only be specified for debugging")
 
+	// Reputation
+	fs.Duration(NetworkReputationHalfLifeKey, constants.DefaultNetworkReputationHalfLife, "Amount of time it takes for the effect of a peer's behavior on its reputation score to halve")
+	fs.Float64(NetworkReputationSampleThresholdKey, constants.DefaultNetworkReputationSampleThreshold, "Minimum reputation score a peer must have to be sampled for gossip and requests. If 0, peers are always sampled")
+	fs.Float64(NetworkReputationDisconnectThresholdKey, constants.DefaultNetworkReputationDisconnectThreshold, "Reputation score below which peers are disconnected. If 0, peers are never disconnected due to their reputation score")
+	fs.Duration(NetworkReputationUpdateFrequencyKey, constants.DefaultNetworkReputationUpdateFrequency, "Frequency to persist reputation scores and disconnect peers with low scores")
+
+	// Peer message capture
+	fs.String(NetworkCaptureFileKey, "", "File to capture all the messages exchanged with peers in. If empty, messages aren't captured. Should only be specified for debugging")
+	fs.Uint(NetworkCaptureMaxSizeKey, constants.DefaultNetworkCaptureMaxSize, "The maximum file size in megabytes of the capture file before it gets rotated")
+	fs.Uint(NetworkCaptureMaxFilesKey, constants.DefaultNetworkCaptureMaxFiles, "The maximum number of rotated capture files to retain. 0 means retain all rotated capture files")
+	fs.Bool(NetworkCaptureCompressEnabledKey, constants.DefaultNetworkCaptureCompressEnabled, "Enables the compression of rotated capture files through gzip")
+
 	// Benchlist
 	fs.Int(BenchlistFailThresholdKey, constants.DefaultBenchlistFailThreshold, "Number of consecutive failed queries before benchlisting a node")
 	fs.Duration(BenchlistDurationKey, constants.DefaultBenchlistDuration, "Max amount of time a peer is benchlisted after surpassing the threshold")
@@ -248,6 +265,11 @@ func addNodeFlags(fs *pflag.FlagSet) {
 	fs.Bool(StakingEphemeralSignerEnabledKey, false, "If true, the node uses an ephemeral staking signer key")
 	fs.String(StakingSignerKeyPathKey, defaultStakingSignerKeyPath, fmt.Sprintf("Path to the signer private key for staking. Ignored if %s is specified", StakingSignerKeyContentKey))
 	fs.String(StakingSignerKeyContentKey, "", "Specifies base64 encoded signer private key for staking")
+	fs.String(StakingRPCSignerEndpointKey, "", fmt.Sprintf("Address of a gRPC signer that holds the staking TLS and signer private keys. If specified, the private keys are never loaded by the node and only the TLS certificate is read from %s or %s. Either a unix socket, formatted as unix:///path/to/socket, or a host:port address. Unless %s is specified, host:port addresses must be loopback addresses", StakingCertPathKey, StakingCertContentKey, StakingRPCSignerTLSCAFileKey))
+	fs.Duration(StakingRPCSignerTimeoutKey, 10*time.Second, "Timeout for requests to the gRPC staking signer")
+	fs.String(StakingRPCSignerTLSCAFileKey, "", fmt.Sprintf("Path to the PEM encoded CA certificate used to authenticate the gRPC staking signer. If specified, %s and %s must also be specified", StakingRPCSignerTLSCertFileKey, StakingRPCSignerTLSKeyFileKey))
+	fs.String(StakingRPCSignerTLSCertFileKey, "", "Path to the PEM encoded client certificate used to authenticate to the gRPC staking signer")
+	fs.String(StakingRPCSignerTLSKeyFileKey, "", "Path to the PEM encoded client private key used to authenticate to the gRPC staking signer")
 	fs.Bool(SybilProtectionEnabledKey, true, "Enables sybil protection. If enabled, Network TLS is required")
 	fs.Uint64(SybilProtectionDisabledWeightKey, 100, "Weight to provide to each peer when sybil protection is disabled")
 	fs.Bool(PartialSyncPrimaryNetworkKey, false, "Only sync the P-chain on the Primary Network. If the node is a Primary Network validator, it will report unhealthy")
@@ -308,6 +330,7 @@ func addNodeFlags(fs *pflag.FlagSet) {
 	// Indexer
 	fs.Bool(IndexEnabledKey, false, "If true, index all accepted containers and transactions and expose them via an API")
 	fs.Bool(IndexAllowIncompleteKey, false, "If true, allow running the node in such a way that could cause an index to miss transactions. Ignored if index is disabled")
+	fs.Bool(IndexSubscriptionsEnabledKey, false, "If true, stream accepted containers and chain status changes of every chain over a websocket at /ext/index/subscribe")
 
 	// Config Directories
 	fs.String(ChainConfigDirKey, defaultChainConfigDir, fmt.Sprintf("Chain specific configurations parent directory. Ignored if %s is specified", ChainConfigContentKey))
@@ -43,6 +43,9 @@ const (
 	DBPathKey                        = "db-dir"
 	DBConfigFileKey                  = "db-config-file"
 	DBConfigContentKey               = "db-config-file-content"
+	DBSnapshotDirKey                 = "db-snapshot-dir"
+	DBRestoreSnapshotFileKey         = "db-restore-snapshot-file"
+	DBMigrateToTypeKey               = "db-migrate-to-type"
 	PublicIPKey                      = "public-ip"
 	PublicIPResolutionFreqKey        = "public-ip-resolution-frequency"
 	PublicIPResolutionServiceKey     = "public-ip-resolution-service"
@@ -76,6 +79,11 @@ const (
 	StakingEphemeralSignerEnabledKey                   = "staking-ephemeral-signer-enabled"
 	StakingSignerKeyPathKey                            = "staking-signer-key-file"
 	StakingSignerKeyContentKey                         = "staking-signer-key-file-content"
+	StakingRPCSignerEndpointKey                        = "staking-rpc-signer-endpoint"
+	StakingRPCSignerTimeoutKey                         = "staking-rpc-signer-timeout"
+	StakingRPCSignerTLSCAFileKey                       = "staking-rpc-signer-tls-ca-file"
+	StakingRPCSignerTLSCertFileKey                     = "staking-rpc-signer-tls-cert-file"
+	StakingRPCSignerTLSKeyFileKey                      = "staking-rpc-signer-tls-key-file"
 	SybilProtectionEnabledKey                          = "sybil-protection-enabled"
 	SybilProtectionDisabledWeightKey                   = "sybil-protection-disabled-weight"
 	NetworkInitialTimeoutKey                           = "network-initial-timeout"
@@ -102,6 +110,7 @@ const (
 	NetworkMaxClockDifferenceKey                       = "network-max-clock-difference"
 	NetworkAllowPrivateIPsKey                          = "network-allow-private-ips"
 	NetworkRequireValidatorToConnectKey                = "network-require-validator-to-connect"
+	NetworkConnectionPolicyFileKey                     = "network-connection-policy-file"
 	NetworkPeerReadBufferSizeKey                       = "network-peer-read-buffer-size"
 	NetworkPeerWriteBufferSizeKey                      = "network-peer-write-buffer-size"
 	NetworkTCPProxyEnabledKey                          = "network-tcp-proxy-enabled"
@@ -111,6 +120,14 @@ const (
 	NetworkInboundThrottlerMaxConnsPerSecKey           = "network-inbound-connection-throttling-max-conns-per-sec"
 	NetworkOutboundConnectionThrottlingRpsKey          = "network-outbound-connection-throttling-rps"
 	NetworkOutboundConnectionTimeoutKey                = "network-outbound-connection-timeout"
+	NetworkReputationHalfLifeKey                       = "network-reputation-half-life"
+	NetworkReputationSampleThresholdKey                = "network-reputation-sample-threshold"
+	NetworkReputationDisconnectThresholdKey            = "network-reputation-disconnect-threshold"
+	NetworkReputationUpdateFrequencyKey                = "network-reputation-update-frequency"
+	NetworkCaptureFileKey                              = "network-capture-file"
+	NetworkCaptureMaxSizeKey                           = "network-capture-max-size"
+	NetworkCaptureMaxFilesKey                          = "network-capture-max-files"
+	NetworkCaptureCompressEnabledKey                   = "network-capture-compress-enabled"
 	BenchlistFailThresholdKey                          = "benchlist-fail-threshold"
 	BenchlistDurationKey                               = "benchlist-duration"
 	BenchlistMinFailingDurationKey                     = "benchlist-min-failing-duration"
@@ -147,6 +164,7 @@ const (
 	FdLimitKey                                         = "fd-limit"
 	IndexEnabledKey                                    = "index-enabled"
 	IndexAllowIncompleteKey                            = "index-allow-incomplete"
+	IndexSubscriptionsEnabledKey                       = "index-subscriptions-enabled"
 	RouterHealthMaxDropRateKey                         = "router-health-max-drop-rate"
 	RouterHealthMaxOutstandingRequestsKey              = "router-health-max-outstanding-requests"
 	HealthCheckFreqKey                                 = "health-check-frequency"
@@ -47,6 +47,15 @@ type Iterator interface {
 	Release()
 }
 
+// Snapshotter is implemented by databases that can iterate over a consistent
+// view of their contents while they are concurrently being written to.
+type Snapshotter interface {
+	// NewSnapshotIterator creates an iterator over the entire keyspace of the
+	// database as of the time of the call. Writes that happen after the call
+	// returns are not reported by the iterator.
+	NewSnapshotIterator() (Iterator, error)
+}
+
 // Iteratee wraps the NewIterator methods of a backing data store.
 type Iteratee interface {
 	// NewIterator creates an iterator over the entire keyspace contained within
@@ -63,9 +63,10 @@ const (
 )
 
 var (
-	_ database.Database = (*Database)(nil)
-	_ database.Batch    = (*batch)(nil)
-	_ database.Iterator = (*iter)(nil)
+	_ database.Database    = (*Database)(nil)
+	_ database.Snapshotter = (*Database)(nil)
+	_ database.Batch       = (*batch)(nil)
+	_ database.Iterator    = (*iter)(nil)
 
 	ErrInvalidConfig = errors.New("invalid config")
 	ErrCouldNotOpen  = errors.New("could not open")
@@ -337,6 +338,20 @@ func (db *Database) NewIteratorWithStartAndPrefix(start, prefix []byte) database
 	}
 }
 
+// NewSnapshotIterator creates a lexicographically ordered iterator over a
+// point-in-time snapshot of the database
+func (db *Database) NewSnapshotIterator() (database.Iterator, error) {
+	snapshot, err := db.DB.GetSnapshot()
+	if err != nil {
+		return nil, updateError(err)
+	}
+	return &iter{
+		db:       db,
+		Iterator: snapshot.NewIterator(new(util.Range), nil),
+		snapshot: snapshot,
+	}, nil
+}
+
 // This comment is basically copy pasted from the underlying levelDB library:
 
 // Compact the underlying DB for the given key range.
@@ -443,6 +458,10 @@ type iter struct {
 	db *Database
 	iterator.Iterator
 
+	// snapshot is only set if the iterator was created by
+	// [Database.NewSnapshotIterator].
+	snapshot *leveldb.Snapshot
+
 	key, val []byte
 	err      error
 }
@@ -482,6 +501,13 @@ func (it *iter) Value() []byte {
 	return it.val
 }
 
+func (it *iter) Release() {
+	it.Iterator.Release()
+	if it.snapshot != nil {
+		it.snapshot.Release()
+	}
+}
+
 func updateError(err error) error {
 	switch err {
 	case leveldb.ErrClosed:
@@ -35,6 +35,12 @@ func newDB(t testing.TB) database.Database {
 	return db
 }
 
+func TestSnapshotIterator(t *testing.T) {
+	db := newDB(t)
+	database.TestSnapshotIterator(t, db)
+	_ = db.Close()
+}
+
 func FuzzKeyValue(f *testing.F) {
 	db := newDB(f)
 	defer db.Close()
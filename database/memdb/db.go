@@ -21,9 +21,10 @@ const (
 )
 
 var (
-	_ database.Database = (*Database)(nil)
-	_ database.Batch    = (*batch)(nil)
-	_ database.Iterator = (*iterator)(nil)
+	_ database.Database    = (*Database)(nil)
+	_ database.Snapshotter = (*Database)(nil)
+	_ database.Batch       = (*batch)(nil)
+	_ database.Iterator    = (*iterator)(nil)
 )
 
 // Database is an ephemeral key-value store that implements the Database
@@ -154,6 +155,16 @@ func (db *Database) NewIteratorWithStartAndPrefix(start, prefix []byte) database
 	}
 }
 
+// NewSnapshotIterator returns an iterator over the current contents of the
+// database. The contents are captured when the iterator is created, so later
+// writes are never reported.
+func (db *Database) NewSnapshotIterator() (database.Iterator, error) {
+	if db.isClosed() {
+		return nil, database.ErrClosed
+	}
+	return db.NewIterator(), nil
+}
+
 func (db *Database) Compact(_, _ []byte) error {
 	db.lock.RLock()
 	defer db.lock.RUnlock()
@@ -18,6 +18,10 @@ func TestInterface(t *testing.T) {
 	}
 }
 
+func TestSnapshotIterator(t *testing.T) {
+	database.TestSnapshotIterator(t, New())
+}
+
 func FuzzKeyValue(f *testing.F) {
 	database.FuzzKeyValue(f, New())
 }
@@ -0,0 +1,99 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package migrate
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"slices"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+)
+
+// checksumCheckFrequency is the number of keys that are hashed between checks
+// for cancellation.
+const checksumCheckFrequency = 1 << 16
+
+// Checksums returns the SHA-256 hash of all the key-value pairs in [db],
+// grouped by the first [prefixLen] bytes of each key. Keys that are shorter
+// than [prefixLen] are grouped by the entire key.
+func Checksums(ctx context.Context, db database.Iteratee, prefixLen int) (map[string]ids.ID, error) {
+	var (
+		it      = db.NewIterator()
+		hashers = make(map[string]hash.Hash)
+		lenBuf  [binary.MaxVarintLen64]byte
+		numKeys int
+	)
+	defer it.Release()
+
+	for it.Next() {
+		key := it.Key()
+		if bytes.Equal(key, progressKey) {
+			continue
+		}
+
+		prefix := string(key[:min(len(key), prefixLen)])
+		hasher, ok := hashers[prefix]
+		if !ok {
+			hasher = sha256.New()
+			hashers[prefix] = hasher
+		}
+		for _, b := range [][]byte{key, it.Value()} {
+			n := binary.PutUvarint(lenBuf[:], uint64(len(b)))
+			_, _ = hasher.Write(lenBuf[:n])
+			_, _ = hasher.Write(b)
+		}
+
+		numKeys++
+		if numKeys%checksumCheckFrequency == 0 {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := it.Error(); err != nil {
+		return nil, err
+	}
+
+	checksums := make(map[string]ids.ID, len(hashers))
+	for prefix, hasher := range hashers {
+		checksums[prefix] = ids.ID(hasher.Sum(nil))
+	}
+	return checksums, nil
+}
+
+// Verify returns an error if [src] and [dst] do not contain the same key-value
+// pairs. If they do, the number of distinct key prefixes is returned.
+func Verify(ctx context.Context, prefixLen int, src, dst database.Iteratee) (int, error) {
+	srcChecksums, err := Checksums(ctx, src, prefixLen)
+	if err != nil {
+		return 0, fmt.Errorf("couldn't compute source checksums: %w", err)
+	}
+	dstChecksums, err := Checksums(ctx, dst, prefixLen)
+	if err != nil {
+		return 0, fmt.Errorf("couldn't compute destination checksums: %w", err)
+	}
+
+	var mismatched [][]byte
+	for prefix, srcChecksum := range srcChecksums {
+		if dstChecksum, ok := dstChecksums[prefix]; !ok || dstChecksum != srcChecksum {
+			mismatched = append(mismatched, []byte(prefix))
+		}
+	}
+	for prefix := range dstChecksums {
+		if _, ok := srcChecksums[prefix]; !ok {
+			mismatched = append(mismatched, []byte(prefix))
+		}
+	}
+	if len(mismatched) > 0 {
+		slices.SortFunc(mismatched, bytes.Compare)
+		return 0, fmt.Errorf("%w: %d prefixes differ, starting with %x", ErrChecksumMismatch, len(mismatched), mismatched[0])
+	}
+	return len(srcChecksums), nil
+}
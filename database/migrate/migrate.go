@@ -0,0 +1,236 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package migrate copies the full contents of one [database.Database] into
+// another, for example to move a node from leveldb to pebble without
+// re-bootstrapping.
+package migrate
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/units"
+)
+
+const (
+	// statusCopying is recorded in the progress marker, along with the last
+	// copied key, while keys are still being copied.
+	statusCopying byte = iota
+	// statusCopied is recorded in the progress marker once every key has been
+	// copied, but before the copy has been verified.
+	statusCopied
+)
+
+var (
+	// progressKey is written into the destination database to allow an
+	// interrupted migration to be resumed. It is removed once the migration
+	// has been verified.
+	progressKey = []byte("\x00avalanchego-database-migration-progress")
+
+	DefaultConfig = Config{
+		BatchSize:    4 * units.MiB,
+		PrefixLen:    1,
+		LogFrequency: 30 * time.Second,
+	}
+
+	ErrDestinationNotEmpty = errors.New("destination database is not empty")
+	ErrChecksumMismatch    = errors.New("checksum mismatch")
+
+	errReservedKey     = errors.New("source database contains the reserved migration progress key")
+	errInvalidProgress = errors.New("invalid migration progress")
+)
+
+type Config struct {
+	// BatchSize is the approximate number of bytes that are written to the
+	// destination database in a single batch.
+	BatchSize int `json:"batchSize"`
+	// PrefixLen is the number of leading key bytes that are used to group keys
+	// when verifying the migration.
+	PrefixLen int `json:"prefixLen"`
+	// LogFrequency is how often progress is reported.
+	LogFrequency time.Duration `json:"logFrequency"`
+}
+
+// Summary describes a completed migration.
+type Summary struct {
+	// NumKeys and NumBytes only include the keys copied by this invocation, so
+	// they are lower than the size of the database if the migration was
+	// resumed.
+	NumKeys  uint64 `json:"numKeys"`
+	NumBytes uint64 `json:"numBytes"`
+	Resumed  bool   `json:"resumed"`
+	// NumPrefixes is the number of distinct key prefixes that were verified.
+	NumPrefixes int `json:"numPrefixes"`
+}
+
+// Migrate copies every key-value pair of [src] into [dst] and then verifies
+// that both databases have identical per-prefix checksums.
+//
+// If a previous call was interrupted, for example by cancelling [ctx] or
+// killing the process, calling Migrate again with the same databases resumes
+// from the last written batch. [dst] must otherwise be empty and [src] must not
+// be modified while the migration is in progress.
+func Migrate(
+	ctx context.Context,
+	log logging.Logger,
+	config Config,
+	src database.Iteratee,
+	dst database.Database,
+) (Summary, error) {
+	status, lastKey, err := getProgress(dst)
+	if err != nil {
+		return Summary{}, err
+	}
+
+	summary := Summary{
+		Resumed: status != statusCopying || lastKey != nil,
+	}
+	if status == statusCopying {
+		if err := migrateKeys(ctx, log, config, src, dst, lastKey, &summary); err != nil {
+			return Summary{}, err
+		}
+	}
+
+	log.Info("verifying database migration",
+		zap.Int("prefixLen", config.PrefixLen),
+	)
+	summary.NumPrefixes, err = Verify(ctx, config.PrefixLen, src, dst)
+	if err != nil {
+		return Summary{}, err
+	}
+	return summary, dst.Delete(progressKey)
+}
+
+// getProgress returns the status of a previous migration into [dst], if any.
+// If the status is [statusCopying], the returned key is the last key that was
+// copied, or nil if no keys have been copied.
+func getProgress(dst database.Database) (byte, []byte, error) {
+	progress, err := dst.Get(progressKey)
+	if err == database.ErrNotFound {
+		isEmpty, err := database.IsEmpty(dst)
+		if err != nil {
+			return 0, nil, err
+		}
+		if !isEmpty {
+			return 0, nil, ErrDestinationNotEmpty
+		}
+		return statusCopying, nil, nil
+	}
+	if err != nil {
+		return 0, nil, err
+	}
+
+	switch {
+	case len(progress) == 0:
+		return 0, nil, errInvalidProgress
+	case progress[0] == statusCopying:
+		return statusCopying, progress[1:], nil
+	case progress[0] == statusCopied && len(progress) == 1:
+		return statusCopied, nil, nil
+	default:
+		return 0, nil, fmt.Errorf("%w: %x", errInvalidProgress, progress)
+	}
+}
+
+// migrateKeys copies every key after [lastKey] from [src] into [dst]. The
+// progress marker is written atomically with every batch.
+func migrateKeys(
+	ctx context.Context,
+	log logging.Logger,
+	config Config,
+	src database.Iteratee,
+	dst database.Database,
+	lastKey []byte,
+	summary *Summary,
+) error {
+	var (
+		startTime  = time.Now()
+		nextUpdate = startTime.Add(config.LogFrequency)
+		batch      = dst.NewBatch()
+		it         = src.NewIteratorWithStart(lastKey)
+	)
+	defer it.Release()
+
+	if lastKey != nil {
+		log.Info("resuming database migration",
+			zap.Binary("lastKey", lastKey),
+		)
+	} else {
+		log.Info("starting database migration")
+	}
+
+	for it.Next() {
+		key := it.Key()
+		if lastKey != nil && bytes.Equal(key, lastKey) {
+			continue
+		}
+		if bytes.Equal(key, progressKey) {
+			return errReservedKey
+		}
+
+		value := it.Value()
+		if err := batch.Put(key, value); err != nil {
+			return err
+		}
+		summary.NumKeys++
+		summary.NumBytes += uint64(len(key) + len(value))
+
+		if batch.Size() < config.BatchSize {
+			continue
+		}
+		if err := writeBatch(batch, statusCopying, key); err != nil {
+			return err
+		}
+		batch.Reset()
+
+		// Cancellation is only checked after a batch has been written, so
+		// that the migration can always be resumed from the progress marker.
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		now := time.Now()
+		if now.After(nextUpdate) {
+			nextUpdate = now.Add(config.LogFrequency)
+
+			// Keys aren't uniformly distributed, so the position of [key] in
+			// the key space can't be used to estimate the remaining time.
+			log.Info("migrating database",
+				zap.Uint64("numKeys", summary.NumKeys),
+				zap.Uint64("numBytes", summary.NumBytes),
+				zap.Duration("elapsed", now.Sub(startTime)),
+			)
+		}
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+	if err := writeBatch(batch, statusCopied, nil); err != nil {
+		return err
+	}
+
+	log.Info("copied database",
+		zap.Uint64("numKeys", summary.NumKeys),
+		zap.Uint64("numBytes", summary.NumBytes),
+		zap.Duration("duration", time.Since(startTime)),
+	)
+	return nil
+}
+
+func writeBatch(batch database.Batch, status byte, lastKey []byte) error {
+	progress := make([]byte, 1+len(lastKey))
+	progress[0] = status
+	copy(progress[1:], lastKey)
+	if err := batch.Put(progressKey, progress); err != nil {
+		return err
+	}
+	return batch.Write()
+}
@@ -0,0 +1,160 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package migrate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/logging"
+)
+
+func newTestDB(t *testing.T, numKeys int) *memdb.Database {
+	db := memdb.New()
+	for i := 0; i < numKeys; i++ {
+		require.NoError(t, db.Put(utils.RandomBytes(32), utils.RandomBytes(32)))
+	}
+	return db
+}
+
+func requireDatabasesEqual(t *testing.T, expected, actual database.Database) {
+	require := require.New(t)
+
+	expectedIt := expected.NewIterator()
+	defer expectedIt.Release()
+	actualIt := actual.NewIterator()
+	defer actualIt.Release()
+
+	for expectedIt.Next() {
+		require.True(actualIt.Next())
+		require.Equal(expectedIt.Key(), actualIt.Key())
+		require.Equal(expectedIt.Value(), actualIt.Value())
+	}
+	require.False(actualIt.Next())
+	require.NoError(expectedIt.Error())
+	require.NoError(actualIt.Error())
+}
+
+func TestMigrate(t *testing.T) {
+	require := require.New(t)
+
+	src := newTestDB(t, 1000)
+	dst := memdb.New()
+
+	summary, err := Migrate(context.Background(), logging.NoLog{}, DefaultConfig, src, dst)
+	require.NoError(err)
+	require.Equal(uint64(1000), summary.NumKeys)
+	require.Equal(uint64(1000*64), summary.NumBytes)
+	require.False(summary.Resumed)
+	require.Positive(summary.NumPrefixes)
+
+	requireDatabasesEqual(t, src, dst)
+}
+
+func TestMigrateResume(t *testing.T) {
+	require := require.New(t)
+
+	src := newTestDB(t, 1000)
+	dst := memdb.New()
+
+	config := DefaultConfig
+	config.BatchSize = 1
+
+	// Cancelling the context interrupts the migration after the first batch
+	// is written.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := Migrate(ctx, logging.NoLog{}, config, src, dst)
+	require.ErrorIs(err, context.Canceled)
+
+	has, err := dst.Has(progressKey)
+	require.NoError(err)
+	require.True(has)
+
+	summary, err := Migrate(context.Background(), logging.NoLog{}, config, src, dst)
+	require.NoError(err)
+	require.Equal(uint64(999), summary.NumKeys)
+	require.True(summary.Resumed)
+
+	requireDatabasesEqual(t, src, dst)
+}
+
+func TestMigrateDestinationNotEmpty(t *testing.T) {
+	src := newTestDB(t, 1)
+	dst := newTestDB(t, 1)
+
+	_, err := Migrate(context.Background(), logging.NoLog{}, DefaultConfig, src, dst)
+	require.ErrorIs(t, err, ErrDestinationNotEmpty)
+}
+
+func TestMigrateReservedKey(t *testing.T) {
+	src := newTestDB(t, 1)
+	require.NoError(t, src.Put(progressKey, nil))
+
+	_, err := Migrate(context.Background(), logging.NoLog{}, DefaultConfig, src, memdb.New())
+	require.ErrorIs(t, err, errReservedKey)
+}
+
+func TestVerify(t *testing.T) {
+	tests := []struct {
+		name        string
+		modify      func(db database.Database) error
+		expectedErr error
+	}{
+		{
+			name: "equal",
+			modify: func(database.Database) error {
+				return nil
+			},
+		},
+		{
+			name: "modified value",
+			modify: func(db database.Database) error {
+				return db.Put([]byte{0x01}, []byte{0x02})
+			},
+			expectedErr: ErrChecksumMismatch,
+		},
+		{
+			name: "deleted key",
+			modify: func(db database.Database) error {
+				return db.Delete([]byte{0x02})
+			},
+			expectedErr: ErrChecksumMismatch,
+		},
+		{
+			name: "added key",
+			modify: func(db database.Database) error {
+				return db.Put([]byte{0x03}, []byte{0x03})
+			},
+			expectedErr: ErrChecksumMismatch,
+		},
+		{
+			name: "progress key is ignored",
+			modify: func(db database.Database) error {
+				return db.Put(progressKey, []byte{statusCopied})
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			src := memdb.New()
+			dst := memdb.New()
+			for _, db := range []database.Database{src, dst} {
+				require.NoError(db.Put([]byte{0x01}, []byte{0x01}))
+				require.NoError(db.Put([]byte{0x02}, []byte{0x02}))
+			}
+			require.NoError(test.modify(dst))
+
+			_, err := Verify(context.Background(), DefaultConfig.PrefixLen, src, dst)
+			require.ErrorIs(err, test.expectedErr)
+		})
+	}
+}
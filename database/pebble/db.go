@@ -31,7 +31,8 @@ const (
 )
 
 var (
-	_ database.Database = (*Database)(nil)
+	_ database.Database    = (*Database)(nil)
+	_ database.Snapshotter = (*Database)(nil)
 
 	errInvalidOperation = errors.New("invalid operation")
 
@@ -259,6 +260,32 @@ func (db *Database) NewIteratorWithStartAndPrefix(start, prefix []byte) database
 	return iter
 }
 
+// NewSnapshotIterator creates a lexicographically ordered iterator over a
+// point-in-time snapshot of the database.
+func (db *Database) NewSnapshotIterator() (database.Iterator, error) {
+	db.lock.Lock()
+	defer db.lock.Unlock()
+
+	if db.closed {
+		return nil, database.ErrClosed
+	}
+
+	snapshot := db.pebbleDB.NewSnapshot()
+	it, err := snapshot.NewIter(keyRange(nil, nil))
+	if err != nil {
+		_ = snapshot.Close()
+		return nil, updateError(err)
+	}
+
+	iter := &iter{
+		db:       db,
+		iter:     it,
+		snapshot: snapshot,
+	}
+	db.openIterators.Add(iter)
+	return iter, nil
+}
+
 // Converts a pebble-specific error to its Avalanche equivalent, if applicable.
 func updateError(err error) error {
 	switch err {
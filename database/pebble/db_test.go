@@ -31,6 +31,12 @@ func TestInterface(t *testing.T) {
 	}
 }
 
+func TestSnapshotIterator(t *testing.T) {
+	db := newDB(t)
+	database.TestSnapshotIterator(t, db)
+	_ = db.Close()
+}
+
 func FuzzKeyValue(f *testing.F) {
 	db := newDB(f)
 	database.FuzzKeyValue(f, db)
@@ -30,6 +30,10 @@ type iter struct {
 	db   *Database
 	iter *pebble.Iterator
 
+	// snapshot is only set if the iterator was created by
+	// [Database.NewSnapshotIterator].
+	snapshot *pebble.Snapshot
+
 	initialized bool
 	closed      bool
 	err         error
@@ -134,4 +138,9 @@ func (it *iter) release() {
 	if err := it.iter.Close(); err != nil {
 		it.err = updateError(err)
 	}
+	if it.snapshot != nil {
+		if err := it.snapshot.Close(); err != nil && it.err == nil {
+			it.err = updateError(err)
+		}
+	}
 }
@@ -0,0 +1,144 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/spf13/cobra"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/leveldb"
+	"github.com/ava-labs/avalanchego/database/pebble"
+	"github.com/ava-labs/avalanchego/database/snapshot"
+	"github.com/ava-labs/avalanchego/utils/logging"
+)
+
+var (
+	errDBPathRequired       = errors.New("--db-path is required")
+	errSnapshotFileRequired = errors.New("--snapshot-file is required")
+	errUnknownDBType        = errors.New("unknown db-type")
+)
+
+type persistentDatabase interface {
+	database.Database
+	database.Snapshotter
+}
+
+func main() {
+	var (
+		dbType       string
+		dbPath       string
+		snapshotFile string
+	)
+	rootCmd := &cobra.Command{
+		Use:   "snapshotctl",
+		Short: "Export, verify and restore database snapshots of a stopped node",
+	}
+	rootCmd.PersistentFlags().StringVar(&dbType, "db-type", leveldb.Name, fmt.Sprintf("Database type, one of {%s, %s}", leveldb.Name, pebble.Name))
+	rootCmd.PersistentFlags().StringVar(&dbPath, "db-path", "", "Path to the database directory, e.g. $HOME/.avalanchego/db/mainnet/v1.4.5")
+	rootCmd.PersistentFlags().StringVar(&snapshotFile, "snapshot-file", "", "Path to the snapshot file")
+
+	exportCmd := &cobra.Command{
+		Use:   "export",
+		Short: "Write a snapshot of the database to the snapshot file",
+		RunE: func(*cobra.Command, []string) error {
+			if len(snapshotFile) == 0 {
+				return errSnapshotFileRequired
+			}
+			db, err := openDB(dbType, dbPath)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			summary, err := snapshot.WriteFile(snapshotFile, db)
+			if err != nil {
+				return err
+			}
+			printSummary("exported", summary)
+			return nil
+		},
+	}
+
+	verifyCmd := &cobra.Command{
+		Use:   "verify",
+		Short: "Verify the integrity of the snapshot file",
+		RunE: func(*cobra.Command, []string) error {
+			if len(snapshotFile) == 0 {
+				return errSnapshotFileRequired
+			}
+			summary, err := snapshot.VerifyFile(snapshotFile)
+			if err != nil {
+				return err
+			}
+			printSummary("verified", summary)
+			return nil
+		},
+	}
+
+	restoreCmd := &cobra.Command{
+		Use:   "restore",
+		Short: "Verify the snapshot file and restore it into an empty database",
+		RunE: func(*cobra.Command, []string) error {
+			if len(snapshotFile) == 0 {
+				return errSnapshotFileRequired
+			}
+			db, err := openDB(dbType, dbPath)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			summary, err := snapshot.RestoreFile(snapshotFile, db)
+			if err != nil {
+				return err
+			}
+			printSummary("restored", summary)
+			return nil
+		},
+	}
+
+	rootCmd.AddCommand(
+		exportCmd,
+		verifyCmd,
+		restoreCmd,
+	)
+
+	if err := rootCmd.Execute(); err != nil {
+		fmt.Fprintf(os.Stderr, "snapshotctl failed: %v\n", err)
+		os.Exit(1)
+	}
+	os.Exit(0)
+}
+
+func openDB(dbType string, dbPath string) (persistentDatabase, error) {
+	if len(dbPath) == 0 {
+		return nil, errDBPathRequired
+	}
+
+	var (
+		db  database.Database
+		err error
+	)
+	switch dbType {
+	case leveldb.Name:
+		db, err = leveldb.New(dbPath, nil, logging.NoLog{}, "", prometheus.NewRegistry())
+	case pebble.Name:
+		db, err = pebble.New(dbPath, nil, logging.NoLog{}, "", prometheus.NewRegistry())
+	default:
+		return nil, fmt.Errorf("%w: %q", errUnknownDBType, dbType)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("couldn't open %s at %s: %w", dbType, dbPath, err)
+	}
+	return db.(persistentDatabase), nil
+}
+
+func printSummary(action string, summary snapshot.Summary) {
+	fmt.Fprintf(os.Stdout, "%s snapshot with %d keys and checksum %s\n", action, summary.NumKeys, summary.Checksum)
+}
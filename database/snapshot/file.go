@@ -0,0 +1,105 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package snapshot
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/perms"
+)
+
+const tmpSuffix = ".tmp"
+
+// WriteFile writes a snapshot of [db] to [path]. The snapshot is written to a
+// temporary file that is only moved to [path] once it is complete, so [path]
+// never contains a partial snapshot.
+func WriteFile(path string, db database.Snapshotter) (Summary, error) {
+	it, err := db.NewSnapshotIterator()
+	if err != nil {
+		return Summary{}, err
+	}
+	defer it.Release()
+
+	return WriteIteratorFile(path, it)
+}
+
+// WriteIteratorFile writes every key-value pair reported by [it] to [path].
+// This allows callers to create the snapshot iterator while holding a lock
+// and to release the lock before the potentially slow write.
+func WriteIteratorFile(path string, it database.Iterator) (Summary, error) {
+	if err := os.MkdirAll(filepath.Dir(path), perms.ReadWriteExecute); err != nil {
+		return Summary{}, err
+	}
+
+	tmpPath := path + tmpSuffix
+	f, err := perms.Create(tmpPath, perms.ReadWrite)
+	if err != nil {
+		return Summary{}, err
+	}
+
+	summary, err := Write(f, it)
+	if err == nil {
+		err = f.Sync()
+	}
+	err = errors.Join(err, f.Close())
+	if err != nil {
+		_ = os.Remove(tmpPath)
+		return Summary{}, err
+	}
+	return summary, os.Rename(tmpPath, path)
+}
+
+// ReadFileChecksum returns the checksum recorded in the trailer of the
+// snapshot at [path] without reading the rest of the file. The returned
+// checksum is not verified.
+func ReadFileChecksum(path string) (ids.ID, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return ids.Empty, err
+	}
+	defer f.Close()
+
+	var checksum ids.ID
+	info, err := f.Stat()
+	if err != nil {
+		return ids.Empty, err
+	}
+	if info.Size() < int64(len(checksum)) {
+		return ids.Empty, io.ErrUnexpectedEOF
+	}
+	_, err = f.ReadAt(checksum[:], info.Size()-int64(len(checksum)))
+	return checksum, err
+}
+
+// VerifyFile verifies the integrity of the snapshot at [path].
+func VerifyFile(path string) (Summary, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return Summary{}, err
+	}
+	defer f.Close()
+
+	return Verify(f)
+}
+
+// RestoreFile verifies the snapshot at [path] and then writes it into [db],
+// which must be empty.
+func RestoreFile(path string, db database.Database) (Summary, error) {
+	if _, err := VerifyFile(path); err != nil {
+		return Summary{}, err
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return Summary{}, err
+	}
+	defer f.Close()
+
+	return Restore(f, db)
+}
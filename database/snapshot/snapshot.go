@@ -0,0 +1,287 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package snapshot implements a portable, checksummed format for exporting
+// and restoring the full contents of a [database.Database].
+//
+// A snapshot is encoded as:
+//
+//	magic    [8]byte
+//	version  uint16
+//	entries  (entryFlag, uvarint keyLen, key, uvarint valueLen, value)*
+//	trailer  (endFlag, uint64 numKeys, [32]byte checksum)
+//
+// where checksum is the SHA-256 hash of every byte that precedes it.
+package snapshot
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/units"
+)
+
+const (
+	Version uint16 = 0
+
+	entryFlag byte = 1
+	endFlag   byte = 0
+
+	// maxEntryLen bounds the size of any key or value that will be read from
+	// a snapshot, to avoid allocating unbounded memory for corrupted files.
+	maxEntryLen = 256 * units.MiB
+
+	// restoreBatchSize is the approximate number of bytes that are written to
+	// the database in a single batch during a restore.
+	restoreBatchSize = 4 * units.MiB
+)
+
+var (
+	magic = [8]byte{'a', 'v', 'a', 'x', 's', 'n', 'a', 'p'}
+
+	ErrInvalidMagic       = errors.New("invalid snapshot magic")
+	ErrUnsupportedVersion = errors.New("unsupported snapshot version")
+	ErrChecksumMismatch   = errors.New("snapshot checksum mismatch")
+	ErrNumKeysMismatch    = errors.New("snapshot key count mismatch")
+	ErrDatabaseNotEmpty   = errors.New("database is not empty")
+
+	errInvalidFlag     = errors.New("invalid entry flag")
+	errEntryTooLarge   = errors.New("entry too large")
+	errTrailingData    = errors.New("unexpected data after snapshot trailer")
+	errUnsortedEntries = errors.New("snapshot entries are not sorted")
+)
+
+// Summary describes the contents of a snapshot.
+type Summary struct {
+	NumKeys  uint64 `json:"numKeys"`
+	Checksum ids.ID `json:"checksum"`
+}
+
+// Write encodes every key-value pair reported by [it] to [w]. The iterator
+// should be created with [database.Snapshotter.NewSnapshotIterator] so that
+// the snapshot is consistent if the database is being written to.
+func Write(w io.Writer, it database.Iterator) (Summary, error) {
+	var (
+		hasher  = sha256.New()
+		bufW    = bufio.NewWriter(w)
+		writer  = io.MultiWriter(bufW, hasher)
+		numKeys uint64
+		lenBuf  [binary.MaxVarintLen64]byte
+	)
+
+	header := make([]byte, len(magic)+2)
+	copy(header, magic[:])
+	binary.BigEndian.PutUint16(header[len(magic):], Version)
+	if _, err := writer.Write(header); err != nil {
+		return Summary{}, err
+	}
+
+	for it.Next() {
+		key := it.Key()
+		value := it.Value()
+		if _, err := writer.Write([]byte{entryFlag}); err != nil {
+			return Summary{}, err
+		}
+		for _, b := range [][]byte{key, value} {
+			n := binary.PutUvarint(lenBuf[:], uint64(len(b)))
+			if _, err := writer.Write(lenBuf[:n]); err != nil {
+				return Summary{}, err
+			}
+			if _, err := writer.Write(b); err != nil {
+				return Summary{}, err
+			}
+		}
+		numKeys++
+	}
+	if err := it.Error(); err != nil {
+		return Summary{}, err
+	}
+
+	trailer := make([]byte, 1+database.Uint64Size)
+	trailer[0] = endFlag
+	binary.BigEndian.PutUint64(trailer[1:], numKeys)
+	if _, err := writer.Write(trailer); err != nil {
+		return Summary{}, err
+	}
+
+	checksum := ids.ID(hasher.Sum(nil))
+	if _, err := bufW.Write(checksum[:]); err != nil {
+		return Summary{}, err
+	}
+	return Summary{
+		NumKeys:  numKeys,
+		Checksum: checksum,
+	}, bufW.Flush()
+}
+
+// Verify reads the snapshot from [r] and verifies its integrity without
+// writing it anywhere.
+func Verify(r io.Reader) (Summary, error) {
+	return read(r, func([]byte, []byte) error {
+		return nil
+	})
+}
+
+// Restore writes the snapshot read from [r] into [db], which must be empty.
+//
+// Because the checksum is only known once the entire snapshot has been read,
+// [db] may have been partially written to if an error is returned. Callers
+// that can't tolerate this should call [Verify] first.
+func Restore(r io.Reader, db database.Database) (Summary, error) {
+	isEmpty, err := database.IsEmpty(db)
+	if err != nil {
+		return Summary{}, err
+	}
+	if !isEmpty {
+		return Summary{}, ErrDatabaseNotEmpty
+	}
+	return Load(r, db)
+}
+
+// Load writes the snapshot read from [r] into [db] without requiring [db] to be
+// empty. Keys in [db] that are also in the snapshot are overwritten and every
+// other key is left untouched.
+//
+// Because the checksum is only known once the entire snapshot has been read,
+// [db] may have been partially written to if an error is returned.
+func Load(r io.Reader, db database.Batcher) (Summary, error) {
+	batch := db.NewBatch()
+	summary, err := read(r, func(key, value []byte) error {
+		if err := batch.Put(key, value); err != nil {
+			return err
+		}
+		if batch.Size() < restoreBatchSize {
+			return nil
+		}
+		if err := batch.Write(); err != nil {
+			return err
+		}
+		batch.Reset()
+		return nil
+	})
+	if err != nil {
+		return Summary{}, err
+	}
+	return summary, batch.Write()
+}
+
+// read decodes the snapshot from [r], calling [onEntry] for each key-value
+// pair in order. The checksum is verified after the last entry is read.
+func read(r io.Reader, onEntry func(key, value []byte) error) (Summary, error) {
+	var (
+		hasher  = sha256.New()
+		bufR    = bufio.NewReader(r)
+		reader  = &hashReader{r: bufR, h: hasher}
+		numKeys uint64
+		lastKey []byte
+	)
+
+	header := make([]byte, len(magic)+2)
+	if _, err := io.ReadFull(reader, header); err != nil {
+		return Summary{}, err
+	}
+	if !bytes.Equal(header[:len(magic)], magic[:]) {
+		return Summary{}, ErrInvalidMagic
+	}
+	if version := binary.BigEndian.Uint16(header[len(magic):]); version != Version {
+		return Summary{}, fmt.Errorf("%w: %d", ErrUnsupportedVersion, version)
+	}
+
+	for {
+		flag, err := reader.ReadByte()
+		if err == io.EOF {
+			return Summary{}, io.ErrUnexpectedEOF
+		}
+		if err != nil {
+			return Summary{}, err
+		}
+		if flag == endFlag {
+			break
+		}
+		if flag != entryFlag {
+			return Summary{}, fmt.Errorf("%w: %d", errInvalidFlag, flag)
+		}
+
+		key, err := readBytes(reader)
+		if err != nil {
+			return Summary{}, err
+		}
+		if numKeys > 0 && bytes.Compare(lastKey, key) >= 0 {
+			return Summary{}, errUnsortedEntries
+		}
+		value, err := readBytes(reader)
+		if err != nil {
+			return Summary{}, err
+		}
+		if err := onEntry(key, value); err != nil {
+			return Summary{}, err
+		}
+		lastKey = key
+		numKeys++
+	}
+
+	numKeysBytes := make([]byte, database.Uint64Size)
+	if _, err := io.ReadFull(reader, numKeysBytes); err != nil {
+		return Summary{}, err
+	}
+	expectedChecksum := ids.ID(hasher.Sum(nil))
+
+	var checksum ids.ID
+	if _, err := io.ReadFull(bufR, checksum[:]); err != nil {
+		return Summary{}, err
+	}
+	if checksum != expectedChecksum {
+		return Summary{}, fmt.Errorf("%w: expected %s but got %s", ErrChecksumMismatch, expectedChecksum, checksum)
+	}
+	if expectedNumKeys := binary.BigEndian.Uint64(numKeysBytes); expectedNumKeys != numKeys {
+		return Summary{}, fmt.Errorf("%w: expected %d but got %d", ErrNumKeysMismatch, expectedNumKeys, numKeys)
+	}
+	if _, err := bufR.ReadByte(); err != io.EOF {
+		return Summary{}, errTrailingData
+	}
+	return Summary{
+		NumKeys:  numKeys,
+		Checksum: checksum,
+	}, nil
+}
+
+func readBytes(r *hashReader) ([]byte, error) {
+	length, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, err
+	}
+	if length > maxEntryLen {
+		return nil, fmt.Errorf("%w: %d > %d", errEntryTooLarge, length, maxEntryLen)
+	}
+	b := make([]byte, length)
+	_, err = io.ReadFull(r, b)
+	return b, err
+}
+
+// hashReader passes every byte that is read through to a hash.
+type hashReader struct {
+	r *bufio.Reader
+	h hash.Hash
+}
+
+func (r *hashReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	_, _ = r.h.Write(p[:n])
+	return n, err
+}
+
+func (r *hashReader) ReadByte() (byte, error) {
+	b, err := r.r.ReadByte()
+	if err == nil {
+		_, _ = r.h.Write([]byte{b})
+	}
+	return b, err
+}
@@ -0,0 +1,193 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package snapshot
+
+import (
+	"bytes"
+	"io"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/utils"
+)
+
+func newTestDB(t *testing.T, numKeys int) *memdb.Database {
+	db := memdb.New()
+	for i := 0; i < numKeys; i++ {
+		key := utils.RandomBytes(32)
+		value := utils.RandomBytes(i)
+		require.NoError(t, db.Put(key, value))
+	}
+	return db
+}
+
+func requireDatabasesEqual(t *testing.T, expected, actual database.Database) {
+	require := require.New(t)
+
+	expectedIt := expected.NewIterator()
+	defer expectedIt.Release()
+	actualIt := actual.NewIterator()
+	defer actualIt.Release()
+
+	for expectedIt.Next() {
+		require.True(actualIt.Next())
+		require.Equal(expectedIt.Key(), actualIt.Key())
+		require.Equal(expectedIt.Value(), actualIt.Value())
+	}
+	require.False(actualIt.Next())
+	require.NoError(expectedIt.Error())
+	require.NoError(actualIt.Error())
+}
+
+func TestWriteRestore(t *testing.T) {
+	tests := []struct {
+		name    string
+		numKeys int
+	}{
+		{
+			name:    "empty",
+			numKeys: 0,
+		},
+		{
+			name:    "single key",
+			numKeys: 1,
+		},
+		{
+			name:    "many keys",
+			numKeys: 1000,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			db := newTestDB(t, test.numKeys)
+			it, err := db.NewSnapshotIterator()
+			require.NoError(err)
+			defer it.Release()
+
+			buf := &bytes.Buffer{}
+			summary, err := Write(buf, it)
+			require.NoError(err)
+			require.Equal(uint64(test.numKeys), summary.NumKeys)
+
+			verifySummary, err := Verify(bytes.NewReader(buf.Bytes()))
+			require.NoError(err)
+			require.Equal(summary, verifySummary)
+
+			restoredDB := memdb.New()
+			restoreSummary, err := Restore(bytes.NewReader(buf.Bytes()), restoredDB)
+			require.NoError(err)
+			require.Equal(summary, restoreSummary)
+
+			requireDatabasesEqual(t, db, restoredDB)
+		})
+	}
+}
+
+func TestVerifyCorrupted(t *testing.T) {
+	db := newTestDB(t, 10)
+	it, err := db.NewSnapshotIterator()
+	require.NoError(t, err)
+	defer it.Release()
+
+	buf := &bytes.Buffer{}
+	_, err = Write(buf, it)
+	require.NoError(t, err)
+	snapshot := buf.Bytes()
+
+	tests := []struct {
+		name        string
+		modify      func([]byte) []byte
+		expectedErr error
+	}{
+		{
+			name: "invalid magic",
+			modify: func(b []byte) []byte {
+				b[0] ^= 1
+				return b
+			},
+			expectedErr: ErrInvalidMagic,
+		},
+		{
+			name: "unsupported version",
+			modify: func(b []byte) []byte {
+				b[len(magic)+1] = 1
+				return b
+			},
+			expectedErr: ErrUnsupportedVersion,
+		},
+		{
+			name: "flipped value bit",
+			modify: func(b []byte) []byte {
+				b[len(b)-100] ^= 1
+				return b
+			},
+			expectedErr: ErrChecksumMismatch,
+		},
+		{
+			name: "truncated",
+			modify: func(b []byte) []byte {
+				return b[:len(b)/2]
+			},
+			expectedErr: io.ErrUnexpectedEOF,
+		},
+		{
+			name: "trailing data",
+			modify: func(b []byte) []byte {
+				return append(b, 0)
+			},
+			expectedErr: errTrailingData,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			modified := test.modify(bytes.Clone(snapshot))
+			_, err := Verify(bytes.NewReader(modified))
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
+
+func TestRestoreNotEmpty(t *testing.T) {
+	require := require.New(t)
+
+	db := newTestDB(t, 1)
+	it, err := db.NewSnapshotIterator()
+	require.NoError(err)
+	defer it.Release()
+
+	buf := &bytes.Buffer{}
+	_, err = Write(buf, it)
+	require.NoError(err)
+
+	_, err = Restore(buf, db)
+	require.ErrorIs(err, ErrDatabaseNotEmpty)
+}
+
+func TestWriteRestoreFile(t *testing.T) {
+	require := require.New(t)
+
+	db := newTestDB(t, 100)
+	path := filepath.Join(t.TempDir(), "snapshots", "db.snapshot")
+
+	summary, err := WriteFile(path, db)
+	require.NoError(err)
+	require.NoFileExists(path + tmpSuffix)
+
+	verifySummary, err := VerifyFile(path)
+	require.NoError(err)
+	require.Equal(summary, verifySummary)
+
+	restoredDB := memdb.New()
+	restoreSummary, err := RestoreFile(path, restoredDB)
+	require.NoError(err)
+	require.Equal(summary, restoreSummary)
+
+	requireDatabasesEqual(t, db, restoredDB)
+}
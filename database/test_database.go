@@ -612,6 +612,49 @@ func TestIteratorSnapshot(t *testing.T, db Database) {
 	require.NoError(iterator.Error())
 }
 
+// TestSnapshotIterator tests to make sure that a snapshot iterator doesn't
+// report writes, or deletions, that occur after it was created. This test is
+// only run for databases that implement [Snapshotter].
+func TestSnapshotIterator(t *testing.T, db Database) {
+	require := require.New(t)
+
+	snapshotter, ok := db.(Snapshotter)
+	require.True(ok)
+
+	key1 := []byte("hello1")
+	value1 := []byte("world1")
+
+	key2 := []byte("hello2")
+	value2 := []byte("world2")
+
+	key3 := []byte("hello3")
+	value3 := []byte("world3")
+
+	require.NoError(db.Put(key1, value1))
+	require.NoError(db.Put(key2, value2))
+
+	iterator, err := snapshotter.NewSnapshotIterator()
+	require.NoError(err)
+	defer iterator.Release()
+
+	require.NoError(db.Delete(key1))
+	require.NoError(db.Put(key2, value3))
+	require.NoError(db.Put(key3, value3))
+
+	require.True(iterator.Next())
+	require.Equal(key1, iterator.Key())
+	require.Equal(value1, iterator.Value())
+
+	require.True(iterator.Next())
+	require.Equal(key2, iterator.Key())
+	require.Equal(value2, iterator.Value())
+
+	require.False(iterator.Next())
+	require.Nil(iterator.Key())
+	require.Nil(iterator.Value())
+	require.NoError(iterator.Error())
+}
+
 // TestIterator tests to make sure the database iterates over the database
 // contents lexicographically.
 func TestIterator(t *testing.T, db Database) {
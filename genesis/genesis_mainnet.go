@@ -9,6 +9,7 @@ import (
 	_ "embed"
 
 	"github.com/ava-labs/avalanchego/utils/units"
+	"github.com/ava-labs/avalanchego/vms/components/gas"
 	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs/fee"
 )
@@ -30,6 +31,18 @@ var (
 			AddSubnetValidatorFee:         units.MilliAvax,
 			AddSubnetDelegatorFee:         units.MilliAvax,
 		},
+		DynamicFeeConfig: gas.Config{
+			Weights: gas.Dimensions{
+				gas.Bandwidth: 1,
+				gas.DBRead:    1_000,
+				gas.DBWrite:   1_000,
+				gas.Compute:   1_000,
+			},
+			MaxGasPerBlock:           2_000_000,
+			TargetGasPerBlock:        500_000,
+			MinPrice:                 100,
+			ExcessConversionConstant: 5_000_000,
+		},
 		StakingConfig: StakingConfig{
 			UptimeRequirement: .8, // 80%
 			MinValidatorStake: 2 * units.KiloAvax,
@@ -487,3 +487,12 @@ func TestAVAXAssetID(t *testing.T) {
 		})
 	}
 }
+
+func TestDynamicFeeConfigVerify(t *testing.T) {
+	for _, networkID := range []uint32{constants.MainnetID, constants.FujiID, constants.LocalID} {
+		t.Run(constants.NetworkIDToNetworkName[networkID], func(t *testing.T) {
+			config := GetDynamicFeeConfig(networkID)
+			require.NoError(t, config.Verify())
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/vms/components/gas"
 	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs/fee"
 )
@@ -37,6 +38,9 @@ type StakingConfig struct {
 type Params struct {
 	StakingConfig
 	fee.StaticConfig
+	// DynamicFeeConfig is the config for the fee market that replaces the
+	// static fees after the E upgrade.
+	DynamicFeeConfig gas.Config
 }
 
 func GetTxFeeConfig(networkID uint32) fee.StaticConfig {
@@ -52,6 +56,19 @@ func GetTxFeeConfig(networkID uint32) fee.StaticConfig {
 	}
 }
 
+func GetDynamicFeeConfig(networkID uint32) gas.Config {
+	switch networkID {
+	case constants.MainnetID:
+		return MainnetParams.DynamicFeeConfig
+	case constants.FujiID:
+		return FujiParams.DynamicFeeConfig
+	case constants.LocalID:
+		return LocalParams.DynamicFeeConfig
+	default:
+		return LocalParams.DynamicFeeConfig
+	}
+}
+
 func GetStakingConfig(networkID uint32) StakingConfig {
 	switch networkID {
 	case constants.MainnetID:
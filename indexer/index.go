@@ -55,6 +55,8 @@ type index struct {
 	// Container ID --> Index
 	containerToIndex database.Database
 	log              logging.Logger
+	// Closed and replaced whenever a container is accepted
+	accepted chan struct{}
 }
 
 // Create a new thread-safe index.
@@ -76,6 +78,7 @@ func newIndex(
 		indexToContainer: indexToContainer,
 		containerToIndex: containerToIndex,
 		log:              log,
+		accepted:         make(chan struct{}),
 	}
 
 	// Get next accepted index from db
@@ -159,7 +162,14 @@ func (i *index) Accept(ctx *snow.ConsensusContext, containerID ids.ID, container
 	}
 
 	// Atomically commit [i.vDB], [i.indexToContainer], [i.containerToIndex] to [i.baseDB]
-	return i.vDB.Commit()
+	if err := i.vDB.Commit(); err != nil {
+		return err
+	}
+
+	// Wake up anyone waiting for the next container to be accepted
+	close(i.accepted)
+	i.accepted = make(chan struct{})
+	return nil
 }
 
 // Returns the ID of the [index]th accepted container and the container itself.
@@ -271,6 +281,15 @@ func (i *index) GetLastAccepted() (Container, error) {
 	return i.getContainerByIndex(lastAcceptedIndex)
 }
 
+// nextIndex returns the index that the next accepted container will have and a
+// channel that is closed once it has been accepted.
+func (i *index) nextIndex() (uint64, <-chan struct{}) {
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+
+	return i.nextAcceptedIndex, i.accepted
+}
+
 // Assumes i.lock is held
 // Returns:
 //
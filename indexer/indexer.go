@@ -4,6 +4,7 @@
 package indexer
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"sync"
@@ -53,6 +54,9 @@ type Config struct {
 	VertexAcceptorGroup  snow.AcceptorGroup
 	APIServer            server.PathAdder
 	ShutdownF            func()
+	// If true, accepted containers and chain state changes of every chain can
+	// be streamed over a websocket
+	SubscriptionsEnabled bool
 }
 
 // Indexer causes accepted containers for a given chain
@@ -86,6 +90,18 @@ func NewIndexer(config Config) (Indexer, error) {
 	if err != nil {
 		return nil, err
 	}
+
+	if config.SubscriptionsEnabled {
+		indexer.subscriptions = newSubscriptionServer(
+			config.Log,
+			config.BlockAcceptorGroup,
+			config.TxAcceptorGroup,
+			config.VertexAcceptorGroup,
+		)
+		if err := config.APIServer.AddRoute(indexer.subscriptions, "index", "/subscribe"); err != nil {
+			return nil, errors.Join(err, indexer.subscriptions.close())
+		}
+	}
 	indexer.hasRunBefore = hasRun
 	return indexer, indexer.markHasRun()
 }
@@ -126,6 +142,10 @@ type indexer struct {
 	txAcceptorGroup snow.AcceptorGroup
 	// Notifies of newly accepted vertices
 	vertexAcceptorGroup snow.AcceptorGroup
+
+	// Streams accepted containers to websocket clients. Nil if subscriptions
+	// are disabled.
+	subscriptions *subscriptionServer
 }
 
 // Assumes [ctx.Lock] is not held
@@ -133,6 +153,34 @@ func (i *indexer) RegisterChain(chainName string, ctx *snow.ConsensusContext, vm
 	i.lock.Lock()
 	defer i.lock.Unlock()
 
+	i.registerChain(chainName, ctx, vm)
+	if i.subscriptions == nil || i.closed {
+		return
+	}
+
+	// Subscriptions are supported for every chain. Containers are streamed
+	// from the indices that were just created, if any.
+	chainID := ctx.ChainID
+	indices := make(map[string]*index)
+	if index, ok := i.blockIndices[chainID]; ok {
+		indices[SubscriptionBlock] = index
+	}
+	if index, ok := i.vtxIndices[chainID]; ok {
+		indices[SubscriptionVertex] = index
+	}
+	if index, ok := i.txIndices[chainID]; ok {
+		indices[SubscriptionTx] = index
+	}
+	if err := i.subscriptions.registerChain(chainName, ctx, vm, indices); err != nil {
+		i.log.Error("failed to register chain for subscriptions",
+			zap.String("chainName", chainName),
+			zap.Error(err),
+		)
+	}
+}
+
+// Assumes [i.lock] is held
+func (i *indexer) registerChain(chainName string, ctx *snow.ConsensusContext, vm common.VM) {
 	if i.closed {
 		i.log.Debug("not registering chain to indexer",
 			zap.String("reason", "indexer is closed"),
@@ -362,6 +410,9 @@ func (i *indexer) close() error {
 	i.closed = true
 
 	errs := &wrappers.Errs{}
+	if i.subscriptions != nil {
+		errs.Add(i.subscriptions.close())
+	}
 	for chainID, txIndex := range i.txIndices {
 		errs.Add(
 			txIndex.Close(),
@@ -0,0 +1,452 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package indexer
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/formatting"
+
+	avajson "github.com/ava-labs/avalanchego/utils/json"
+)
+
+const (
+	subscribeMethod    = "index.subscribe"
+	unsubscribeMethod  = "index.unsubscribe"
+	notificationMethod = "index.subscription"
+
+	jsonRPCVersion = "2.0"
+
+	// Error codes defined by the JSON-RPC 2.0 specification
+	jsonRPCParseError     = -32700
+	jsonRPCMethodNotFound = -32601
+	jsonRPCInvalidParams  = -32602
+	jsonRPCServerError    = -32000
+)
+
+var (
+	errUnknownSubscription = errors.New("unknown subscription")
+	errUnsubscribed        = errors.New("unsubscribed")
+)
+
+type subscriptionRequest struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params"`
+}
+
+type subscriptionError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// subscriptionMessage is either a response to a request or a notification.
+type subscriptionMessage struct {
+	JSONRPC string                    `json:"jsonrpc"`
+	ID      json.RawMessage           `json:"id,omitempty"`
+	Result  interface{}               `json:"result,omitempty"`
+	Error   *subscriptionError        `json:"error,omitempty"`
+	Method  string                    `json:"method,omitempty"`
+	Params  *SubscriptionNotification `json:"params,omitempty"`
+}
+
+// subscriptionConn is a websocket connection of a client.
+type subscriptionConn struct {
+	server *subscriptionServer
+	conn   *websocket.Conn
+	// Buffered channel of outbound messages
+	send chan *subscriptionMessage
+	// Closed when the connection is closed
+	closed    chan struct{}
+	closeOnce sync.Once
+
+	lock               sync.Mutex
+	nextSubscriptionID uint64
+	subscriptions      map[string]*subscription
+}
+
+func newSubscriptionConn(server *subscriptionServer, conn *websocket.Conn) *subscriptionConn {
+	return &subscriptionConn{
+		server:        server,
+		conn:          conn,
+		send:          make(chan *subscriptionMessage, subscriptionMaxPendingMessages),
+		closed:        make(chan struct{}),
+		subscriptions: make(map[string]*subscription),
+	}
+}
+
+// write queues [msg] to be sent to the client. Returns false if the connection
+// was closed first.
+func (c *subscriptionConn) write(msg *subscriptionMessage) bool {
+	msg.JSONRPC = jsonRPCVersion
+	select {
+	case c.send <- msg:
+		return true
+	case <-c.closed:
+		return false
+	}
+}
+
+func (c *subscriptionConn) close() {
+	c.closeOnce.Do(func() {
+		close(c.closed)
+
+		c.lock.Lock()
+		subscriptions := c.subscriptions
+		c.subscriptions = nil
+		c.lock.Unlock()
+
+		for _, sub := range subscriptions {
+			sub.cancel(nil)
+		}
+
+		// close is called by both the writePump and the readPump so one of
+		// them will always error
+		_ = c.conn.Close()
+		c.server.removeConn(c)
+	})
+}
+
+// readPump handles the requests of the client.
+//
+// The application runs readPump in a per-connection goroutine. The application
+// ensures that there is at most one reader on a connection by executing all
+// reads from this goroutine.
+func (c *subscriptionConn) readPump() {
+	defer c.close()
+
+	c.conn.SetReadLimit(subscriptionMaxMessageSize)
+	// SetReadDeadline returns an error if the connection is corrupted
+	if err := c.conn.SetReadDeadline(time.Now().Add(subscriptionPongWait)); err != nil {
+		return
+	}
+	c.conn.SetPongHandler(func(string) error {
+		return c.conn.SetReadDeadline(time.Now().Add(subscriptionPongWait))
+	})
+
+	for {
+		_, msgBytes, err := c.conn.ReadMessage()
+		if err != nil {
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
+				c.server.log.Debug("unexpected close in websockets",
+					zap.Error(err),
+				)
+			}
+			return
+		}
+		if !c.handle(msgBytes) {
+			return
+		}
+	}
+}
+
+// handle responds to a single request. Returns false if the connection was
+// closed.
+func (c *subscriptionConn) handle(msgBytes []byte) bool {
+	var req subscriptionRequest
+	if err := json.Unmarshal(msgBytes, &req); err != nil {
+		return c.write(&subscriptionMessage{
+			Error: &subscriptionError{
+				Code:    jsonRPCParseError,
+				Message: err.Error(),
+			},
+		})
+	}
+
+	var (
+		result interface{}
+		rpcErr *subscriptionError
+	)
+	switch req.Method {
+	case subscribeMethod:
+		var args SubscribeArgs
+		if err := json.Unmarshal(req.Params, &args); err != nil {
+			rpcErr = &subscriptionError{
+				Code:    jsonRPCInvalidParams,
+				Message: err.Error(),
+			}
+			break
+		}
+		subscriptionID, err := c.subscribe(&args)
+		if err != nil {
+			rpcErr = &subscriptionError{
+				Code:    jsonRPCServerError,
+				Message: err.Error(),
+			}
+			break
+		}
+		result = &SubscribeReply{
+			SubscriptionID: subscriptionID,
+		}
+	case unsubscribeMethod:
+		var args UnsubscribeArgs
+		if err := json.Unmarshal(req.Params, &args); err != nil {
+			rpcErr = &subscriptionError{
+				Code:    jsonRPCInvalidParams,
+				Message: err.Error(),
+			}
+			break
+		}
+		if err := c.unsubscribe(args.SubscriptionID); err != nil {
+			rpcErr = &subscriptionError{
+				Code:    jsonRPCServerError,
+				Message: err.Error(),
+			}
+			break
+		}
+		result = struct{}{}
+	default:
+		rpcErr = &subscriptionError{
+			Code:    jsonRPCMethodNotFound,
+			Message: "unknown method " + strconv.Quote(req.Method),
+		}
+	}
+	return c.write(&subscriptionMessage{
+		ID:     req.ID,
+		Result: result,
+		Error:  rpcErr,
+	})
+}
+
+func (c *subscriptionConn) subscribe(args *SubscribeArgs) (string, error) {
+	c.lock.Lock()
+	if c.subscriptions == nil {
+		c.lock.Unlock()
+		return "", errSubscriptionsClosed
+	}
+	if len(c.subscriptions) >= maxSubscriptionsPerConn {
+		c.lock.Unlock()
+		return "", errTooManySubscriptions
+	}
+	subscriptionID := strconv.FormatUint(c.nextSubscriptionID, 10)
+	c.nextSubscriptionID++
+
+	sub := newSubscription(subscriptionID, c, args.Encoding)
+	c.subscriptions[subscriptionID] = sub
+	c.lock.Unlock()
+
+	if err := c.server.subscribe(sub, args); err != nil {
+		c.removeSubscription(subscriptionID)
+		return "", err
+	}
+	return subscriptionID, nil
+}
+
+func (c *subscriptionConn) unsubscribe(subscriptionID string) error {
+	sub, ok := c.removeSubscription(subscriptionID)
+	if !ok {
+		return errUnknownSubscription
+	}
+	sub.cancel(errUnsubscribed)
+	return nil
+}
+
+func (c *subscriptionConn) removeSubscription(subscriptionID string) (*subscription, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	sub, ok := c.subscriptions[subscriptionID]
+	delete(c.subscriptions, subscriptionID)
+	return sub, ok
+}
+
+// writePump sends queued messages to the client.
+//
+// A goroutine running writePump is started for each connection. The
+// application ensures that there is at most one writer to a connection by
+// executing all writes from this goroutine.
+func (c *subscriptionConn) writePump() {
+	ticker := time.NewTicker(subscriptionPingPeriod)
+	defer func() {
+		ticker.Stop()
+		c.close()
+	}()
+
+	for {
+		select {
+		case msg := <-c.send:
+			if err := c.conn.SetWriteDeadline(time.Now().Add(subscriptionWriteWait)); err != nil {
+				return
+			}
+			if err := c.conn.WriteJSON(msg); err != nil {
+				return
+			}
+		case <-ticker.C:
+			if err := c.conn.SetWriteDeadline(time.Now().Add(subscriptionWriteWait)); err != nil {
+				return
+			}
+			if err := c.conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+		case <-c.closed:
+			return
+		}
+	}
+}
+
+// subscription streams the events of a single chain to a connection.
+type subscription struct {
+	id       string
+	conn     *subscriptionConn
+	chainID  ids.ID
+	encoding formatting.Encoding
+
+	// Events that aren't read from an index
+	events chan interface{}
+
+	// Closed when the subscription is cancelled
+	done       chan struct{}
+	cancelOnce sync.Once
+	// The reason the subscription was cancelled, if any. Only read after
+	// [done] is closed.
+	err error
+}
+
+func newSubscription(id string, conn *subscriptionConn, encoding formatting.Encoding) *subscription {
+	return &subscription{
+		id:       id,
+		conn:     conn,
+		encoding: encoding,
+		events:   make(chan interface{}, subscriptionMaxPendingEvents),
+		done:     make(chan struct{}),
+	}
+}
+
+// push queues [event] without blocking. Returns false if the subscription has
+// too many pending events.
+func (s *subscription) push(event interface{}) bool {
+	select {
+	case s.events <- event:
+		return true
+	default:
+		return false
+	}
+}
+
+// cancel stops the subscription. If [err] is not nil, the client is notified
+// of it.
+func (s *subscription) cancel(err error) {
+	s.cancelOnce.Do(func() {
+		s.err = err
+		close(s.done)
+	})
+}
+
+// runFeed streams the events of [f] until the subscription is cancelled.
+func (s *subscription) runFeed(f *feed) {
+	defer f.remove(s)
+
+	for {
+		select {
+		case event := <-s.events:
+			if !s.notify(event, nil) {
+				return
+			}
+		case <-s.done:
+			s.notifyCancelled()
+			return
+		}
+	}
+}
+
+// runIndex streams the containers of [index] starting at [next] until the
+// subscription is cancelled. Containers that were accepted before the
+// subscription was created are streamed first, so a client that reconnects
+// doesn't miss any containers.
+func (s *subscription) runIndex(index *index, next uint64) {
+	for {
+		nextAccepted, accepted := index.nextIndex()
+		if next >= nextAccepted {
+			select {
+			case <-accepted:
+				continue
+			case <-s.done:
+				s.notifyCancelled()
+				return
+			}
+		}
+
+		containers, err := index.GetContainerRange(next, min(nextAccepted-next, MaxFetchedByRange))
+		if err != nil {
+			s.cancel(err)
+			s.notifyCancelled()
+			return
+		}
+		for _, container := range containers {
+			index := next
+			if !s.notify(container, &index) {
+				return
+			}
+			next++
+		}
+	}
+}
+
+// notify sends [event] to the client. Returns false if the subscription was
+// cancelled first.
+func (s *subscription) notify(event interface{}, index *uint64) bool {
+	notification := &SubscriptionNotification{
+		SubscriptionID: s.id,
+		ChainID:        s.chainID,
+	}
+	switch event := event.(type) {
+	case Container:
+		bytes, err := formatting.Encode(s.encoding, event.Bytes)
+		if err != nil {
+			s.cancel(err)
+			s.notifyCancelled()
+			return false
+		}
+		notification.Container = &AcceptedContainer{
+			ID:        event.ID,
+			Bytes:     bytes,
+			Timestamp: time.Unix(0, event.Timestamp),
+			Encoding:  s.encoding,
+		}
+		if index != nil {
+			jsonIndex := avajson.Uint64(*index)
+			notification.Container.Index = &jsonIndex
+		}
+	case *ChainStatus:
+		notification.Status = event
+	}
+
+	select {
+	case <-s.done:
+		s.notifyCancelled()
+		return false
+	default:
+	}
+	return s.conn.write(&subscriptionMessage{
+		Method: notificationMethod,
+		Params: notification,
+	})
+}
+
+// notifyCancelled tells the client why the subscription was cancelled, if the
+// subscription wasn't cancelled by the client.
+func (s *subscription) notifyCancelled() {
+	if s.err == nil || s.err == errUnsubscribed {
+		return
+	}
+
+	s.conn.removeSubscription(s.id)
+	s.conn.write(&subscriptionMessage{
+		Method: notificationMethod,
+		Params: &SubscriptionNotification{
+			SubscriptionID: s.id,
+			ChainID:        s.chainID,
+			Error:          s.err.Error(),
+		},
+	})
+}
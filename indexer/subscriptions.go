@@ -0,0 +1,447 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package indexer
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/snow/engine/avalanche/vertex"
+	"github.com/ava-labs/avalanchego/snow/engine/common"
+	"github.com/ava-labs/avalanchego/utils/formatting"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/utils/units"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+
+	avajson "github.com/ava-labs/avalanchego/utils/json"
+)
+
+const (
+	subscriptionsNamePrefix = "subscriptions-"
+
+	// SubscriptionBlock streams the accepted blocks of a chain
+	SubscriptionBlock = "block"
+	// SubscriptionVertex streams the accepted vertices of a DAG chain
+	SubscriptionVertex = "vtx"
+	// SubscriptionTx streams the accepted txs of a DAG chain
+	SubscriptionTx = "tx"
+	// SubscriptionStatus streams the state changes of a chain
+	SubscriptionStatus = "status"
+
+	// Size of the ws read buffer
+	subscriptionReadBufferSize = units.KiB
+	// Size of the ws write buffer
+	subscriptionWriteBufferSize = units.KiB
+	// Maximum message size allowed from a client
+	subscriptionMaxMessageSize = 10 * units.KiB
+	// Maximum number of pending messages to send to a client
+	subscriptionMaxPendingMessages = 1024
+	// Maximum number of events buffered for a subscription that isn't backed
+	// by an index before the subscription is cancelled
+	subscriptionMaxPendingEvents = 1024
+	// Maximum number of subscriptions on a single connection
+	maxSubscriptionsPerConn = 64
+
+	// Time allowed to write a message to the client
+	subscriptionWriteWait = 10 * time.Second
+	// Time allowed to read the next pong message from the client
+	subscriptionPongWait = 60 * time.Second
+	// Send pings to the client with this period. Must be less than
+	// subscriptionPongWait.
+	subscriptionPingPeriod = (subscriptionPongWait * 9) / 10
+	// How often the state of every chain is checked for changes
+	subscriptionStatusFrequency = time.Second
+)
+
+var (
+	errUnknownChain            = errors.New("unknown chain")
+	errUnknownSubscriptionKind = errors.New("unknown subscription kind")
+	errResumeUnsupported       = errors.New("resuming is only supported for indexed containers")
+	errTooManySubscriptions    = errors.New("too many subscriptions")
+	errSubscriptionLagging     = errors.New("subscription fell too far behind")
+	errSubscriptionsClosed     = errors.New("subscriptions are closed")
+
+	subscriptionUpgrader = websocket.Upgrader{
+		ReadBufferSize:  subscriptionReadBufferSize,
+		WriteBufferSize: subscriptionWriteBufferSize,
+		CheckOrigin: func(*http.Request) bool {
+			return true
+		},
+	}
+
+	_ http.Handler  = (*subscriptionServer)(nil)
+	_ snow.Acceptor = (*feed)(nil)
+)
+
+// SubscribeArgs are the arguments of index.subscribe
+type SubscribeArgs struct {
+	// Chain is the ID or the primary alias of the chain
+	Chain string `json:"chain"`
+	// Kind is one of "block", "vtx", "tx" or "status"
+	Kind string `json:"kind"`
+	// FromIndex, if provided, replays the containers that were accepted
+	// starting at this index before streaming newly accepted containers. Only
+	// supported for indexed containers.
+	FromIndex *avajson.Uint64     `json:"fromIndex"`
+	Encoding  formatting.Encoding `json:"encoding"`
+}
+
+// SubscribeReply is the response of index.subscribe
+type SubscribeReply struct {
+	SubscriptionID string `json:"subscriptionID"`
+}
+
+// UnsubscribeArgs are the arguments of index.unsubscribe
+type UnsubscribeArgs struct {
+	SubscriptionID string `json:"subscriptionID"`
+}
+
+// AcceptedContainer is an accepted block, vertex or tx sent to a subscription
+type AcceptedContainer struct {
+	ID        ids.ID              `json:"id"`
+	Bytes     string              `json:"bytes"`
+	Timestamp time.Time           `json:"timestamp"`
+	Encoding  formatting.Encoding `json:"encoding"`
+	// Index is only provided for indexed containers
+	Index *avajson.Uint64 `json:"index,omitempty"`
+}
+
+// ChainStatus is the state of a chain sent to a subscription
+type ChainStatus struct {
+	State        string `json:"state"`
+	Bootstrapped bool   `json:"bootstrapped"`
+}
+
+// SubscriptionNotification is the params of an index.subscription
+// notification. Exactly one of Container, Status and Error is provided. If
+// Error is provided, the subscription has been cancelled.
+type SubscriptionNotification struct {
+	SubscriptionID string             `json:"subscriptionID"`
+	ChainID        ids.ID             `json:"chainID"`
+	Container      *AcceptedContainer `json:"container,omitempty"`
+	Status         *ChainStatus       `json:"status,omitempty"`
+	Error          string             `json:"error,omitempty"`
+}
+
+func newChainStatus(state snow.State) *ChainStatus {
+	return &ChainStatus{
+		State:        state.String(),
+		Bootstrapped: state == snow.NormalOp,
+	}
+}
+
+// feed notifies subscriptions of events that aren't persisted by an index.
+type feed struct {
+	lock sync.Mutex
+	subs set.Set[*subscription]
+}
+
+// Accept is called while the chain's context lock is held, so it must not
+// block.
+func (f *feed) Accept(_ *snow.ConsensusContext, containerID ids.ID, containerBytes []byte) error {
+	f.publish(Container{
+		ID:        containerID,
+		Bytes:     containerBytes,
+		Timestamp: time.Now().UnixNano(),
+	})
+	return nil
+}
+
+func (f *feed) publish(event interface{}) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	for sub := range f.subs {
+		if !sub.push(event) {
+			f.subs.Remove(sub)
+			sub.cancel(errSubscriptionLagging)
+		}
+	}
+}
+
+func (f *feed) add(sub *subscription) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.subs.Add(sub)
+}
+
+func (f *feed) remove(sub *subscription) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.subs.Remove(sub)
+}
+
+type subscribableChain struct {
+	ctx *snow.ConsensusContext
+	// Kind --> index of the containers of that kind, if they are indexed
+	indices map[string]*index
+	// Kind --> feed of the containers of that kind, if they aren't indexed
+	feeds map[string]*feed
+	// Only accessed by the status goroutine
+	lastState snow.State
+	status    *feed
+}
+
+// subscriptionServer streams accepted containers and chain state changes to
+// websocket clients.
+type subscriptionServer struct {
+	log                 logging.Logger
+	blockAcceptorGroup  snow.AcceptorGroup
+	txAcceptorGroup     snow.AcceptorGroup
+	vertexAcceptorGroup snow.AcceptorGroup
+
+	lock   sync.RWMutex
+	closed bool
+	// Chain ID --> chain
+	chains map[ids.ID]*subscribableChain
+	// Primary alias --> chain ID
+	aliases map[string]ids.ID
+	conns   set.Set[*subscriptionConn]
+
+	stopStatus chan struct{}
+	statusDone chan struct{}
+}
+
+func newSubscriptionServer(
+	log logging.Logger,
+	blockAcceptorGroup snow.AcceptorGroup,
+	txAcceptorGroup snow.AcceptorGroup,
+	vertexAcceptorGroup snow.AcceptorGroup,
+) *subscriptionServer {
+	s := &subscriptionServer{
+		log:                 log,
+		blockAcceptorGroup:  blockAcceptorGroup,
+		txAcceptorGroup:     txAcceptorGroup,
+		vertexAcceptorGroup: vertexAcceptorGroup,
+		chains:              make(map[ids.ID]*subscribableChain),
+		aliases:             make(map[string]ids.ID),
+		stopStatus:          make(chan struct{}),
+		statusDone:          make(chan struct{}),
+	}
+	go s.publishStatuses()
+	return s
+}
+
+// registerChain allows clients to subscribe to [ctx]'s chain. Containers that
+// have an index in [indices] are streamed from the index. All other containers
+// are streamed as they are accepted.
+func (s *subscriptionServer) registerChain(
+	chainName string,
+	ctx *snow.ConsensusContext,
+	vm common.VM,
+	indices map[string]*index,
+) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	chainID := ctx.ChainID
+	if s.closed {
+		return errSubscriptionsClosed
+	}
+	if _, ok := s.chains[chainID]; ok {
+		return fmt.Errorf("chain %s is already registered", chainID)
+	}
+
+	kinds := map[string]snow.AcceptorGroup{
+		SubscriptionBlock: s.blockAcceptorGroup,
+	}
+	if _, ok := vm.(vertex.DAGVM); ok {
+		kinds[SubscriptionVertex] = s.vertexAcceptorGroup
+		kinds[SubscriptionTx] = s.txAcceptorGroup
+	}
+
+	chain := &subscribableChain{
+		ctx:       ctx,
+		indices:   make(map[string]*index),
+		feeds:     make(map[string]*feed),
+		lastState: ctx.State.Get().State,
+		status:    &feed{},
+	}
+	for kind, acceptorGroup := range kinds {
+		if index, ok := indices[kind]; ok {
+			chain.indices[kind] = index
+			continue
+		}
+
+		feed := &feed{}
+		if err := acceptorGroup.RegisterAcceptor(chainID, subscriptionsNamePrefix+chainID.String(), feed, false); err != nil {
+			return err
+		}
+		chain.feeds[kind] = feed
+	}
+
+	s.chains[chainID] = chain
+	s.aliases[chainName] = chainID
+	return nil
+}
+
+func (s *subscriptionServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	wsConn, err := subscriptionUpgrader.Upgrade(w, r, nil)
+	if err != nil {
+		s.log.Debug("failed to upgrade",
+			zap.Error(err),
+		)
+		return
+	}
+
+	conn := newSubscriptionConn(s, wsConn)
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.closed {
+		_ = wsConn.Close()
+		return
+	}
+	s.conns.Add(conn)
+
+	go conn.writePump()
+	go conn.readPump()
+}
+
+func (s *subscriptionServer) removeConn(conn *subscriptionConn) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.conns.Remove(conn)
+}
+
+// subscribe starts streaming the events requested by [args] to [sub].
+func (s *subscriptionServer) subscribe(sub *subscription, args *SubscribeArgs) error {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	if s.closed {
+		return errSubscriptionsClosed
+	}
+	// Containers are encoded lazily, so an unsupported encoding is reported
+	// now rather than when the first container is accepted.
+	if _, err := formatting.Encode(args.Encoding, nil); err != nil {
+		return err
+	}
+
+	chainID, ok := s.aliases[args.Chain]
+	if !ok {
+		var err error
+		chainID, err = ids.FromString(args.Chain)
+		if err != nil {
+			return fmt.Errorf("%w: %q", errUnknownChain, args.Chain)
+		}
+	}
+	chain, ok := s.chains[chainID]
+	if !ok {
+		return fmt.Errorf("%w: %q", errUnknownChain, args.Chain)
+	}
+	sub.chainID = chainID
+
+	if args.Kind == SubscriptionStatus {
+		if args.FromIndex != nil {
+			return errResumeUnsupported
+		}
+
+		// The current status is sent first so that the client doesn't need
+		// to wait for the next change.
+		chain.status.lock.Lock()
+		sub.push(newChainStatus(chain.ctx.State.Get().State))
+		chain.status.subs.Add(sub)
+		chain.status.lock.Unlock()
+
+		go sub.runFeed(chain.status)
+		return nil
+	}
+
+	if index, ok := chain.indices[args.Kind]; ok {
+		next, _ := index.nextIndex()
+		if args.FromIndex != nil {
+			next = uint64(*args.FromIndex)
+		}
+		go sub.runIndex(index, next)
+		return nil
+	}
+
+	feed, ok := chain.feeds[args.Kind]
+	if !ok {
+		return fmt.Errorf("%w %q for chain %s", errUnknownSubscriptionKind, args.Kind, chainID)
+	}
+	if args.FromIndex != nil {
+		return fmt.Errorf("%w: %s %ss are not indexed", errResumeUnsupported, chainID, args.Kind)
+	}
+	feed.add(sub)
+	go sub.runFeed(feed)
+	return nil
+}
+
+// publishStatuses notifies the status subscriptions of every chain whenever
+// the state of the chain changes.
+func (s *subscriptionServer) publishStatuses() {
+	defer close(s.statusDone)
+
+	ticker := time.NewTicker(subscriptionStatusFrequency)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-s.stopStatus:
+			return
+		}
+
+		s.lock.RLock()
+		for _, chain := range s.chains {
+			state := chain.ctx.State.Get().State
+			if state == chain.lastState {
+				continue
+			}
+			chain.lastState = state
+			chain.status.publish(newChainStatus(state))
+		}
+		s.lock.RUnlock()
+	}
+}
+
+// close disconnects all clients and stops listening for accepted containers.
+func (s *subscriptionServer) close() error {
+	s.lock.Lock()
+	if s.closed {
+		s.lock.Unlock()
+		return nil
+	}
+	s.closed = true
+
+	errs := &wrappers.Errs{}
+	for chainID, chain := range s.chains {
+		acceptorName := subscriptionsNamePrefix + chainID.String()
+		if _, ok := chain.feeds[SubscriptionBlock]; ok {
+			errs.Add(s.blockAcceptorGroup.DeregisterAcceptor(chainID, acceptorName))
+		}
+		if _, ok := chain.feeds[SubscriptionVertex]; ok {
+			errs.Add(s.vertexAcceptorGroup.DeregisterAcceptor(chainID, acceptorName))
+		}
+		if _, ok := chain.feeds[SubscriptionTx]; ok {
+			errs.Add(s.txAcceptorGroup.DeregisterAcceptor(chainID, acceptorName))
+		}
+	}
+	conns := s.conns.List()
+	s.lock.Unlock()
+
+	// Connections are closed without holding the lock, as closing a
+	// connection removes it from the server.
+	for _, conn := range conns {
+		conn.close()
+	}
+	close(s.stopStatus)
+	<-s.statusDone
+	return errs.Err
+}
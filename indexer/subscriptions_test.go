@@ -0,0 +1,273 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package indexer
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/block"
+	"github.com/ava-labs/avalanchego/snow/snowtest"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/timer/mockable"
+
+	avajson "github.com/ava-labs/avalanchego/utils/json"
+)
+
+type subscriptionTestClient struct {
+	require *require.Assertions
+	conn    *websocket.Conn
+	nextID  int
+}
+
+func newSubscriptionTestClient(t *testing.T, s *subscriptionServer) *subscriptionTestClient {
+	require := require.New(t)
+
+	httpServer := httptest.NewServer(s)
+	t.Cleanup(httpServer.Close)
+
+	url := "ws" + strings.TrimPrefix(httpServer.URL, "http")
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	require.NoError(err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+	return &subscriptionTestClient{
+		require: require,
+		conn:    conn,
+	}
+}
+
+func (c *subscriptionTestClient) call(method string, params interface{}) *subscriptionMessage {
+	paramsBytes, err := json.Marshal(params)
+	c.require.NoError(err)
+	idBytes, err := json.Marshal(c.nextID)
+	c.require.NoError(err)
+	c.nextID++
+
+	c.require.NoError(c.conn.WriteJSON(&subscriptionRequest{
+		JSONRPC: jsonRPCVersion,
+		ID:      idBytes,
+		Method:  method,
+		Params:  paramsBytes,
+	}))
+	msg := c.read()
+	c.require.Equal(idBytes, []byte(msg.ID))
+	return msg
+}
+
+func (c *subscriptionTestClient) subscribe(args *SubscribeArgs) string {
+	msg := c.call(subscribeMethod, args)
+	c.require.Nil(msg.Error)
+
+	var reply SubscribeReply
+	c.unmarshalResult(msg, &reply)
+	return reply.SubscriptionID
+}
+
+func (c *subscriptionTestClient) unmarshalResult(msg *subscriptionMessage, dst interface{}) {
+	resultBytes, err := json.Marshal(msg.Result)
+	c.require.NoError(err)
+	c.require.NoError(json.Unmarshal(resultBytes, dst))
+}
+
+func (c *subscriptionTestClient) read() *subscriptionMessage {
+	c.require.NoError(c.conn.SetReadDeadline(time.Now().Add(10 * time.Second)))
+	var msg subscriptionMessage
+	c.require.NoError(c.conn.ReadJSON(&msg))
+	return &msg
+}
+
+func (c *subscriptionTestClient) readContainer(subscriptionID string) *AcceptedContainer {
+	msg := c.read()
+	c.require.Equal(notificationMethod, msg.Method)
+	c.require.Equal(subscriptionID, msg.Params.SubscriptionID)
+	c.require.Empty(msg.Params.Error)
+	c.require.NotNil(msg.Params.Container)
+	return msg.Params.Container
+}
+
+func newTestSubscriptionServer(t *testing.T) *subscriptionServer {
+	s := newSubscriptionServer(
+		logging.NoLog{},
+		snow.NewAcceptorGroup(logging.NoLog{}),
+		snow.NewAcceptorGroup(logging.NoLog{}),
+		snow.NewAcceptorGroup(logging.NoLog{}),
+	)
+	t.Cleanup(func() {
+		require.NoError(t, s.close())
+	})
+	return s
+}
+
+func TestSubscribeIndexed(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+
+	snowCtx := snowtest.Context(t, snowtest.CChainID)
+	ctx := snowtest.ConsensusContext(snowCtx)
+
+	blockIndex, err := newIndex(memdb.New(), logging.NoLog{}, mockable.Clock{})
+	require.NoError(err)
+
+	containerIDs := make([]ids.ID, 4)
+	for i := range containerIDs {
+		containerIDs[i] = ids.GenerateTestID()
+	}
+	for _, containerID := range containerIDs[:3] {
+		require.NoError(blockIndex.Accept(ctx, containerID, utils.RandomBytes(32)))
+	}
+
+	s := newTestSubscriptionServer(t)
+	require.NoError(s.registerChain("C", ctx, block.NewMockChainVM(ctrl), map[string]*index{
+		SubscriptionBlock: blockIndex,
+	}))
+
+	client := newSubscriptionTestClient(t, s)
+	fromIndex := avajson.Uint64(1)
+	subscriptionID := client.subscribe(&SubscribeArgs{
+		Chain:     "C",
+		Kind:      SubscriptionBlock,
+		FromIndex: &fromIndex,
+	})
+
+	// Previously accepted containers are replayed before newly accepted
+	// containers are streamed.
+	go func() {
+		_ = blockIndex.Accept(ctx, containerIDs[3], utils.RandomBytes(32))
+	}()
+	for i := 1; i < len(containerIDs); i++ {
+		container := client.readContainer(subscriptionID)
+		require.Equal(containerIDs[i], container.ID)
+		require.NotNil(container.Index)
+		require.Equal(avajson.Uint64(i), *container.Index)
+	}
+
+	// Without an index, only newly accepted containers are streamed.
+	client = newSubscriptionTestClient(t, s)
+	subscriptionID = client.subscribe(&SubscribeArgs{
+		Chain: snowtest.CChainID.String(),
+		Kind:  SubscriptionBlock,
+	})
+	containerID := ids.GenerateTestID()
+	require.NoError(blockIndex.Accept(ctx, containerID, utils.RandomBytes(32)))
+	container := client.readContainer(subscriptionID)
+	require.Equal(containerID, container.ID)
+	require.Equal(avajson.Uint64(4), *container.Index)
+}
+
+func TestSubscribeNotIndexed(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+
+	snowCtx := snowtest.Context(t, snowtest.CChainID)
+	ctx := snowtest.ConsensusContext(snowCtx)
+
+	s := newTestSubscriptionServer(t)
+	require.NoError(s.registerChain("C", ctx, block.NewMockChainVM(ctrl), nil))
+
+	client := newSubscriptionTestClient(t, s)
+
+	// Resuming requires an index.
+	fromIndex := avajson.Uint64(0)
+	msg := client.call(subscribeMethod, &SubscribeArgs{
+		Chain:     "C",
+		Kind:      SubscriptionBlock,
+		FromIndex: &fromIndex,
+	})
+	require.NotNil(msg.Error)
+	require.Contains(msg.Error.Message, errResumeUnsupported.Error())
+
+	// Snowman chains don't have txs.
+	msg = client.call(subscribeMethod, &SubscribeArgs{
+		Chain: "C",
+		Kind:  SubscriptionTx,
+	})
+	require.NotNil(msg.Error)
+	require.Contains(msg.Error.Message, errUnknownSubscriptionKind.Error())
+
+	subscriptionID := client.subscribe(&SubscribeArgs{
+		Chain: "C",
+		Kind:  SubscriptionBlock,
+	})
+
+	containerID := ids.GenerateTestID()
+	require.NoError(s.blockAcceptorGroup.Accept(ctx, containerID, utils.RandomBytes(32)))
+	container := client.readContainer(subscriptionID)
+	require.Equal(containerID, container.ID)
+	require.Nil(container.Index)
+
+	msg = client.call(unsubscribeMethod, &UnsubscribeArgs{
+		SubscriptionID: subscriptionID,
+	})
+	require.Nil(msg.Error)
+
+	msg = client.call(unsubscribeMethod, &UnsubscribeArgs{
+		SubscriptionID: subscriptionID,
+	})
+	require.NotNil(msg.Error)
+	require.Equal(errUnknownSubscription.Error(), msg.Error.Message)
+}
+
+func TestSubscribeStatus(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+
+	snowCtx := snowtest.Context(t, snowtest.CChainID)
+	ctx := snowtest.ConsensusContext(snowCtx)
+	ctx.State.Set(snow.EngineState{
+		State: snow.Bootstrapping,
+	})
+
+	s := newTestSubscriptionServer(t)
+	require.NoError(s.registerChain("C", ctx, block.NewMockChainVM(ctrl), nil))
+
+	client := newSubscriptionTestClient(t, s)
+	subscriptionID := client.subscribe(&SubscribeArgs{
+		Chain: "C",
+		Kind:  SubscriptionStatus,
+	})
+
+	// The current status is sent immediately.
+	msg := client.read()
+	require.Equal(subscriptionID, msg.Params.SubscriptionID)
+	require.Equal(newChainStatus(snow.Bootstrapping), msg.Params.Status)
+
+	ctx.State.Set(snow.EngineState{
+		State: snow.NormalOp,
+	})
+	msg = client.read()
+	require.Equal(subscriptionID, msg.Params.SubscriptionID)
+	require.Equal(newChainStatus(snow.NormalOp), msg.Params.Status)
+	require.True(msg.Params.Status.Bootstrapped)
+}
+
+func TestSubscribeUnknownChain(t *testing.T) {
+	require := require.New(t)
+
+	s := newTestSubscriptionServer(t)
+	client := newSubscriptionTestClient(t, s)
+
+	msg := client.call(subscribeMethod, &SubscribeArgs{
+		Chain: "X",
+		Kind:  SubscriptionBlock,
+	})
+	require.NotNil(msg.Error)
+	require.Contains(msg.Error.Message, errUnknownChain.Error())
+
+	msg = client.call("index.unknown", struct{}{})
+	require.NotNil(msg.Error)
+	require.Equal(jsonRPCMethodNotFound, msg.Error.Code)
+}
@@ -40,6 +40,14 @@ func main() {
 		os.Exit(1)
 	}
 
+	if len(nodeConfig.DatabaseConfig.MigrateToType) != 0 {
+		if err := app.MigrateDatabase(nodeConfig); err != nil {
+			fmt.Printf("couldn't migrate database: %s\n", err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
 	if term.IsTerminal(int(os.Stdout.Fd())) {
 		fmt.Println(app.Header)
 	}
@@ -0,0 +1,110 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package message
+
+import (
+	"slices"
+
+	_ "embed"
+
+	"github.com/ava-labs/avalanchego/proto/pb/p2p"
+	"github.com/ava-labs/avalanchego/utils/compression"
+	"github.com/ava-labs/avalanchego/utils/set"
+)
+
+// compressionDictionaryID is the ID of [compressionDictionary], as written in
+// its header.
+const compressionDictionaryID = 0x1CCEEC74
+
+var (
+	// compressionDictionary is the zstd dictionary used to compress
+	// [compression.TypeZstdDict] messages. It was trained with `zstd --train`
+	// on the message corpus used by the compression benchmarks.
+	//
+	// zstd writes the ID of the dictionary into every message compressed with
+	// it. To replace the dictionary, e.g. with one trained on a network
+	// capture, a dictionary with a new ID is added to [compressionDictionaries]
+	// and is only used for peers that report its ID in their Handshake.
+	// Previous dictionaries must be kept until no peer uses them anymore.
+	//
+	//go:embed compression_dictionary.bin
+	compressionDictionary []byte
+
+	// compressionDictionaries are the dictionaries, keyed by their ID, that
+	// [compression.TypeZstdDict] messages can be decompressed with.
+	compressionDictionaries = map[uint32][]byte{
+		compressionDictionaryID: compressionDictionary,
+	}
+
+	// supportedCompressionDictionaryIDs are the IDs of the dictionaries that
+	// are reported to peers in the Handshake.
+	supportedCompressionDictionaryIDs = []uint32{
+		compressionDictionaryID,
+	}
+
+	// supportedCompressionTypes are the compression types that are reported
+	// to peers in the Handshake.
+	supportedCompressionTypes = []p2p.CompressionType{
+		p2p.CompressionType_COMPRESSION_TYPE_ZSTD,
+		p2p.CompressionType_COMPRESSION_TYPE_SNAPPY,
+		p2p.CompressionType_COMPRESSION_TYPE_ZSTD_DICT,
+	}
+
+	// compressionPreference is the order, from the fewest to the most bytes
+	// sent, in which compression types are picked if the preferred compression
+	// type isn't supported by a peer.
+	compressionPreference = []compression.Type{
+		compression.TypeZstdDict,
+		compression.TypeZstd,
+		compression.TypeSnappy,
+	}
+
+	protoToCompressionType = map[p2p.CompressionType]compression.Type{
+		p2p.CompressionType_COMPRESSION_TYPE_ZSTD:      compression.TypeZstd,
+		p2p.CompressionType_COMPRESSION_TYPE_SNAPPY:    compression.TypeSnappy,
+		p2p.CompressionType_COMPRESSION_TYPE_ZSTD_DICT: compression.TypeZstdDict,
+	}
+)
+
+// NegotiateCompressionType returns the compression type to use for messages
+// sent to a peer that reported [peerTypes] and [peerDictionaryIDs] in its
+// Handshake.
+//
+// [preferred] is used if the peer supports it. Otherwise, the compression type
+// that both nodes support and that sends the fewest bytes is used. If
+// [preferred] is [compression.TypeNone], messages are never compressed.
+//
+// [compression.TypeZstdDict] is only supported by the peer if it also reported
+// the ID of the dictionary that this node compresses messages with.
+func NegotiateCompressionType(
+	preferred compression.Type,
+	peerTypes []p2p.CompressionType,
+	peerDictionaryIDs []uint32,
+) compression.Type {
+	if preferred == compression.TypeNone {
+		return compression.TypeNone
+	}
+
+	// zstd was supported prior to compression types being reported in the
+	// Handshake, so all peers support it.
+	supported := set.Of(compression.TypeZstd)
+	for _, peerType := range peerTypes {
+		if compressionType, ok := protoToCompressionType[peerType]; ok {
+			supported.Add(compressionType)
+		}
+	}
+	if !slices.Contains(peerDictionaryIDs, compressionDictionaryID) {
+		supported.Remove(compression.TypeZstdDict)
+	}
+
+	if supported.Contains(preferred) {
+		return preferred
+	}
+	for _, compressionType := range compressionPreference {
+		if supported.Contains(compressionType) {
+			return compressionType
+		}
+	}
+	return compression.TypeZstd
+}
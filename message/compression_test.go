@@ -0,0 +1,449 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package message
+
+import (
+	"encoding/binary"
+	"net"
+	"os"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/codec/linearcodec"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/network/capture"
+	"github.com/ava-labs/avalanchego/proto/pb/p2p"
+	"github.com/ava-labs/avalanchego/staking"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/compression"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/ips"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+// compressionTypes are the compression types that are benchmarked
+var compressionTypes = []compression.Type{
+	compression.TypeZstd,
+	compression.TypeSnappy,
+	compression.TypeZstdDict,
+}
+
+func TestNegotiateCompressionType(t *testing.T) {
+	tests := []struct {
+		name              string
+		preferred         compression.Type
+		peerTypes         []p2p.CompressionType
+		peerDictionaryIDs []uint32
+		expected          compression.Type
+	}{
+		{
+			name:              "compression disabled",
+			preferred:         compression.TypeNone,
+			peerTypes:         supportedCompressionTypes,
+			peerDictionaryIDs: supportedCompressionDictionaryIDs,
+			expected:          compression.TypeNone,
+		},
+		{
+			name:              "peer supports preferred",
+			preferred:         compression.TypeSnappy,
+			peerTypes:         supportedCompressionTypes,
+			peerDictionaryIDs: supportedCompressionDictionaryIDs,
+			expected:          compression.TypeSnappy,
+		},
+		{
+			name:              "peer supports preferred dictionary",
+			preferred:         compression.TypeZstdDict,
+			peerTypes:         supportedCompressionTypes,
+			peerDictionaryIDs: supportedCompressionDictionaryIDs,
+			expected:          compression.TypeZstdDict,
+		},
+		{
+			name:              "peer doesn't support the dictionary",
+			preferred:         compression.TypeZstdDict,
+			peerTypes:         supportedCompressionTypes,
+			peerDictionaryIDs: []uint32{compressionDictionaryID + 1},
+			expected:          compression.TypeZstd,
+		},
+		{
+			name:      "peer doesn't report compression types",
+			preferred: compression.TypeZstdDict,
+			peerTypes: nil,
+			expected:  compression.TypeZstd,
+		},
+		{
+			name:      "fallback to the fewest bytes",
+			preferred: compression.TypeSnappy,
+			peerTypes: []p2p.CompressionType{
+				p2p.CompressionType_COMPRESSION_TYPE_ZSTD_DICT,
+			},
+			peerDictionaryIDs: supportedCompressionDictionaryIDs,
+			expected:          compression.TypeZstdDict,
+		},
+		{
+			name:      "unknown compression types are ignored",
+			preferred: compression.TypeZstdDict,
+			peerTypes: []p2p.CompressionType{
+				p2p.CompressionType_COMPRESSION_TYPE_UNSPECIFIED,
+				p2p.CompressionType(100),
+			},
+			expected: compression.TypeZstd,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, NegotiateCompressionType(test.preferred, test.peerTypes, test.peerDictionaryIDs))
+		})
+	}
+}
+
+func TestCompressionDictionaries(t *testing.T) {
+	require := require.New(t)
+
+	for expectedID, dictionary := range compressionDictionaries {
+		id, err := compression.ZstdDictionaryID(dictionary)
+		require.NoError(err)
+		require.Equal(expectedID, id)
+	}
+	require.Contains(supportedCompressionDictionaryIDs, uint32(compressionDictionaryID))
+
+	mb, err := newMsgBuilder(
+		logging.NoLog{},
+		"",
+		prometheus.NewRegistry(),
+		10*time.Second,
+	)
+	require.NoError(err)
+
+	// Messages report the dictionary they were compressed with.
+	compressedBytes, err := mb.compressors[compression.TypeZstdDict].Compress([]byte("message"))
+	require.NoError(err)
+	id, err := compression.ZstdFrameDictionaryID(compressedBytes)
+	require.NoError(err)
+	require.Equal(uint32(compressionDictionaryID), id)
+
+	// Messages compressed with an unknown dictionary are rejected.
+	unknownDictionary := slices.Clone(compressionDictionary)
+	binary.LittleEndian.PutUint32(unknownDictionary[4:], compressionDictionaryID+1)
+	unknownCompressor, err := compression.NewZstdDictCompressor(constants.DefaultMaxMessageSize, unknownDictionary)
+	require.NoError(err)
+	compressedBytes, err = unknownCompressor.Compress([]byte("message"))
+	require.NoError(err)
+	msgBytes, err := proto.Marshal(&p2p.Message{
+		Message: &p2p.Message_CompressedZstdDict{
+			CompressedZstdDict: compressedBytes,
+		},
+	})
+	require.NoError(err)
+	_, _, _, err = mb.unmarshal(msgBytes)
+	require.ErrorIs(err, errUnknownDictionaryID)
+}
+
+func TestOutboundMessageCompress(t *testing.T) {
+	require := require.New(t)
+
+	mb, err := newMsgBuilder(
+		logging.NoLog{},
+		"",
+		prometheus.NewRegistry(),
+		10*time.Second,
+	)
+	require.NoError(err)
+	outbound := newOutboundBuilder(compression.TypeZstd, mb)
+	inbound := newInboundBuilder(mb)
+
+	chainID := ids.GenerateTestID()
+	container := utils.RandomBytes(1024)
+	msg, err := outbound.Put(chainID, 1, container)
+	require.NoError(err)
+
+	for _, compressionType := range append(compressionTypes, compression.TypeNone) {
+		compressedMsg, err := msg.Compress(compressionType)
+		require.NoError(err)
+
+		// Compressing the same message twice shouldn't compress it again.
+		cachedMsg, err := msg.Compress(compressionType)
+		require.NoError(err)
+		require.Same(compressedMsg, cachedMsg)
+
+		parsedMsg, err := inbound.Parse(compressedMsg.Bytes(), ids.EmptyNodeID, func() {})
+		require.NoError(err)
+		require.Equal(PutOp, parsedMsg.Op())
+		require.Equal(container, parsedMsg.Message().(*p2p.Put).Container)
+	}
+
+	// Messages that don't support compression are never compressed.
+	msg, err = outbound.Pong()
+	require.NoError(err)
+	compressedMsg, err := msg.Compress(compressionTypes[0])
+	require.NoError(err)
+	require.Same(msg, compressedMsg)
+}
+
+type corpusMessage struct {
+	op    Op
+	bytes []byte
+}
+
+// corpusBlock has the layout of a block on the P-chain or X-chain.
+type corpusBlock struct {
+	ParentID  ids.ID      `serialize:"true"`
+	Height    uint64      `serialize:"true"`
+	Timestamp uint64      `serialize:"true"`
+	Txs       []*corpusTx `serialize:"true"`
+}
+
+type corpusTx struct {
+	avax.BaseTx `serialize:"true"`
+	Creds       []*secp256k1fx.Credential `serialize:"true"`
+}
+
+// newMessageCorpus returns uncompressed messages, of the types that support
+// compression, in the proportions they are sent by a validator of the primary
+// network.
+//
+// If CAPTURE_FILE is set, the corpus is instead read from the messages
+// received in the capture file. Captures can be recorded by running a node
+// with --network-capture-file.
+func newMessageCorpus(tb testing.TB, mb *msgBuilder) []corpusMessage {
+	require := require.New(tb)
+
+	if file := os.Getenv("CAPTURE_FILE"); file != "" {
+		records, err := capture.ReadFile(file)
+		require.NoError(err)
+
+		corpus := make([]corpusMessage, 0, len(records))
+		for _, record := range records {
+			if record.Direction != capture.Inbound {
+				continue
+			}
+			msg, bytesSaved, op, err := mb.unmarshal(record.Bytes)
+			if err != nil || bytesSaved == 0 {
+				// Only messages that were compressed are included.
+				continue
+			}
+			msgBytes, err := proto.Marshal(msg)
+			require.NoError(err)
+			corpus = append(corpus, corpusMessage{
+				op:    op,
+				bytes: msgBytes,
+			})
+		}
+		return corpus
+	}
+
+	c := linearcodec.NewDefault()
+	require.NoError(utils.Err(
+		c.RegisterType(&secp256k1fx.TransferInput{}),
+		c.RegisterType(&secp256k1fx.TransferOutput{}),
+	))
+	m := codec.NewDefaultManager()
+	require.NoError(m.RegisterCodec(0, c))
+
+	avaxAssetID, err := ids.FromString("FvwEAhmxKfeiG8SnEvq42hc6whRyY3EFYAvebMqDNDGCgxN5Z")
+	require.NoError(err)
+	xChainID, err := ids.FromString("2oYMBNV4eNHyqk2fjjV5nVQLDbtmNJzq5s3qs3Lo6ftnC6FByM")
+	require.NoError(err)
+	cChainID, err := ids.FromString("2q9e4r6Mu3U68nU1fYjgbR6JvwrRx36CohpAX5UQxse55x1Q5")
+	require.NoError(err)
+	chainIDs := []ids.ID{constants.PlatformChainID, xChainID, cChainID}
+
+	marshal := func(v interface{}) []byte {
+		b, err := m.Marshal(0, v)
+		require.NoError(err)
+		return b
+	}
+	newTx := func(chainID ids.ID) *corpusTx {
+		return &corpusTx{
+			BaseTx: avax.BaseTx{
+				NetworkID:    constants.MainnetID,
+				BlockchainID: chainID,
+				Outs: []*avax.TransferableOutput{{
+					Asset: avax.Asset{ID: avaxAssetID},
+					Out: &secp256k1fx.TransferOutput{
+						Amt: 1_000_000_000,
+						OutputOwners: secp256k1fx.OutputOwners{
+							Threshold: 1,
+							Addrs:     []ids.ShortID{ids.GenerateTestShortID()},
+						},
+					},
+				}},
+				Ins: []*avax.TransferableInput{{
+					UTXOID: avax.UTXOID{
+						TxID: ids.GenerateTestID(),
+					},
+					Asset: avax.Asset{ID: avaxAssetID},
+					In: &secp256k1fx.TransferInput{
+						Amt: 1_001_000_000,
+						Input: secp256k1fx.Input{
+							SigIndices: []uint32{0},
+						},
+					},
+				}},
+			},
+			Creds: []*secp256k1fx.Credential{{
+				Sigs: [][secp256k1.SignatureLen]byte{
+					[secp256k1.SignatureLen]byte(utils.RandomBytes(secp256k1.SignatureLen)),
+				},
+			}},
+		}
+	}
+	newBlock := func(chainID ids.ID, height uint64) []byte {
+		block := corpusBlock{
+			ParentID:  ids.GenerateTestID(),
+			Height:    height,
+			Timestamp: uint64(time.Now().Unix()),
+		}
+		for i := 0; i < 5; i++ {
+			block.Txs = append(block.Txs, newTx(chainID))
+		}
+		return marshal(block)
+	}
+
+	peers := make([]*ips.ClaimedIPPort, 15)
+	for i := range peers {
+		tlsCert, err := staking.NewTLSCert()
+		require.NoError(err)
+		cert, err := staking.ParseCertificate(tlsCert.Leaf.Raw)
+		require.NoError(err)
+		peers[i] = ips.NewClaimedIPPort(
+			cert,
+			ips.IPPort{
+				IP:   net.IPv4(byte(i), 2, 3, 4),
+				Port: 9651,
+			},
+			uint64(time.Now().Unix()),
+			utils.RandomBytes(72),
+		)
+	}
+
+	var (
+		outbound = newOutboundBuilder(compression.TypeNone, mb)
+		msgs     []OutboundMessage
+		add      = func(msg OutboundMessage, err error) {
+			require.NoError(err)
+			msgs = append(msgs, msg)
+		}
+	)
+	for height := uint64(0); height < 100; height++ {
+		for _, chainID := range chainIDs {
+			requestID := uint32(height)
+			block := newBlock(chainID, height)
+
+			add(outbound.PushQuery(chainID, requestID, time.Second, block, height))
+			add(outbound.Put(chainID, requestID, block))
+			add(outbound.AppGossip(chainID, marshal(newTx(chainID))))
+			add(outbound.AppRequest(chainID, requestID, time.Second, utils.RandomBytes(256)))
+			add(outbound.AppResponse(chainID, requestID, marshal(newTx(chainID))))
+		}
+		if height%10 == 0 {
+			add(outbound.Ancestors(
+				constants.PlatformChainID,
+				uint32(height),
+				[][]byte{
+					newBlock(constants.PlatformChainID, height),
+					newBlock(constants.PlatformChainID, height+1),
+				},
+			))
+			add(outbound.GetPeerList(utils.RandomBytes(128), utils.RandomBytes(32)))
+			add(outbound.PeerList(peers, false))
+		}
+	}
+
+	corpus := make([]corpusMessage, len(msgs))
+	for i, msg := range msgs {
+		corpus[i] = corpusMessage{
+			op:    msg.Op(),
+			bytes: msg.Bytes(),
+		}
+	}
+	return corpus
+}
+
+// Benchmarks compressing a corpus of messages. The "ratio" metric is the
+// number of bytes sent divided by the number of bytes of the uncompressed
+// messages.
+//
+// e.g.,
+//
+//	$ go test -run=NONE -bench=BenchmarkCompressCorpus
+//	$ CAPTURE_FILE=/tmp/capture go test -run=NONE -bench=BenchmarkCompressCorpus
+func BenchmarkCompressCorpus(b *testing.B) {
+	mb, err := newMsgBuilder(logging.NoLog{}, "", prometheus.NewRegistry(), 10*time.Second)
+	require.NoError(b, err)
+
+	corpus := newMessageCorpus(b, mb)
+	uncompressedLen := 0
+	for _, msg := range corpus {
+		uncompressedLen += len(msg.bytes)
+	}
+
+	for _, compressionType := range compressionTypes {
+		b.Run(compressionType.String(), func(b *testing.B) {
+			require := require.New(b)
+
+			b.SetBytes(int64(uncompressedLen))
+			b.ResetTimer()
+
+			compressedLen := 0
+			for n := 0; n < b.N; n++ {
+				compressedLen = 0
+				for _, msg := range corpus {
+					compressedBytes, _, err := mb.compress(msg.bytes, msg.op, compressionType)
+					require.NoError(err)
+					compressedLen += len(compressedBytes)
+				}
+			}
+			b.ReportMetric(float64(compressedLen)/float64(uncompressedLen), "ratio")
+		})
+	}
+}
+
+// Benchmarks decompressing a corpus of messages.
+//
+// e.g.,
+//
+//	$ go test -run=NONE -bench=BenchmarkDecompressCorpus
+//	$ CAPTURE_FILE=/tmp/capture go test -run=NONE -bench=BenchmarkDecompressCorpus
+func BenchmarkDecompressCorpus(b *testing.B) {
+	mb, err := newMsgBuilder(logging.NoLog{}, "", prometheus.NewRegistry(), 10*time.Second)
+	require.NoError(b, err)
+
+	corpus := newMessageCorpus(b, mb)
+	uncompressedLen := 0
+	for _, msg := range corpus {
+		uncompressedLen += len(msg.bytes)
+	}
+
+	for _, compressionType := range compressionTypes {
+		b.Run(compressionType.String(), func(b *testing.B) {
+			require := require.New(b)
+
+			compressedMsgs := make([][]byte, len(corpus))
+			for i, msg := range corpus {
+				compressedBytes, _, err := mb.compress(msg.bytes, msg.op, compressionType)
+				require.NoError(err)
+				compressedMsgs[i] = compressedBytes
+			}
+
+			b.SetBytes(int64(uncompressedLen))
+			b.ResetTimer()
+
+			for n := 0; n < b.N; n++ {
+				for _, compressedBytes := range compressedMsgs {
+					_, _, _, err := mb.unmarshal(compressedBytes)
+					require.NoError(err)
+				}
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ package message
 import (
 	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -36,6 +37,8 @@ var (
 	metricLabels = []string{typeLabel, opLabel, directionLabel}
 
 	errUnknownCompressionType = errors.New("message is compressed with an unknown compression type")
+	errUnknownDictionaryID    = errors.New("message is compressed with an unknown dictionary")
+	errUnexpectedDictionaryID = errors.New("unexpected compression dictionary ID")
 )
 
 // InboundMessage represents a set of fields for an inbound message
@@ -111,6 +114,9 @@ type OutboundMessage interface {
 	// BytesSavedCompression returns the number of bytes that this message saved
 	// due to being compressed
 	BytesSavedCompression() int
+	// Compress returns this message compressed with [compressionType]. If this
+	// message was created without compression, it is returned unmodified.
+	Compress(compressionType compression.Type) (OutboundMessage, error)
 }
 
 type outboundMessage struct {
@@ -118,6 +124,18 @@ type outboundMessage struct {
 	op                    Op
 	bytes                 []byte
 	bytesSavedCompression int
+
+	// compressionType is the compression used to create [bytes]. The
+	// uncompressed bytes aren't kept, to avoid doubling the memory of queued
+	// messages. If the message must be compressed differently, [bytes] are
+	// decompressed again.
+	builder         *msgBuilder
+	compressionType compression.Type
+
+	// compressedLock must be held while accessing [compressed]
+	compressedLock sync.Mutex
+	// compressionType --> this message compressed with compressionType
+	compressed map[compression.Type]*outboundMessage
 }
 
 func (m *outboundMessage) BypassThrottling() bool {
@@ -136,13 +154,55 @@ func (m *outboundMessage) BytesSavedCompression() int {
 	return m.bytesSavedCompression
 }
 
-// TODO: add other compression algorithms with extended interface
+func (m *outboundMessage) Compress(compressionType compression.Type) (OutboundMessage, error) {
+	if m.compressionType == compression.TypeNone || m.compressionType == compressionType {
+		return m, nil
+	}
+
+	// The same message is commonly sent to many peers, so the result is cached
+	// to avoid compressing it once per peer.
+	m.compressedLock.Lock()
+	defer m.compressedLock.Unlock()
+
+	if msg, ok := m.compressed[compressionType]; ok {
+		return msg, nil
+	}
+
+	uncompressedBytes, err := m.builder.decompressBytes(m.bytes)
+	if err != nil {
+		return nil, err
+	}
+	b, saved, err := m.builder.compress(uncompressedBytes, m.op, compressionType)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &outboundMessage{
+		bypassThrottling:      m.bypassThrottling,
+		op:                    m.op,
+		bytes:                 b,
+		bytesSavedCompression: saved,
+		builder:               m.builder,
+		compressionType:       compressionType,
+	}
+	if m.compressed == nil {
+		m.compressed = make(map[compression.Type]*outboundMessage)
+	}
+	m.compressed[compressionType] = msg
+	return msg, nil
+}
+
 type msgBuilder struct {
 	log logging.Logger
 
-	zstdCompressor compression.Compressor
-	count          *prometheus.CounterVec // type + op + direction
-	duration       *prometheus.GaugeVec   // type + op + direction
+	// compressors are used to compress messages. The
+	// [compression.TypeZstdDict] compressor uses [compressionDictionaryID].
+	compressors map[compression.Type]compression.Compressor
+	// dictionary ID --> compressor that decompresses
+	// [compression.TypeZstdDict] messages compressed with the dictionary
+	dictionaryCompressors map[uint32]compression.Compressor
+	count                 *prometheus.CounterVec // type + op + direction
+	duration              *prometheus.GaugeVec   // type + op + direction
 
 	maxMessageTimeout time.Duration
 }
@@ -157,11 +217,34 @@ func newMsgBuilder(
 	if err != nil {
 		return nil, err
 	}
+	snappyCompressor, err := compression.NewSnappyCompressor(constants.DefaultMaxMessageSize)
+	if err != nil {
+		return nil, err
+	}
+	dictionaryCompressors := make(map[uint32]compression.Compressor, len(compressionDictionaries))
+	for id, dictionary := range compressionDictionaries {
+		dictionaryID, err := compression.ZstdDictionaryID(dictionary)
+		if err != nil {
+			return nil, err
+		}
+		if dictionaryID != id {
+			return nil, fmt.Errorf("%w: expected %d but got %d", errUnexpectedDictionaryID, id, dictionaryID)
+		}
+		dictionaryCompressors[id], err = compression.NewZstdDictCompressor(constants.DefaultMaxMessageSize, dictionary)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	mb := &msgBuilder{
 		log: log,
 
-		zstdCompressor: zstdCompressor,
+		compressors: map[compression.Type]compression.Compressor{
+			compression.TypeZstd:     zstdCompressor,
+			compression.TypeSnappy:   snappyCompressor,
+			compression.TypeZstdDict: dictionaryCompressors[compressionDictionaryID],
+		},
+		dictionaryCompressors: dictionaryCompressors,
 		count: prometheus.NewCounterVec(
 			prometheus.CounterOpts{
 				Namespace: namespace,
@@ -201,36 +284,60 @@ func (mb *msgBuilder) marshal(
 		return nil, 0, 0, err
 	}
 
+	compressedMsgBytes, bytesSaved, err := mb.compress(uncompressedMsgBytes, op, compressionType)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	return compressedMsgBytes, bytesSaved, op, nil
+}
+
+// compress returns [uncompressedMsgBytes] compressed with [compressionType]
+// along with the number of bytes saved by compressing them.
+func (mb *msgBuilder) compress(
+	uncompressedMsgBytes []byte,
+	op Op,
+	compressionType compression.Type,
+) ([]byte, int, error) {
+	if compressionType == compression.TypeNone {
+		return uncompressedMsgBytes, 0, nil
+	}
+
+	compressor, ok := mb.compressors[compressionType]
+	if !ok {
+		return nil, 0, errUnknownCompressionType
+	}
+
 	// If compression is enabled, we marshal twice:
 	// 1. the original message
 	// 2. the message with compressed bytes
 	//
 	// This recursive packing allows us to avoid an extra compression on/off
 	// field in the message.
-	var (
-		startTime     = time.Now()
-		compressedMsg p2p.Message
-	)
+	startTime := time.Now()
+	compressedBytes, err := compressor.Compress(uncompressedMsgBytes)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var compressedMsg p2p.Message
 	switch compressionType {
-	case compression.TypeNone:
-		return uncompressedMsgBytes, 0, op, nil
 	case compression.TypeZstd:
-		compressedBytes, err := mb.zstdCompressor.Compress(uncompressedMsgBytes)
-		if err != nil {
-			return nil, 0, 0, err
+		compressedMsg.Message = &p2p.Message_CompressedZstd{
+			CompressedZstd: compressedBytes,
 		}
-		compressedMsg = p2p.Message{
-			Message: &p2p.Message_CompressedZstd{
-				CompressedZstd: compressedBytes,
-			},
+	case compression.TypeSnappy:
+		compressedMsg.Message = &p2p.Message_CompressedSnappy{
+			CompressedSnappy: compressedBytes,
+		}
+	case compression.TypeZstdDict:
+		compressedMsg.Message = &p2p.Message_CompressedZstdDict{
+			CompressedZstdDict: compressedBytes,
 		}
-	default:
-		return nil, 0, 0, errUnknownCompressionType
 	}
 
 	compressedMsgBytes, err := proto.Marshal(&compressedMsg)
 	if err != nil {
-		return nil, 0, 0, err
+		return nil, 0, err
 	}
 	compressTook := time.Since(startTime)
 
@@ -243,37 +350,87 @@ func (mb *msgBuilder) marshal(
 	mb.duration.With(labels).Add(float64(compressTook))
 
 	bytesSaved := len(uncompressedMsgBytes) - len(compressedMsgBytes)
-	return compressedMsgBytes, bytesSaved, op, nil
+	return compressedMsgBytes, bytesSaved, nil
 }
 
-func (mb *msgBuilder) unmarshal(b []byte) (*p2p.Message, int, Op, error) {
-	m := new(p2p.Message)
-	if err := proto.Unmarshal(b, m); err != nil {
-		return nil, 0, 0, err
-	}
-
+// decompress returns the compression type of [m] along with its compressed
+// and decompressed bytes. If [m] isn't compressed, [compression.TypeNone] is
+// returned.
+func (mb *msgBuilder) decompress(m *p2p.Message) (compression.Type, []byte, []byte, error) {
 	// Figure out what compression type, if any, was used to compress the message.
 	var (
-		compressor      compression.Compressor
-		compressedBytes []byte
-		zstdCompressed  = m.GetCompressedZstd()
+		compressionType    compression.Type
+		compressor         compression.Compressor
+		compressedBytes    []byte
+		zstdCompressed     = m.GetCompressedZstd()
+		snappyCompressed   = m.GetCompressedSnappy()
+		zstdDictCompressed = m.GetCompressedZstdDict()
 	)
 	switch {
 	case len(zstdCompressed) > 0:
-		compressor = mb.zstdCompressor
+		compressionType = compression.TypeZstd
 		compressedBytes = zstdCompressed
+	case len(snappyCompressed) > 0:
+		compressionType = compression.TypeSnappy
+		compressedBytes = snappyCompressed
+	case len(zstdDictCompressed) > 0:
+		compressionType = compression.TypeZstdDict
+		compressedBytes = zstdDictCompressed
+
+		dictionaryID, err := compression.ZstdFrameDictionaryID(compressedBytes)
+		if err != nil {
+			return 0, nil, nil, err
+		}
+		var ok bool
+		compressor, ok = mb.dictionaryCompressors[dictionaryID]
+		if !ok {
+			return 0, nil, nil, fmt.Errorf("%w: %d", errUnknownDictionaryID, dictionaryID)
+		}
 	default:
-		// The message wasn't compressed
-		op, err := ToOp(m)
-		return m, 0, op, err
+		return compression.TypeNone, nil, nil, nil
+	}
+	if compressor == nil {
+		compressor = mb.compressors[compressionType]
+	}
+
+	decompressed, err := compressor.Decompress(compressedBytes)
+	return compressionType, compressedBytes, decompressed, err
+}
+
+// decompressBytes returns the bytes of the uncompressed message that was
+// compressed into [b].
+func (mb *msgBuilder) decompressBytes(b []byte) ([]byte, error) {
+	m := new(p2p.Message)
+	if err := proto.Unmarshal(b, m); err != nil {
+		return nil, err
+	}
+	compressionType, _, decompressed, err := mb.decompress(m)
+	if err != nil {
+		return nil, err
+	}
+	if compressionType == compression.TypeNone {
+		return b, nil
+	}
+	return decompressed, nil
+}
+
+func (mb *msgBuilder) unmarshal(b []byte) (*p2p.Message, int, Op, error) {
+	m := new(p2p.Message)
+	if err := proto.Unmarshal(b, m); err != nil {
+		return nil, 0, 0, err
 	}
 
 	startTime := time.Now()
 
-	decompressed, err := compressor.Decompress(compressedBytes)
+	compressionType, compressedBytes, decompressed, err := mb.decompress(m)
 	if err != nil {
 		return nil, 0, 0, err
 	}
+	if compressionType == compression.TypeNone {
+		// The message wasn't compressed
+		op, err := ToOp(m)
+		return m, 0, op, err
+	}
 	bytesSavedCompression := len(decompressed) - len(compressedBytes)
 
 	if err := proto.Unmarshal(decompressed, m); err != nil {
@@ -288,7 +445,7 @@ func (mb *msgBuilder) unmarshal(b []byte) (*p2p.Message, int, Op, error) {
 	}
 
 	labels := prometheus.Labels{
-		typeLabel:      compression.TypeZstd.String(),
+		typeLabel:      compressionType.String(),
 		opLabel:        op.String(),
 		directionLabel: decompressionLabel,
 	}
@@ -309,6 +466,8 @@ func (mb *msgBuilder) createOutbound(m *p2p.Message, compressionType compression
 		op:                    op,
 		bytes:                 b,
 		bytesSavedCompression: saved,
+		builder:               mb,
+		compressionType:       compressionType,
 	}, nil
 }
 
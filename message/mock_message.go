@@ -12,6 +12,7 @@ package message
 import (
 	reflect "reflect"
 
+	compression "github.com/ava-labs/avalanchego/utils/compression"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -80,6 +81,21 @@ func (mr *MockOutboundMessageMockRecorder) BytesSavedCompression() *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BytesSavedCompression", reflect.TypeOf((*MockOutboundMessage)(nil).BytesSavedCompression))
 }
 
+// Compress mocks base method.
+func (m *MockOutboundMessage) Compress(arg0 compression.Type) (OutboundMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Compress", arg0)
+	ret0, _ := ret[0].(OutboundMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Compress indicates an expected call of Compress.
+func (mr *MockOutboundMessageMockRecorder) Compress(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Compress", reflect.TypeOf((*MockOutboundMessage)(nil).Compress), arg0)
+}
+
 // Op mocks base method.
 func (m *MockOutboundMessage) Op() Op {
 	m.ctrl.T.Helper()
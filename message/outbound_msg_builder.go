@@ -267,7 +267,9 @@ func (b *outMsgBuilder) Handshake(
 						Filter: knownPeersFilter,
 						Salt:   knownPeersSalt,
 					},
-					IpBlsSig: ipBLSSig,
+					IpBlsSig:                  ipBLSSig,
+					SupportedCompressionTypes: supportedCompressionTypes,
+					CompressionDictionaryIds:  supportedCompressionDictionaryIDs,
 				},
 			},
 		},
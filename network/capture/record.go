@@ -0,0 +1,149 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package capture
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+const (
+	Inbound Direction = iota
+	Outbound
+
+	// timestamp + direction + nodeID + message length
+	headerLen = wrappers.LongLen + wrappers.ByteLen + ids.NodeIDLen + wrappers.IntLen
+
+	gzipExtension = ".gz"
+)
+
+var (
+	errUnknownDirection = errors.New("unknown direction")
+	errMessageTooLarge  = errors.New("message too large")
+)
+
+// Direction specifies whether a message was received from or sent to a peer.
+type Direction byte
+
+func (d Direction) String() string {
+	switch d {
+	case Inbound:
+		return "inbound"
+	case Outbound:
+		return "outbound"
+	default:
+		return "unknown"
+	}
+}
+
+// Record is a single captured message.
+type Record struct {
+	// Time the message was captured
+	Time time.Time
+	// Direction the message was sent in
+	Direction Direction
+	// NodeID of the peer the message was exchanged with
+	NodeID ids.NodeID
+	// Bytes of the message, as they were sent over the wire
+	Bytes []byte
+}
+
+func (r *Record) bytes() []byte {
+	b := make([]byte, headerLen+len(r.Bytes))
+	binary.BigEndian.PutUint64(b, uint64(r.Time.UnixNano()))
+	b[wrappers.LongLen] = byte(r.Direction)
+	copy(b[wrappers.LongLen+wrappers.ByteLen:], r.NodeID[:])
+	binary.BigEndian.PutUint32(b[headerLen-wrappers.IntLen:], uint32(len(r.Bytes)))
+	copy(b[headerLen:], r.Bytes)
+	return b
+}
+
+// Reader reads records from a capture.
+type Reader struct {
+	reader io.Reader
+}
+
+func NewReader(reader io.Reader) *Reader {
+	return &Reader{
+		reader: bufio.NewReader(reader),
+	}
+}
+
+// Next returns the next record of the capture. Returns [io.EOF] once all the
+// records have been read.
+func (r *Reader) Next() (*Record, error) {
+	header := make([]byte, headerLen)
+	if _, err := io.ReadFull(r.reader, header); err != nil {
+		return nil, err
+	}
+
+	record := &Record{
+		Time:      time.Unix(0, int64(binary.BigEndian.Uint64(header))),
+		Direction: Direction(header[wrappers.LongLen]),
+	}
+	if record.Direction != Inbound && record.Direction != Outbound {
+		return nil, fmt.Errorf("%w: %d", errUnknownDirection, record.Direction)
+	}
+	copy(record.NodeID[:], header[wrappers.LongLen+wrappers.ByteLen:])
+
+	msgLen := binary.BigEndian.Uint32(header[headerLen-wrappers.IntLen:])
+	if msgLen > constants.DefaultMaxMessageSize {
+		return nil, fmt.Errorf("%w: %d > %d", errMessageTooLarge, msgLen, constants.DefaultMaxMessageSize)
+	}
+	record.Bytes = make([]byte, msgLen)
+	if _, err := io.ReadFull(r.reader, record.Bytes); err != nil {
+		// The capture ends in the middle of a record.
+		if errors.Is(err, io.EOF) {
+			return nil, io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	return record, nil
+}
+
+// ReadFile returns all the records in the capture file at [path]. Rotated
+// files that were compressed are decompressed.
+func ReadFile(path string) ([]*Record, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var reader io.Reader = file
+	if filepath.Ext(path) == gzipExtension {
+		gzipReader, err := gzip.NewReader(file)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't decompress %q: %w", path, err)
+		}
+		defer gzipReader.Close()
+		reader = gzipReader
+	}
+
+	var (
+		captureReader = NewReader(reader)
+		records       []*Record
+	)
+	for {
+		record, err := captureReader.Next()
+		if errors.Is(err, io.EOF) {
+			return records, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("couldn't read record %d of %q: %w", len(records), path, err)
+		}
+		records = append(records, record)
+	}
+}
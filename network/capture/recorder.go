@@ -0,0 +1,108 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package capture
+
+import (
+	"io"
+
+	"go.uber.org/zap"
+	"gopkg.in/natefinch/lumberjack.v2"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/timer/mockable"
+)
+
+var (
+	_ Recorder = (*recorder)(nil)
+	_ Recorder = noRecorder{}
+)
+
+// Recorder writes the messages exchanged with peers to a capture.
+type Recorder interface {
+	// Record the [msgBytes] that were sent to or received from [nodeID].
+	Record(direction Direction, nodeID ids.NodeID, msgBytes []byte)
+
+	// Close flushes and closes the capture.
+	Close() error
+}
+
+type Config struct {
+	// File that messages are written to. If empty, messages aren't captured.
+	File string `json:"file"`
+
+	// MaxSize is the maximum size, in megabytes, of a capture file before it
+	// is rotated.
+	MaxSize int `json:"maxSize"`
+
+	// MaxFiles is the maximum number of rotated capture files to keep. If 0,
+	// all rotated files are kept.
+	MaxFiles int `json:"maxFiles"`
+
+	// Compress rotated capture files with gzip.
+	Compress bool `json:"compress"`
+}
+
+type recorder struct {
+	log    logging.Logger
+	clock  mockable.Clock
+	writer io.WriteCloser
+}
+
+// NewRecorder returns a Recorder that writes to the rotating file described by
+// [config].
+func NewRecorder(config Config, log logging.Logger) Recorder {
+	return newRecorder(
+		&lumberjack.Logger{
+			Filename:   config.File,
+			MaxSize:    config.MaxSize,  // megabytes
+			MaxBackups: config.MaxFiles, // files
+			Compress:   config.Compress,
+		},
+		log,
+	)
+}
+
+func newRecorder(writer io.WriteCloser, log logging.Logger) *recorder {
+	return &recorder{
+		log:    log,
+		writer: writer,
+	}
+}
+
+func (r *recorder) Record(direction Direction, nodeID ids.NodeID, msgBytes []byte) {
+	record := Record{
+		Time:      r.clock.Time(),
+		Direction: direction,
+		NodeID:    nodeID,
+		Bytes:     msgBytes,
+	}
+
+	// Each record is written with a single call so that records are never
+	// interleaved or split across rotated files.
+	if _, err := r.writer.Write(record.bytes()); err != nil {
+		r.log.Warn("failed to capture message",
+			zap.Stringer("direction", direction),
+			zap.Stringer("nodeID", nodeID),
+			zap.Error(err),
+		)
+	}
+}
+
+func (r *recorder) Close() error {
+	return r.writer.Close()
+}
+
+type noRecorder struct{}
+
+// NewNoRecorder returns a Recorder that drops all messages.
+func NewNoRecorder() Recorder {
+	return noRecorder{}
+}
+
+func (noRecorder) Record(Direction, ids.NodeID, []byte) {}
+
+func (noRecorder) Close() error {
+	return nil
+}
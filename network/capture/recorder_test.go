@@ -0,0 +1,144 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package capture
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/perms"
+)
+
+func TestRecorder(t *testing.T) {
+	require := require.New(t)
+
+	file := filepath.Join(t.TempDir(), "capture")
+	r := NewRecorder(
+		Config{
+			File:    file,
+			MaxSize: 1,
+		},
+		logging.NoLog{},
+	).(*recorder)
+
+	now := time.Unix(0, 123456789)
+	r.clock.Set(now)
+
+	nodeID0 := ids.GenerateTestNodeID()
+	nodeID1 := ids.GenerateTestNodeID()
+	r.Record(Inbound, nodeID0, []byte{1, 2, 3})
+	r.Record(Outbound, nodeID1, []byte{4})
+	r.Record(Inbound, nodeID1, nil)
+	require.NoError(r.Close())
+
+	records, err := ReadFile(file)
+	require.NoError(err)
+	require.Equal(
+		[]*Record{
+			{
+				Time:      now,
+				Direction: Inbound,
+				NodeID:    nodeID0,
+				Bytes:     []byte{1, 2, 3},
+			},
+			{
+				Time:      now,
+				Direction: Outbound,
+				NodeID:    nodeID1,
+				Bytes:     []byte{4},
+			},
+			{
+				Time:      now,
+				Direction: Inbound,
+				NodeID:    nodeID1,
+				Bytes:     []byte{},
+			},
+		},
+		records,
+	)
+}
+
+func TestReadFileCompressed(t *testing.T) {
+	require := require.New(t)
+
+	record := &Record{
+		Time:      time.Unix(0, 1),
+		Direction: Outbound,
+		NodeID:    ids.GenerateTestNodeID(),
+		Bytes:     []byte{1, 2, 3},
+	}
+
+	compressed := &bytes.Buffer{}
+	writer := gzip.NewWriter(compressed)
+	_, err := writer.Write(record.bytes())
+	require.NoError(err)
+	require.NoError(writer.Close())
+
+	file := filepath.Join(t.TempDir(), "capture.gz")
+	require.NoError(os.WriteFile(file, compressed.Bytes(), perms.ReadWrite))
+
+	records, err := ReadFile(file)
+	require.NoError(err)
+	require.Equal([]*Record{record}, records)
+}
+
+func TestReaderErrors(t *testing.T) {
+	record := &Record{
+		Time:      time.Unix(0, 1),
+		Direction: Inbound,
+		NodeID:    ids.GenerateTestNodeID(),
+		Bytes:     []byte{1, 2, 3},
+	}
+	recordBytes := record.bytes()
+
+	unknownDirectionBytes := record.bytes()
+	unknownDirectionBytes[8] = 2
+
+	tests := []struct {
+		name        string
+		bytes       []byte
+		expectedErr error
+	}{
+		{
+			name:        "empty",
+			bytes:       nil,
+			expectedErr: io.EOF,
+		},
+		{
+			name:        "truncated header",
+			bytes:       recordBytes[:headerLen-1],
+			expectedErr: io.ErrUnexpectedEOF,
+		},
+		{
+			name:        "truncated message",
+			bytes:       recordBytes[:len(recordBytes)-1],
+			expectedErr: io.ErrUnexpectedEOF,
+		},
+		{
+			name:        "missing message",
+			bytes:       recordBytes[:headerLen],
+			expectedErr: io.ErrUnexpectedEOF,
+		},
+		{
+			name:        "unknown direction",
+			bytes:       unknownDirectionBytes,
+			expectedErr: errUnknownDirection,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := NewReader(bytes.NewReader(test.bytes)).Next()
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
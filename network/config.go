@@ -9,7 +9,9 @@ import (
 	"time"
 
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/network/capture"
 	"github.com/ava-labs/avalanchego/network/dialer"
+	"github.com/ava-labs/avalanchego/network/reputation"
 	"github.com/ava-labs/avalanchego/network/throttling"
 	"github.com/ava-labs/avalanchego/snow/networking/tracker"
 	"github.com/ava-labs/avalanchego/snow/uptime"
@@ -100,7 +102,9 @@ type Config struct {
 	PeerListGossipConfig `json:"peerListGossipConfig"`
 	TimeoutConfig        `json:"timeoutConfigs"`
 	DelayConfig          `json:"delayConfig"`
-	ThrottlerConfig      ThrottlerConfig `json:"throttlerConfig"`
+	ThrottlerConfig      ThrottlerConfig   `json:"throttlerConfig"`
+	ReputationConfig     reputation.Config `json:"reputationConfig"`
+	CaptureConfig        capture.Config    `json:"captureConfig"`
 
 	ProxyEnabled           bool          `json:"proxyEnabled"`
 	ProxyReadHeaderTimeout time.Duration `json:"proxyReadHeaderTimeout"`
@@ -128,7 +132,7 @@ type Config struct {
 	// TLSKey is this node's TLS key that is used to sign IPs.
 	TLSKey crypto.Signer `json:"-"`
 	// BLSKey is this node's BLS key that is used to sign IPs.
-	BLSKey *bls.SecretKey `json:"-"`
+	BLSKey bls.Signer `json:"-"`
 
 	// TrackedSubnets of the node.
 	TrackedSubnets set.Set[ids.ID]    `json:"-"`
@@ -153,6 +157,14 @@ type Config struct {
 	// the network negatively.
 	RequireValidatorToConnect bool `json:"requireValidatorToConnect"`
 
+	// ConnectionPolicy restricts the peers this node connects to. If nil, all
+	// peers are allowed.
+	ConnectionPolicy *ConnectionPolicy `json:"connectionPolicy"`
+
+	// ConnectionPolicyFile is the file that [ConnectionPolicy] was read from.
+	// If empty, the connection policy can't be reloaded.
+	ConnectionPolicyFile string `json:"connectionPolicyFile"`
+
 	// MaximumInboundMessageTimeout is the maximum deadline duration in a
 	// message. Messages sent by clients setting values higher than this value
 	// will be reset to this value.
@@ -176,4 +188,11 @@ type Config struct {
 	// Specifies how much disk usage each peer can cause before
 	// we rate-limit them.
 	DiskTargeter tracker.Targeter `json:"-"`
+
+	// Scores peers based on their behavior. Peers with low scores aren't
+	// sampled and may be disconnected.
+	Reputation reputation.Tracker `json:"-"`
+
+	// Records the messages exchanged with peers.
+	Capture capture.Recorder `json:"-"`
 }
@@ -0,0 +1,150 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package network
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/ips"
+	"github.com/ava-labs/avalanchego/utils/set"
+)
+
+var (
+	errDeniedSentry     = errors.New("sentry is denied")
+	errDuplicateSentry  = errors.New("duplicate sentry")
+	errInvalidSentryIP  = errors.New("invalid sentry IP")
+	errSentryIsUpstream = errors.New("sentry is an upstream")
+)
+
+// Sentry is a node that shields this node from the rest of the network. This
+// node only connects to its sentries, so its IP is never revealed to other
+// peers.
+type Sentry struct {
+	ID ids.NodeID `json:"id"`
+	IP ips.IPDesc `json:"ip"`
+}
+
+// ConnectionPolicy restricts the peers this node connects to.
+//
+// The zero value allows connections with every peer.
+type ConnectionPolicy struct {
+	// AllowedNodeIDs, if non-empty, are the only nodes this node may connect
+	// to.
+	AllowedNodeIDs set.Set[ids.NodeID] `json:"allowedNodeIDs"`
+	// DeniedNodeIDs are the nodes this node never connects to.
+	DeniedNodeIDs set.Set[ids.NodeID] `json:"deniedNodeIDs"`
+	// AllowedIPs, if non-empty, are the only IP ranges this node may connect
+	// to.
+	AllowedIPs []netip.Prefix `json:"allowedIPs"`
+	// DeniedIPs are the IP ranges this node never connects to.
+	DeniedIPs []netip.Prefix `json:"deniedIPs"`
+
+	// Sentries, if non-empty, are the only nodes this node connects to. This
+	// node always attempts to stay connected to its sentries.
+	Sentries []Sentry `json:"sentries"`
+	// Upstreams are the nodes that this node is a sentry for. Upstreams are
+	// always allowed to connect and their IPs are never gossiped.
+	Upstreams set.Set[ids.NodeID] `json:"upstreams"`
+}
+
+// ParseConnectionPolicy parses and verifies the JSON encoded policy in
+// [policyBytes].
+func ParseConnectionPolicy(policyBytes []byte) (*ConnectionPolicy, error) {
+	policy := &ConnectionPolicy{}
+	if err := json.Unmarshal(policyBytes, policy); err != nil {
+		return nil, fmt.Errorf("couldn't parse connection policy: %w", err)
+	}
+	return policy, policy.Verify()
+}
+
+// Verify returns an error if the policy could never be satisfied.
+func (p *ConnectionPolicy) Verify() error {
+	sentryIDs := set.NewSet[ids.NodeID](len(p.Sentries))
+	for _, sentry := range p.Sentries {
+		switch {
+		case sentryIDs.Contains(sentry.ID):
+			return fmt.Errorf("%w: %s", errDuplicateSentry, sentry.ID)
+		case ips.IPPort(sentry.IP).IsZero():
+			return fmt.Errorf("%w: %s", errInvalidSentryIP, sentry.ID)
+		case p.DeniedNodeIDs.Contains(sentry.ID):
+			return fmt.Errorf("%w: %s", errDeniedSentry, sentry.ID)
+		case p.Upstreams.Contains(sentry.ID):
+			return fmt.Errorf("%w: %s", errSentryIsUpstream, sentry.ID)
+		}
+		sentryIDs.Add(sentry.ID)
+	}
+	return nil
+}
+
+// AllowNodeID returns true if the policy allows connecting to [nodeID] from
+// an unknown IP.
+func (p *ConnectionPolicy) AllowNodeID(nodeID ids.NodeID) bool {
+	return p.Allow(nodeID, nil)
+}
+
+// Allow returns true if the policy allows connecting to [nodeID] at [ip]. If
+// [ip] is nil, only the rules on the nodeID are applied.
+func (p *ConnectionPolicy) Allow(nodeID ids.NodeID, ip net.IP) bool {
+	if p.Upstreams.Contains(nodeID) || p.isSentry(nodeID) {
+		return true
+	}
+	if len(p.Sentries) > 0 {
+		return false
+	}
+	if p.DeniedNodeIDs.Contains(nodeID) {
+		return false
+	}
+	if p.AllowedNodeIDs.Len() > 0 && !p.AllowedNodeIDs.Contains(nodeID) {
+		return false
+	}
+
+	addr, ok := netip.AddrFromSlice(ip)
+	if !ok {
+		return true
+	}
+	addr = addr.Unmap()
+	if containsAddr(p.DeniedIPs, addr) {
+		return false
+	}
+	return len(p.AllowedIPs) == 0 || containsAddr(p.AllowedIPs, addr)
+}
+
+// Gossipable returns true if the IP of [nodeID] may be gossiped to other
+// peers.
+func (p *ConnectionPolicy) Gossipable(nodeID ids.NodeID) bool {
+	return !p.Upstreams.Contains(nodeID)
+}
+
+func (p *ConnectionPolicy) isSentry(nodeID ids.NodeID) bool {
+	for _, sentry := range p.Sentries {
+		if sentry.ID == nodeID {
+			return true
+		}
+	}
+	return false
+}
+
+func containsAddr(prefixes []netip.Prefix, addr netip.Addr) bool {
+	for _, prefix := range prefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseRemoteIP returns the IP of the remote address [addr], or nil if it can't
+// be parsed.
+func parseRemoteIP(addr string) net.IP {
+	ip, err := ips.ToIPPort(addr)
+	if err != nil {
+		return nil
+	}
+	return ip.IP
+}
@@ -0,0 +1,179 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package network
+
+import (
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+)
+
+func TestParseConnectionPolicy(t *testing.T) {
+	nodeID0 := ids.GenerateTestNodeID()
+	nodeID1 := ids.GenerateTestNodeID()
+
+	tests := []struct {
+		name        string
+		policy      string
+		expectedErr error
+	}{
+		{
+			name:   "empty",
+			policy: `{}`,
+		},
+		{
+			name: "valid",
+			policy: fmt.Sprintf(`{
+				"allowedNodeIDs": ["%s"],
+				"deniedIPs": ["10.0.0.0/8"],
+				"sentries": [{"id": "%s", "ip": "1.2.3.4:9651"}]
+			}`, nodeID0, nodeID1),
+		},
+		{
+			name:        "duplicate sentry",
+			policy:      fmt.Sprintf(`{"sentries": [{"id": "%s", "ip": "1.2.3.4:9651"}, {"id": "%s", "ip": "1.2.3.5:9651"}]}`, nodeID0, nodeID0),
+			expectedErr: errDuplicateSentry,
+		},
+		{
+			name:        "sentry without IP",
+			policy:      fmt.Sprintf(`{"sentries": [{"id": "%s"}]}`, nodeID0),
+			expectedErr: errInvalidSentryIP,
+		},
+		{
+			name:        "denied sentry",
+			policy:      fmt.Sprintf(`{"deniedNodeIDs": ["%s"], "sentries": [{"id": "%s", "ip": "1.2.3.4:9651"}]}`, nodeID0, nodeID0),
+			expectedErr: errDeniedSentry,
+		},
+		{
+			name:        "sentry is upstream",
+			policy:      fmt.Sprintf(`{"upstreams": ["%s"], "sentries": [{"id": "%s", "ip": "1.2.3.4:9651"}]}`, nodeID0, nodeID0),
+			expectedErr: errSentryIsUpstream,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ParseConnectionPolicy([]byte(test.policy))
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
+
+func TestConnectionPolicyAllow(t *testing.T) {
+	allowedNodeID := ids.GenerateTestNodeID()
+	deniedNodeID := ids.GenerateTestNodeID()
+	otherNodeID := ids.GenerateTestNodeID()
+	sentryNodeID := ids.GenerateTestNodeID()
+	upstreamNodeID := ids.GenerateTestNodeID()
+
+	publicIP := net.ParseIP("1.2.3.4")
+	privateIP := net.ParseIP("10.0.0.1")
+	deniedIP := net.ParseIP("10.1.0.1")
+
+	tests := []struct {
+		name     string
+		policy   string
+		nodeID   ids.NodeID
+		ip       net.IP
+		expected bool
+	}{
+		{
+			name:     "empty policy",
+			policy:   `{}`,
+			nodeID:   otherNodeID,
+			ip:       publicIP,
+			expected: true,
+		},
+		{
+			name:     "denied nodeID",
+			policy:   fmt.Sprintf(`{"deniedNodeIDs": ["%s"]}`, deniedNodeID),
+			nodeID:   deniedNodeID,
+			expected: false,
+		},
+		{
+			name:     "allowed nodeID",
+			policy:   fmt.Sprintf(`{"allowedNodeIDs": ["%s"]}`, allowedNodeID),
+			nodeID:   allowedNodeID,
+			ip:       publicIP,
+			expected: true,
+		},
+		{
+			name:     "not allowed nodeID",
+			policy:   fmt.Sprintf(`{"allowedNodeIDs": ["%s"]}`, allowedNodeID),
+			nodeID:   otherNodeID,
+			expected: false,
+		},
+		{
+			name:     "denied IP",
+			policy:   `{"allowedIPs": ["10.0.0.0/8"], "deniedIPs": ["10.1.0.0/16"]}`,
+			nodeID:   otherNodeID,
+			ip:       deniedIP,
+			expected: false,
+		},
+		{
+			name:     "allowed IP",
+			policy:   `{"allowedIPs": ["10.0.0.0/8"], "deniedIPs": ["10.1.0.0/16"]}`,
+			nodeID:   otherNodeID,
+			ip:       privateIP,
+			expected: true,
+		},
+		{
+			name:     "not allowed IP",
+			policy:   `{"allowedIPs": ["10.0.0.0/8"]}`,
+			nodeID:   otherNodeID,
+			ip:       publicIP,
+			expected: false,
+		},
+		{
+			name:     "unknown IP",
+			policy:   `{"allowedIPs": ["10.0.0.0/8"]}`,
+			nodeID:   otherNodeID,
+			expected: true,
+		},
+		{
+			name:     "sentry",
+			policy:   fmt.Sprintf(`{"deniedIPs": ["0.0.0.0/0"], "sentries": [{"id": "%s", "ip": "1.2.3.4:9651"}]}`, sentryNodeID),
+			nodeID:   sentryNodeID,
+			ip:       publicIP,
+			expected: true,
+		},
+		{
+			name:     "not a sentry",
+			policy:   fmt.Sprintf(`{"allowedNodeIDs": ["%s"], "sentries": [{"id": "%s", "ip": "1.2.3.4:9651"}]}`, allowedNodeID, sentryNodeID),
+			nodeID:   allowedNodeID,
+			ip:       publicIP,
+			expected: false,
+		},
+		{
+			name:     "upstream",
+			policy:   fmt.Sprintf(`{"allowedNodeIDs": ["%s"], "upstreams": ["%s"]}`, allowedNodeID, upstreamNodeID),
+			nodeID:   upstreamNodeID,
+			ip:       privateIP,
+			expected: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			policy, err := ParseConnectionPolicy([]byte(test.policy))
+			require.NoError(err)
+			require.Equal(test.expected, policy.Allow(test.nodeID, test.ip))
+		})
+	}
+}
+
+func TestConnectionPolicyGossipable(t *testing.T) {
+	require := require.New(t)
+
+	upstreamNodeID := ids.GenerateTestNodeID()
+	policy, err := ParseConnectionPolicy([]byte(fmt.Sprintf(`{"upstreams": ["%s"]}`, upstreamNodeID)))
+	require.NoError(err)
+
+	require.False(policy.Gossipable(upstreamNodeID))
+	require.True(policy.Gossipable(ids.GenerateTestNodeID()))
+}
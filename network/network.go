@@ -29,6 +29,7 @@ import (
 	"github.com/ava-labs/avalanchego/snow/networking/router"
 	"github.com/ava-labs/avalanchego/snow/networking/sender"
 	"github.com/ava-labs/avalanchego/subnets"
+	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/utils/bloom"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/ips"
@@ -87,6 +88,10 @@ type Network interface {
 	// NodeUptime returns given node's [subnetID] UptimeResults in the view of
 	// this node's peer validators.
 	NodeUptime(subnetID ids.ID) (UptimeResult, error)
+
+	// SetConnectionPolicy replaces the current connection policy. Peers that
+	// are no longer allowed are disconnected.
+	SetConnectionPolicy(policy *ConnectionPolicy)
 }
 
 type UptimeResult struct {
@@ -152,6 +157,10 @@ type network struct {
 	connectedPeers  peer.Set
 	closing         bool
 
+	// connectionPolicy restricts the peers this node connects to. It may be
+	// replaced at runtime with [SetConnectionPolicy].
+	connectionPolicy utils.Atomic[*ConnectionPolicy]
+
 	// router is notified about all peer [Connected] and [Disconnected] events
 	// as well as all non-handshake peer messages.
 	//
@@ -209,6 +218,7 @@ func NewNetwork(
 		config.ResourceTracker,
 		config.CPUTargeter,
 		config.DiskTargeter,
+		config.Reputation,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("initializing inbound message throttler failed with: %w", err)
@@ -272,8 +282,11 @@ func NewNetwork(
 		MaxClockDifference:   config.MaxClockDifference,
 		SupportedACPs:        config.SupportedACPs.List(),
 		ObjectedACPs:         config.ObjectedACPs.List(),
+		CompressionType:      config.CompressionType,
 		ResourceTracker:      config.ResourceTracker,
 		UptimeCalculator:     config.UptimeCalculator,
+		Reputation:           config.Reputation,
+		Capture:              config.Capture,
 		IPSigner:             peer.NewIPSigner(config.MyIPPort, config.TLSKey, config.BLSKey),
 	}
 
@@ -306,6 +319,12 @@ func NewNetwork(
 		router:          router,
 	}
 	n.peerConfig.Network = n
+
+	connectionPolicy := config.ConnectionPolicy
+	if connectionPolicy == nil {
+		connectionPolicy = &ConnectionPolicy{}
+	}
+	n.SetConnectionPolicy(connectionPolicy)
 	return n, nil
 }
 
@@ -457,6 +476,7 @@ func (n *network) Connected(nodeID ids.NodeID) {
 	n.ipTracker.Connected(newIP)
 
 	n.metrics.markConnected(peer)
+	n.config.Reputation.Connected(nodeID)
 
 	peerVersion := peer.Version()
 	n.router.Connected(nodeID, peerVersion, constants.PrimaryNetworkID)
@@ -470,10 +490,17 @@ func (n *network) Connected(nodeID ids.NodeID) {
 }
 
 // AllowConnection returns true if this node should have a connection to the
-// provided nodeID. If the node is attempting to connect to the minimum number
-// of peers, then it should only connect if this node is a validator, or the
-// peer is a validator/beacon.
+// provided nodeID. Peers with a low reputation or that are disallowed by the
+// connection policy are never allowed. If the node is attempting to connect to
+// the minimum number of peers, then it should only connect if this node is a
+// validator, or the peer is a validator/beacon.
 func (n *network) AllowConnection(nodeID ids.NodeID) bool {
+	if n.config.Reputation.ShouldDisconnect(nodeID) {
+		return false
+	}
+	if !n.connectionPolicy.Get().AllowNodeID(nodeID) {
+		return false
+	}
 	if !n.config.RequireValidatorToConnect {
 		return true
 	}
@@ -514,12 +541,22 @@ func (n *network) KnownPeers() ([]byte, []byte) {
 }
 
 func (n *network) Peers(except ids.NodeID, knownPeers *bloom.ReadFilter, salt []byte) []*ips.ClaimedIPPort {
-	return n.ipTracker.GetGossipableIPs(
+	gossipableIPs := n.ipTracker.GetGossipableIPs(
 		except,
 		knownPeers,
 		salt,
 		int(n.config.PeerListNumValidatorIPs),
 	)
+
+	// The IPs of the nodes this node is a sentry for must never be revealed.
+	connectionPolicy := n.connectionPolicy.Get()
+	filteredIPs := gossipableIPs[:0]
+	for _, ip := range gossipableIPs {
+		if connectionPolicy.Gossipable(ip.NodeID) {
+			filteredIPs = append(filteredIPs, ip)
+		}
+	}
+	return filteredIPs
 }
 
 // Dispatch starts accepting connections from other nodes attempting to connect
@@ -600,6 +637,15 @@ func (n *network) Dispatch() error {
 }
 
 func (n *network) ManuallyTrack(nodeID ids.NodeID, ip ips.IPPort) {
+	if !n.connectionPolicy.Get().Allow(nodeID, ip.IP) {
+		n.peerConfig.Log.Debug("not tracking peer",
+			zap.String("reason", "disallowed by connection policy"),
+			zap.Stringer("nodeID", nodeID),
+			zap.Stringer("peerIP", ip),
+		)
+		return
+	}
+
 	n.ipTracker.ManuallyTrack(nodeID)
 
 	n.peersLock.Lock()
@@ -621,6 +667,45 @@ func (n *network) ManuallyTrack(nodeID ids.NodeID, ip ips.IPPort) {
 	}
 }
 
+func (n *network) SetConnectionPolicy(policy *ConnectionPolicy) {
+	n.connectionPolicy.Set(policy)
+
+	for _, sentry := range policy.Sentries {
+		n.ManuallyTrack(sentry.ID, ips.IPPort(sentry.IP))
+	}
+
+	n.peersLock.RLock()
+	defer n.peersLock.RUnlock()
+
+	for i := 0; i < n.connectingPeers.Len(); i++ {
+		peer, _ := n.connectingPeers.GetByIndex(i)
+		nodeID := peer.ID()
+		if policy.AllowNodeID(nodeID) {
+			continue
+		}
+
+		n.peerConfig.Log.Debug("disconnecting from peer",
+			zap.String("reason", "disallowed by connection policy"),
+			zap.Stringer("nodeID", nodeID),
+		)
+		peer.StartClose()
+	}
+
+	for i := 0; i < n.connectedPeers.Len(); i++ {
+		peer, _ := n.connectedPeers.GetByIndex(i)
+		nodeID := peer.ID()
+		if policy.Allow(nodeID, parseRemoteIP(peer.Info().IP)) {
+			continue
+		}
+
+		n.peerConfig.Log.Debug("disconnecting from peer",
+			zap.String("reason", "disallowed by connection policy"),
+			zap.Stringer("nodeID", nodeID),
+		)
+		peer.StartClose()
+	}
+}
+
 func (n *network) track(ip *ips.ClaimedIPPort) error {
 	// To avoid signature verification when the IP isn't needed, we
 	// optimistically filter out IPs. This can result in us not tracking an IP
@@ -630,7 +715,7 @@ func (n *network) track(ip *ips.ClaimedIPPort) error {
 	//
 	// Note: Avoiding signature verification when the IP isn't needed is a
 	// **significant** performance optimization.
-	if !n.ipTracker.ShouldVerifyIP(ip) {
+	if !n.ipTracker.ShouldVerifyIP(ip) || !n.connectionPolicy.Get().Allow(ip.NodeID, ip.IPPort.IP) {
 		n.metrics.numUselessPeerListBytes.Add(float64(ip.Size()))
 		return nil
 	}
@@ -745,6 +830,11 @@ func (n *network) samplePeers(
 				return false
 			}
 
+			// Don't sample peers with a low reputation
+			if !n.config.Reputation.ShouldSample(peerID) {
+				return false
+			}
+
 			_, isValidator := n.config.Validators.GetValidator(subnetID, peerID)
 			// check if the peer is allowed to connect to the subnet
 			if !allower.IsAllowed(peerID, isValidator) {
@@ -792,6 +882,7 @@ func (n *network) disconnectedFromConnecting(nodeID ids.NodeID) {
 func (n *network) disconnectedFromConnected(peer peer.Peer, nodeID ids.NodeID) {
 	n.ipTracker.Disconnected(nodeID)
 	n.router.Disconnected(nodeID)
+	n.config.Reputation.Disconnected(nodeID)
 
 	n.peersLock.Lock()
 	defer n.peersLock.Unlock()
@@ -854,7 +945,7 @@ func (n *network) dial(nodeID ids.NodeID, ip *trackedIP) {
 			// trackedIPs and this goroutine. This prevents a memory leak when
 			// the tracked nodeID leaves the validator set and is never able to
 			// be connected to.
-			if !n.ipTracker.WantsConnection(nodeID) {
+			if !n.ipTracker.WantsConnection(nodeID) || !n.connectionPolicy.Get().Allow(nodeID, ip.ip.IP) {
 				// Typically [n.trackedIPs[nodeID]] will already equal [ip], but
 				// the reference to [ip] is refreshed to avoid any potential
 				// race conditions before removing the entry.
@@ -995,6 +1086,16 @@ func (n *network) upgrade(conn net.Conn, upgrader peer.Upgrader) error {
 		return nil
 	}
 
+	if !n.connectionPolicy.Get().Allow(nodeID, parseRemoteIP(tlsConn.RemoteAddr().String())) {
+		_ = tlsConn.Close()
+		n.peerConfig.Log.Verbo(
+			"dropping connection",
+			zap.String("reason", "disallowed by connection policy"),
+			zap.Stringer("nodeID", nodeID),
+		)
+		return nil
+	}
+
 	n.peersLock.Lock()
 	if n.closing {
 		n.peersLock.Unlock()
@@ -1096,6 +1197,12 @@ func (n *network) StartClose() {
 			peer, _ := n.connectedPeers.GetByIndex(i)
 			peer.StartClose()
 		}
+
+		if err := n.config.Reputation.Persist(); err != nil {
+			n.peerConfig.Log.Error("failed to persist peer reputations",
+				zap.Error(err),
+			)
+		}
 	})
 }
 
@@ -1158,9 +1265,11 @@ func (n *network) runTimers() {
 	pullGossipPeerlists := time.NewTicker(n.config.PeerListPullGossipFreq)
 	resetPeerListBloom := time.NewTicker(n.config.PeerListBloomResetFreq)
 	updateUptimes := time.NewTicker(n.config.UptimeMetricFreq)
+	updateReputations := time.NewTicker(n.config.ReputationConfig.UpdateFrequency)
 	defer func() {
 		resetPeerListBloom.Stop()
 		updateUptimes.Stop()
+		updateReputations.Stop()
 	}()
 
 	for {
@@ -1199,10 +1308,40 @@ func (n *network) runTimers() {
 				n.metrics.nodeSubnetUptimeWeightedAverage.WithLabelValues(subnetIDStr).Set(result.WeightedAveragePercentage)
 				n.metrics.nodeSubnetUptimeRewardingStake.WithLabelValues(subnetIDStr).Set(result.RewardingStakePercentage)
 			}
+		case <-updateReputations.C:
+			n.updateReputations()
 		}
 	}
 }
 
+// updateReputations persists the reputation scores and disconnects from peers
+// with a low reputation.
+func (n *network) updateReputations() {
+	if err := n.config.Reputation.Persist(); err != nil {
+		n.peerConfig.Log.Error("failed to persist peer reputations",
+			zap.Error(err),
+		)
+	}
+
+	n.peersLock.RLock()
+	defer n.peersLock.RUnlock()
+
+	for i := 0; i < n.connectedPeers.Len(); i++ {
+		peer, _ := n.connectedPeers.GetByIndex(i)
+		nodeID := peer.ID()
+		if !n.config.Reputation.ShouldDisconnect(nodeID) {
+			continue
+		}
+
+		n.peerConfig.Log.Debug("disconnecting from peer",
+			zap.String("reason", "low reputation"),
+			zap.Stringer("nodeID", nodeID),
+			zap.Float64("score", n.config.Reputation.Score(nodeID)),
+		)
+		peer.StartClose()
+	}
+}
+
 // pullGossipPeerLists requests validators from peers in the network
 func (n *network) pullGossipPeerLists() {
 	peers := n.samplePeers(
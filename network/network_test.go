@@ -14,10 +14,13 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/stretchr/testify/require"
 
+	"github.com/ava-labs/avalanchego/database/memdb"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/message"
+	"github.com/ava-labs/avalanchego/network/capture"
 	"github.com/ava-labs/avalanchego/network/dialer"
 	"github.com/ava-labs/avalanchego/network/peer"
+	"github.com/ava-labs/avalanchego/network/reputation"
 	"github.com/ava-labs/avalanchego/network/throttling"
 	"github.com/ava-labs/avalanchego/snow/engine/common"
 	"github.com/ava-labs/avalanchego/snow/networking/router"
@@ -26,6 +29,7 @@ import (
 	"github.com/ava-labs/avalanchego/snow/validators"
 	"github.com/ava-labs/avalanchego/staking"
 	"github.com/ava-labs/avalanchego/subnets"
+	"github.com/ava-labs/avalanchego/utils/bloom"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/crypto/bls"
 	"github.com/ava-labs/avalanchego/utils/ips"
@@ -90,6 +94,11 @@ var (
 		},
 		MaxInboundConnsPerSec: 100,
 	}
+	defaultReputationConfig = reputation.Config{
+		HalfLife:        time.Hour,
+		UpdateFrequency: time.Minute,
+	}
+
 	defaultDialerConfig = dialer.Config{
 		ThrottleRps:       100,
 		ConnectionTimeout: time.Second,
@@ -101,6 +110,7 @@ var (
 		TimeoutConfig:        defaultTimeoutConfig,
 		DelayConfig:          defaultDelayConfig,
 		ThrottlerConfig:      defaultThrottlerConfig,
+		ReputationConfig:     defaultReputationConfig,
 
 		DialerConfig: defaultDialerConfig,
 
@@ -122,6 +132,8 @@ var (
 		ResourceTracker:              newDefaultResourceTracker(),
 		CPUTargeter:                  nil, // Set in init
 		DiskTargeter:                 nil, // Set in init
+		Reputation:                   reputation.NewNoTracker(),
+		Capture:                      capture.NewNoRecorder(),
 	}
 )
 
@@ -181,7 +193,7 @@ func newTestNetwork(t *testing.T, count int) (*testDialer, []*testListener, []id
 		config.MyNodeID = nodeID
 		config.MyIPPort = ip
 		config.TLSKey = tlsCert.PrivateKey.(crypto.Signer)
-		config.BLSKey = blsKey
+		config.BLSKey = bls.NewLocalSigner(blsKey)
 
 		listeners[i] = listener
 		nodeIDs[i] = nodeID
@@ -749,3 +761,228 @@ func TestAllowConnectionAsAValidator(t *testing.T) {
 	}
 	wg.Wait()
 }
+
+func TestReputation(t *testing.T) {
+	require := require.New(t)
+
+	dialer, listeners, nodeIDs, configs := newTestNetwork(t, 2)
+
+	reputationConfig := defaultReputationConfig
+	reputationConfig.SampleThreshold = .5
+	reputationConfig.DisconnectThreshold = .1
+	reputationDB := memdb.New()
+	reputationTracker, err := reputation.NewTracker(reputationConfig, reputationDB)
+	require.NoError(err)
+
+	// The first node timed out twice, so it shouldn't be sampled.
+	reputationTracker.RegisterTimeout(nodeIDs[0])
+	reputationTracker.RegisterTimeout(nodeIDs[0])
+
+	networks := make([]*network, len(configs))
+	for i, config := range configs {
+		msgCreator := newMessageCreator(t)
+		registry := prometheus.NewRegistry()
+
+		vdrs := validators.NewManager()
+		for _, nodeID := range nodeIDs {
+			require.NoError(vdrs.AddStaker(constants.PrimaryNetworkID, nodeID, nil, ids.GenerateTestID(), 1))
+		}
+
+		config := config
+
+		config.Beacons = validators.NewManager()
+		config.Validators = vdrs
+		if i == 1 {
+			config.Reputation = reputationTracker
+		}
+
+		net, err := NewNetwork(
+			config,
+			msgCreator,
+			registry,
+			logging.NoLog{},
+			listeners[i],
+			dialer,
+			&testHandler{
+				InboundHandler: nil,
+				ConnectedF:     nil,
+				DisconnectedF:  nil,
+			},
+		)
+		require.NoError(err)
+		networks[i] = net.(*network)
+	}
+
+	wg := sync.WaitGroup{}
+	wg.Add(len(networks))
+	for i, net := range networks {
+		if i != 0 {
+			config := configs[0]
+			net.ManuallyTrack(config.MyNodeID, config.MyIPPort.IPPort())
+		}
+
+		go func(net Network) {
+			defer wg.Done()
+
+			require.NoError(net.Dispatch())
+		}(net)
+	}
+
+	network := networks[1]
+	isConnected := func() bool {
+		network.peersLock.RLock()
+		defer network.peersLock.RUnlock()
+
+		_, contains := network.connectedPeers.GetByID(nodeIDs[0])
+		return contains
+	}
+	require.Eventually(isConnected, 10*time.Second, 50*time.Millisecond)
+
+	sampled := network.samplePeers(
+		common.SendConfig{
+			Validators: 1,
+		},
+		constants.PrimaryNetworkID,
+		subnets.NoOpAllower,
+	)
+	require.Empty(sampled)
+
+	// After sending invalid messages, the first node is disconnected and isn't
+	// allowed to reconnect.
+	reputationTracker.RegisterInvalidMessage(nodeIDs[0])
+	reputationTracker.RegisterInvalidMessage(nodeIDs[0])
+	require.False(network.AllowConnection(nodeIDs[0]))
+
+	network.updateReputations()
+	require.Eventually(
+		func() bool {
+			return !isConnected()
+		},
+		10*time.Second,
+		50*time.Millisecond,
+	)
+
+	for _, net := range networks {
+		net.StartClose()
+	}
+	wg.Wait()
+
+	// The scores were persisted on close.
+	reputationTracker, err = reputation.NewTracker(reputationConfig, reputationDB)
+	require.NoError(err)
+	require.True(reputationTracker.ShouldDisconnect(nodeIDs[0]))
+}
+
+func TestSentryConnectionPolicy(t *testing.T) {
+	require := require.New(t)
+
+	dialer, listeners, nodeIDs, configs := newTestNetwork(t, 3)
+	validatorNodeID, sentryNodeID, publicNodeID := nodeIDs[0], nodeIDs[1], nodeIDs[2]
+
+	// The validator only connects to its sentry, and the sentry never reveals
+	// the validator's IP.
+	configs[0].ConnectionPolicy = &ConnectionPolicy{
+		Sentries: []Sentry{{
+			ID: sentryNodeID,
+			IP: ips.IPDesc(configs[1].MyIPPort.IPPort()),
+		}},
+	}
+	configs[1].ConnectionPolicy = &ConnectionPolicy{
+		Upstreams: set.Of(validatorNodeID),
+	}
+
+	networks := make([]*network, len(configs))
+	for i, config := range configs {
+		msgCreator := newMessageCreator(t)
+		registry := prometheus.NewRegistry()
+
+		vdrs := validators.NewManager()
+		for _, nodeID := range nodeIDs {
+			require.NoError(vdrs.AddStaker(constants.PrimaryNetworkID, nodeID, nil, ids.GenerateTestID(), 1))
+		}
+
+		config := config
+
+		config.Beacons = validators.NewManager()
+		config.Validators = vdrs
+
+		net, err := NewNetwork(
+			config,
+			msgCreator,
+			registry,
+			logging.NoLog{},
+			listeners[i],
+			dialer,
+			&testHandler{
+				InboundHandler: nil,
+				ConnectedF:     nil,
+				DisconnectedF:  nil,
+			},
+		)
+		require.NoError(err)
+		networks[i] = net.(*network)
+	}
+
+	// The public node attempts to connect to both the validator and the
+	// sentry.
+	networks[2].ManuallyTrack(validatorNodeID, configs[0].MyIPPort.IPPort())
+	networks[2].ManuallyTrack(sentryNodeID, configs[1].MyIPPort.IPPort())
+
+	wg := sync.WaitGroup{}
+	wg.Add(len(networks))
+	for _, net := range networks {
+		go func(net Network) {
+			defer wg.Done()
+
+			require.NoError(net.Dispatch())
+		}(net)
+	}
+
+	isConnected := func(net *network, nodeID ids.NodeID) bool {
+		net.peersLock.RLock()
+		defer net.peersLock.RUnlock()
+
+		_, contains := net.connectedPeers.GetByID(nodeID)
+		return contains
+	}
+	require.Eventually(
+		func() bool {
+			return isConnected(networks[1], validatorNodeID) && isConnected(networks[1], publicNodeID)
+		},
+		10*time.Second,
+		50*time.Millisecond,
+	)
+	require.False(isConnected(networks[0], publicNodeID))
+	require.False(networks[0].AllowConnection(publicNodeID))
+
+	// The validator's IP is known by the sentry, but isn't gossiped.
+	containsValidator := func(gossipableIPs []*ips.ClaimedIPPort) bool {
+		for _, ip := range gossipableIPs {
+			if ip.NodeID == validatorNodeID {
+				return true
+			}
+		}
+		return false
+	}
+	require.True(containsValidator(networks[1].ipTracker.GetGossipableIPs(publicNodeID, bloom.EmptyFilter, nil, len(nodeIDs))))
+	require.False(containsValidator(networks[1].Peers(publicNodeID, bloom.EmptyFilter, nil)))
+
+	// Reloading the sentry's policy disconnects the public node.
+	networks[1].SetConnectionPolicy(&ConnectionPolicy{
+		DeniedNodeIDs: set.Of(publicNodeID),
+		Upstreams:     set.Of(validatorNodeID),
+	})
+	require.Eventually(
+		func() bool {
+			return !isConnected(networks[1], publicNodeID)
+		},
+		10*time.Second,
+		50*time.Millisecond,
+	)
+	require.True(isConnected(networks[1], validatorNodeID))
+
+	for _, net := range networks {
+		net.StartClose()
+	}
+	wg.Wait()
+}
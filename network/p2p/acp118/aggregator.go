@@ -0,0 +1,230 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package acp118
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/network/p2p"
+	"github.com/ava-labs/avalanchego/proto/pb/sdk"
+	"github.com/ava-labs/avalanchego/snow/validators"
+	"github.com/ava-labs/avalanchego/utils/crypto/bls"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/platformvm/warp"
+)
+
+// DefaultMaxAttempts is the default number of times a signature is requested
+// from a single validator before it is given up on.
+const DefaultMaxAttempts = 3
+
+var (
+	ErrInvalidQuorum      = errors.New("invalid quorum")
+	ErrInsufficientWeight = errors.New("failed to aggregate sufficient stake weight")
+
+	errInvalidSignature = errors.New("invalid signature")
+)
+
+type result struct {
+	index     int
+	signature *bls.Signature
+	err       error
+}
+
+// NewSignatureAggregator returns an instance of SignatureAggregator.
+// [maxAttempts] is the number of times a signature will be requested from a
+// validator before it is given up on.
+func NewSignatureAggregator(
+	log logging.Logger,
+	client *p2p.Client,
+	state validators.State,
+	maxAttempts int,
+) *SignatureAggregator {
+	return &SignatureAggregator{
+		log:         log,
+		client:      client,
+		state:       state,
+		maxAttempts: maxAttempts,
+	}
+}
+
+// SignatureAggregator aggregates validator signatures for warp messages
+type SignatureAggregator struct {
+	log         logging.Logger
+	client      *p2p.Client
+	state       validators.State
+	maxAttempts int
+}
+
+// AggregateSignatures requests signatures of [message] from the validators of
+// the subnet that [message] originates from at [pChainHeight]. Signatures are
+// requested until at least [quorumNum]/[quorumDen] of the validator weight has
+// signed [message], or every validator has been exhausted.
+func (s *SignatureAggregator) AggregateSignatures(
+	ctx context.Context,
+	message *warp.UnsignedMessage,
+	justification []byte,
+	pChainHeight uint64,
+	quorumNum uint64,
+	quorumDen uint64,
+) (*warp.Message, error) {
+	if quorumDen == 0 || quorumNum > quorumDen {
+		return nil, fmt.Errorf("%w: %d/%d", ErrInvalidQuorum, quorumNum, quorumDen)
+	}
+
+	subnetID, err := s.state.GetSubnetID(ctx, message.SourceChainID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get subnet of chain %s: %w", message.SourceChainID, err)
+	}
+
+	vdrs, totalWeight, err := warp.GetCanonicalValidatorSet(ctx, s.state, pChainHeight, subnetID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get validator set: %w", err)
+	}
+
+	requestBytes, err := proto.Marshal(&sdk.SignatureRequest{
+		Message:       message.Bytes(),
+		Justification: justification,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal signature request: %w", err)
+	}
+
+	var (
+		// At most one request is outstanding per validator, so sends to
+		// [results] never block even after this function returns.
+		results  = make(chan result, len(vdrs))
+		attempts = make([]int, len(vdrs))
+		pending  int
+	)
+
+	// sendRequest requests a signature from the validator at [index], moving
+	// on to its next nodeID on every attempt. Returns true if a request is
+	// now outstanding.
+	sendRequest := func(index int) bool {
+		vdr := vdrs[index]
+		for attempts[index] < s.maxAttempts {
+			nodeID := vdr.NodeIDs[attempts[index]%len(vdr.NodeIDs)]
+			attempts[index]++
+
+			onResponse := func(
+				_ context.Context,
+				_ ids.NodeID,
+				responseBytes []byte,
+				err error,
+			) {
+				if err != nil {
+					results <- result{index: index, err: err}
+					return
+				}
+
+				signature, err := parseSignature(vdr, message, responseBytes)
+				results <- result{
+					index:     index,
+					signature: signature,
+					err:       err,
+				}
+			}
+
+			err := s.client.AppRequest(ctx, set.Of(nodeID), requestBytes, onResponse)
+			if err == nil {
+				return true
+			}
+
+			s.log.Debug("failed to request signature",
+				zap.Stringer("nodeID", nodeID),
+				zap.Error(err),
+			)
+		}
+		return false
+	}
+
+	for i := range vdrs {
+		if sendRequest(i) {
+			pending++
+		}
+	}
+
+	var (
+		signers    = set.NewBits()
+		signatures = make([]*bls.Signature, 0, len(vdrs))
+		sigWeight  uint64
+	)
+	for pending > 0 {
+		var r result
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case r = <-results:
+			pending--
+		}
+
+		if r.err != nil {
+			s.log.Debug("failed to get signature",
+				zap.Stringers("nodeIDs", vdrs[r.index].NodeIDs),
+				zap.Error(r.err),
+			)
+
+			if sendRequest(r.index) {
+				pending++
+			}
+			continue
+		}
+
+		signers.Add(r.index)
+		signatures = append(signatures, r.signature)
+		sigWeight += vdrs[r.index].Weight
+
+		if err := warp.VerifyWeight(sigWeight, totalWeight, quorumNum, quorumDen); err != nil {
+			continue
+		}
+
+		aggregateSignature, err := bls.AggregateSignatures(signatures)
+		if err != nil {
+			return nil, fmt.Errorf("failed to aggregate signatures: %w", err)
+		}
+
+		bitSetSignature := &warp.BitSetSignature{
+			Signers: signers.Bytes(),
+		}
+		copy(bitSetSignature.Signature[:], bls.SignatureToBytes(aggregateSignature))
+		return warp.NewMessage(message, bitSetSignature)
+	}
+
+	return nil, fmt.Errorf(
+		"%w: %d*%d > %d*%d",
+		ErrInsufficientWeight,
+		quorumNum,
+		totalWeight,
+		quorumDen,
+		sigWeight,
+	)
+}
+
+func parseSignature(
+	vdr *warp.Validator,
+	message *warp.UnsignedMessage,
+	responseBytes []byte,
+) (*bls.Signature, error) {
+	response := &sdk.SignatureResponse{}
+	if err := proto.Unmarshal(responseBytes, response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	signature, err := bls.SignatureFromBytes(response.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse signature: %w", err)
+	}
+
+	if !bls.Verify(vdr.PublicKey, signature, message.Bytes()) {
+		return nil, errInvalidSignature
+	}
+	return signature, nil
+}
@@ -0,0 +1,236 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package acp118
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/network/p2p"
+	"github.com/ava-labs/avalanchego/snow/engine/common"
+	"github.com/ava-labs/avalanchego/snow/validators"
+	"github.com/ava-labs/avalanchego/utils/crypto/bls"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/platformvm/warp"
+)
+
+type testValidator struct {
+	nodeID ids.NodeID
+	sk     *bls.SecretKey
+	weight uint64
+	// failures is the number of requests this validator will fail before
+	// responding
+	failures int
+	// signer overrides the key used to sign responses if set
+	signer *bls.SecretKey
+}
+
+func newTestValidator(t *testing.T, weight uint64) *testValidator {
+	sk, err := bls.NewSecretKey()
+	require.NoError(t, err)
+
+	return &testValidator{
+		nodeID: ids.GenerateTestNodeID(),
+		sk:     sk,
+		weight: weight,
+	}
+}
+
+func TestSignatureAggregator(t *testing.T) {
+	var (
+		networkID = uint32(123)
+		chainID   = ids.GenerateTestID()
+		subnetID  = ids.GenerateTestID()
+	)
+
+	unsignedMessage, err := warp.NewUnsignedMessage(networkID, chainID, []byte("payload"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		validators  func(t *testing.T) []*testValidator
+		quorumNum   uint64
+		quorumDen   uint64
+		expectedErr error
+	}{
+		{
+			name: "invalid quorum",
+			validators: func(t *testing.T) []*testValidator {
+				return []*testValidator{newTestValidator(t, 1)}
+			},
+			quorumNum:   2,
+			quorumDen:   1,
+			expectedErr: ErrInvalidQuorum,
+		},
+		{
+			name: "all validators sign",
+			validators: func(t *testing.T) []*testValidator {
+				return []*testValidator{
+					newTestValidator(t, 1),
+					newTestValidator(t, 1),
+					newTestValidator(t, 1),
+				}
+			},
+			quorumNum: 67,
+			quorumDen: 100,
+		},
+		{
+			name: "unresponsive validator below quorum",
+			validators: func(t *testing.T) []*testValidator {
+				unresponsive := newTestValidator(t, 1)
+				unresponsive.failures = DefaultMaxAttempts
+				return []*testValidator{
+					newTestValidator(t, 3),
+					newTestValidator(t, 3),
+					unresponsive,
+				}
+			},
+			quorumNum: 67,
+			quorumDen: 100,
+		},
+		{
+			name: "retries failed requests",
+			validators: func(t *testing.T) []*testValidator {
+				flaky := newTestValidator(t, 10)
+				flaky.failures = DefaultMaxAttempts - 1
+				return []*testValidator{
+					flaky,
+					newTestValidator(t, 1),
+				}
+			},
+			quorumNum: 67,
+			quorumDen: 100,
+		},
+		{
+			name: "insufficient weight",
+			validators: func(t *testing.T) []*testValidator {
+				unresponsive := newTestValidator(t, 10)
+				unresponsive.failures = DefaultMaxAttempts
+				return []*testValidator{
+					unresponsive,
+					newTestValidator(t, 1),
+				}
+			},
+			quorumNum:   67,
+			quorumDen:   100,
+			expectedErr: ErrInsufficientWeight,
+		},
+		{
+			name: "invalid signature",
+			validators: func(t *testing.T) []*testValidator {
+				sk, err := bls.NewSecretKey()
+				require.NoError(t, err)
+
+				invalid := newTestValidator(t, 10)
+				invalid.signer = sk
+				return []*testValidator{
+					invalid,
+					newTestValidator(t, 1),
+				}
+			},
+			quorumNum:   67,
+			quorumDen:   100,
+			expectedErr: ErrInsufficientWeight,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			ctx := context.Background()
+
+			vdrs := tt.validators(t)
+			handlers := make(map[ids.NodeID]*Handler, len(vdrs))
+			failures := make(map[ids.NodeID]int, len(vdrs))
+			vdrSet := make(map[ids.NodeID]*validators.GetValidatorOutput, len(vdrs))
+			for _, vdr := range vdrs {
+				sk := vdr.sk
+				if vdr.signer != nil {
+					sk = vdr.signer
+				}
+				handlers[vdr.nodeID] = NewHandler(
+					testVerifier{},
+					warp.NewSigner(bls.NewLocalSigner(sk), networkID, chainID),
+				)
+				failures[vdr.nodeID] = vdr.failures
+				vdrSet[vdr.nodeID] = &validators.GetValidatorOutput{
+					NodeID:    vdr.nodeID,
+					PublicKey: bls.PublicFromSecretKey(vdr.sk),
+					Weight:    vdr.weight,
+				}
+			}
+
+			var network *p2p.Network
+			sender := &common.SenderTest{
+				SendAppRequestF: func(ctx context.Context, nodeIDs set.Set[ids.NodeID], requestID uint32, requestBytes []byte) error {
+					for nodeID := range nodeIDs {
+						nodeID := nodeID
+						if failures[nodeID] > 0 {
+							failures[nodeID]--
+							go func() {
+								require.NoError(network.AppRequestFailed(ctx, nodeID, requestID, common.ErrTimeout))
+							}()
+							continue
+						}
+
+						// Strip the handler prefix
+						responseBytes, err := handlers[nodeID].AppRequest(ctx, nodeID, time.Time{}, requestBytes[1:])
+						require.NoError(err)
+						go func() {
+							require.NoError(network.AppResponse(ctx, nodeID, requestID, responseBytes))
+						}()
+					}
+					return nil
+				},
+			}
+
+			network, err = p2p.NewNetwork(logging.NoLog{}, sender, prometheus.NewRegistry(), "")
+			require.NoError(err)
+
+			state := &validators.TestState{
+				GetSubnetIDF: func(context.Context, ids.ID) (ids.ID, error) {
+					return subnetID, nil
+				},
+				GetValidatorSetF: func(context.Context, uint64, ids.ID) (map[ids.NodeID]*validators.GetValidatorOutput, error) {
+					return vdrSet, nil
+				},
+			}
+
+			aggregator := NewSignatureAggregator(
+				logging.NoLog{},
+				network.NewClient(HandlerID),
+				state,
+				DefaultMaxAttempts,
+			)
+			msg, err := aggregator.AggregateSignatures(
+				ctx,
+				unsignedMessage,
+				nil,
+				0,
+				tt.quorumNum,
+				tt.quorumDen,
+			)
+			require.ErrorIs(err, tt.expectedErr)
+			if tt.expectedErr != nil {
+				return
+			}
+
+			require.NoError(msg.Signature.Verify(
+				ctx,
+				&msg.UnsignedMessage,
+				networkID,
+				state,
+				0,
+				tt.quorumNum,
+				tt.quorumDen,
+			))
+		})
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package acp118
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/network/p2p"
+	"github.com/ava-labs/avalanchego/proto/pb/sdk"
+	"github.com/ava-labs/avalanchego/vms/platformvm/warp"
+)
+
+// HandlerID is the p2p handler ID reserved for the ACP-118 signature request
+// protocol.
+const HandlerID = 2
+
+var _ p2p.Handler = (*Handler)(nil)
+
+// Verifier verifies that a warp message should be signed
+type Verifier interface {
+	// Verify returns nil if [message] should be signed. [justification] is
+	// arbitrary data provided by the requester that may be used to decide
+	// whether the message should be signed.
+	Verify(
+		ctx context.Context,
+		message *warp.UnsignedMessage,
+		justification []byte,
+	) error
+}
+
+// NewHandler returns an instance of Handler
+func NewHandler(verifier Verifier, signer warp.Signer) *Handler {
+	return &Handler{
+		verifier: verifier,
+		signer:   signer,
+	}
+}
+
+// Handler signs warp messages
+type Handler struct {
+	p2p.NoOpHandler
+
+	verifier Verifier
+	signer   warp.Signer
+}
+
+func (h *Handler) AppRequest(
+	ctx context.Context,
+	_ ids.NodeID,
+	_ time.Time,
+	requestBytes []byte,
+) ([]byte, error) {
+	request := &sdk.SignatureRequest{}
+	if err := proto.Unmarshal(requestBytes, request); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal request: %w", err)
+	}
+
+	msg, err := warp.ParseUnsignedMessage(request.Message)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse warp unsigned message: %w", err)
+	}
+
+	if err := h.verifier.Verify(ctx, msg, request.Justification); err != nil {
+		return nil, fmt.Errorf("failed to verify message: %w", err)
+	}
+
+	signature, err := h.signer.Sign(msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign message: %w", err)
+	}
+
+	response := &sdk.SignatureResponse{
+		Signature: signature,
+	}
+	return proto.Marshal(response)
+}
@@ -0,0 +1,103 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package acp118
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/proto/pb/sdk"
+	"github.com/ava-labs/avalanchego/utils/crypto/bls"
+	"github.com/ava-labs/avalanchego/vms/platformvm/warp"
+)
+
+var (
+	_ Verifier = (*testVerifier)(nil)
+
+	errTest = errors.New("test error")
+)
+
+type testVerifier struct {
+	err error
+}
+
+func (t testVerifier) Verify(context.Context, *warp.UnsignedMessage, []byte) error {
+	return t.err
+}
+
+func TestHandler(t *testing.T) {
+	networkID := uint32(123)
+	chainID := ids.GenerateTestID()
+
+	unsignedMessage, err := warp.NewUnsignedMessage(networkID, chainID, []byte("payload"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		verifier     Verifier
+		requestBytes func() []byte
+		expectedErr  error
+	}{
+		{
+			name:     "verification fails",
+			verifier: testVerifier{err: errTest},
+			requestBytes: func() []byte {
+				bytes, err := proto.Marshal(&sdk.SignatureRequest{
+					Message: unsignedMessage.Bytes(),
+				})
+				require.NoError(t, err)
+				return bytes
+			},
+			expectedErr: errTest,
+		},
+		{
+			name:     "signs message",
+			verifier: testVerifier{},
+			requestBytes: func() []byte {
+				bytes, err := proto.Marshal(&sdk.SignatureRequest{
+					Message:       unsignedMessage.Bytes(),
+					Justification: []byte("justification"),
+				})
+				require.NoError(t, err)
+				return bytes
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+
+			sk, err := bls.NewSecretKey()
+			require.NoError(err)
+			pk := bls.PublicFromSecretKey(sk)
+			signer := warp.NewSigner(bls.NewLocalSigner(sk), networkID, chainID)
+
+			handler := NewHandler(tt.verifier, signer)
+			responseBytes, err := handler.AppRequest(
+				context.Background(),
+				ids.GenerateTestNodeID(),
+				time.Time{},
+				tt.requestBytes(),
+			)
+			require.ErrorIs(err, tt.expectedErr)
+			if tt.expectedErr != nil {
+				return
+			}
+
+			response := &sdk.SignatureResponse{}
+			require.NoError(proto.Unmarshal(responseBytes, response))
+
+			signature, err := bls.SignatureFromBytes(response.Signature)
+			require.NoError(err)
+			require.True(bls.Verify(pk, signature, unsignedMessage.Bytes()))
+		})
+	}
+}
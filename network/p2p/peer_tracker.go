@@ -13,6 +13,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/network/reputation"
 	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/utils/heap"
 	"github.com/ava-labs/avalanchego/utils/logging"
@@ -63,6 +64,7 @@ type PeerTracker struct {
 	log          logging.Logger
 	ignoredNodes set.Set[ids.NodeID]
 	minVersion   *version.Application
+	reputation   reputation.Tracker
 	metrics      peerTrackerMetrics
 }
 
@@ -78,6 +80,7 @@ func NewPeerTracker(
 	registerer prometheus.Registerer,
 	ignoredNodes set.Set[ids.NodeID],
 	minVersion *version.Application,
+	reputation reputation.Tracker,
 ) (*PeerTracker, error) {
 	t := &PeerTracker{
 		peerBandwidth: make(map[ids.NodeID]safemath.Averager),
@@ -88,6 +91,7 @@ func NewPeerTracker(
 		log:              log,
 		ignoredNodes:     ignoredNodes,
 		minVersion:       minVersion,
+		reputation:       reputation,
 		metrics: peerTrackerMetrics{
 			numTrackedPeers: prometheus.NewGauge(
 				prometheus.GaugeOpts{
@@ -228,6 +232,7 @@ func (p *PeerTracker) RegisterRequest(nodeID ids.NodeID) {
 //
 // Adds the peer's bandwidth averager to the bandwidth heap.
 func (p *PeerTracker) RegisterResponse(nodeID ids.NodeID, bandwidth float64) {
+	p.reputation.RegisterBandwidth(nodeID, bandwidth)
 	p.updateBandwidth(nodeID, bandwidth, true)
 }
 
@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/network/reputation"
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/version"
 )
@@ -22,6 +23,7 @@ func TestPeerTracker(t *testing.T) {
 		prometheus.NewRegistry(),
 		nil,
 		nil,
+		reputation.NewNoTracker(),
 	)
 	require.NoError(err)
 
@@ -8,11 +8,14 @@ import (
 
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/message"
+	"github.com/ava-labs/avalanchego/network/capture"
+	"github.com/ava-labs/avalanchego/network/reputation"
 	"github.com/ava-labs/avalanchego/network/throttling"
 	"github.com/ava-labs/avalanchego/snow/networking/router"
 	"github.com/ava-labs/avalanchego/snow/networking/tracker"
 	"github.com/ava-labs/avalanchego/snow/uptime"
 	"github.com/ava-labs/avalanchego/snow/validators"
+	"github.com/ava-labs/avalanchego/utils/compression"
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/utils/timer/mockable"
@@ -45,6 +48,10 @@ type Config struct {
 	SupportedACPs []uint32
 	ObjectedACPs  []uint32
 
+	// CompressionType is used for outbound messages if the peer supports it.
+	// Otherwise, the best compression type supported by both nodes is used.
+	CompressionType compression.Type
+
 	// Unix time of the last message sent and received respectively
 	// Must only be accessed atomically
 	LastSent, LastReceived int64
@@ -55,6 +62,12 @@ type Config struct {
 	// Calculates uptime of peers
 	UptimeCalculator uptime.Calculator
 
+	// Notified when peers send invalid messages
+	Reputation reputation.Tracker
+
+	// Records the messages exchanged with peers
+	Capture capture.Recorder
+
 	// Signs my IP so I can send my signed IP address in the Handshake message
 	IPSigner *IPSigner
 }
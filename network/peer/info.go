@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/compression"
 	"github.com/ava-labs/avalanchego/utils/json"
 	"github.com/ava-labs/avalanchego/utils/set"
 )
@@ -23,4 +24,5 @@ type Info struct {
 	TrackedSubnets        set.Set[ids.ID]        `json:"trackedSubnets"`
 	SupportedACPs         set.Set[uint32]        `json:"supportedACPs"`
 	ObjectedACPs          set.Set[uint32]        `json:"objectedACPs"`
+	CompressionType       compression.Type       `json:"compressionType"`
 }
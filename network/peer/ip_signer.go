@@ -17,7 +17,7 @@ type IPSigner struct {
 	ip        ips.DynamicIPPort
 	clock     mockable.Clock
 	tlsSigner crypto.Signer
-	blsSigner *bls.SecretKey
+	blsSigner bls.Signer
 
 	// Must be held while accessing [signedIP]
 	signedIPLock sync.RWMutex
@@ -29,7 +29,7 @@ type IPSigner struct {
 func NewIPSigner(
 	ip ips.DynamicIPPort,
 	tlsSigner crypto.Signer,
-	blsSigner *bls.SecretKey,
+	blsSigner bls.Signer,
 ) *IPSigner {
 	return &IPSigner{
 		ip:        ip,
@@ -24,6 +24,7 @@ func TestSignedIpVerify(t *testing.T) {
 	tlsKey1 := tlsCert1.PrivateKey.(crypto.Signer)
 	blsKey1, err := bls.NewSecretKey()
 	require.NoError(t, err)
+	blsSigner1 := bls.NewLocalSigner(blsKey1)
 
 	tlsCert2, err := staking.NewTLSCert()
 	require.NoError(t, err)
@@ -35,7 +36,7 @@ func TestSignedIpVerify(t *testing.T) {
 	type test struct {
 		name         string
 		tlsSigner    crypto.Signer
-		blsSigner    *bls.SecretKey
+		blsSigner    bls.Signer
 		expectedCert *staking.Certificate
 		ip           UnsignedIP
 		maxTimestamp time.Time
@@ -46,7 +47,7 @@ func TestSignedIpVerify(t *testing.T) {
 		{
 			name:         "valid (before max time)",
 			tlsSigner:    tlsKey1,
-			blsSigner:    blsKey1,
+			blsSigner:    blsSigner1,
 			expectedCert: cert1,
 			ip: UnsignedIP{
 				IPPort: ips.IPPort{
@@ -61,7 +62,7 @@ func TestSignedIpVerify(t *testing.T) {
 		{
 			name:         "valid (at max time)",
 			tlsSigner:    tlsKey1,
-			blsSigner:    blsKey1,
+			blsSigner:    blsSigner1,
 			expectedCert: cert1,
 			ip: UnsignedIP{
 				IPPort: ips.IPPort{
@@ -76,7 +77,7 @@ func TestSignedIpVerify(t *testing.T) {
 		{
 			name:         "timestamp too far ahead",
 			tlsSigner:    tlsKey1,
-			blsSigner:    blsKey1,
+			blsSigner:    blsSigner1,
 			expectedCert: cert1,
 			ip: UnsignedIP{
 				IPPort: ips.IPPort{
@@ -91,7 +92,7 @@ func TestSignedIpVerify(t *testing.T) {
 		{
 			name:         "sig from wrong cert",
 			tlsSigner:    tlsKey1,
-			blsSigner:    blsKey1,
+			blsSigner:    blsSigner1,
 			expectedCert: cert2, // note this isn't cert1
 			ip: UnsignedIP{
 				IPPort: ips.IPPort{
@@ -18,10 +18,12 @@ import (
 
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/message"
+	"github.com/ava-labs/avalanchego/network/capture"
 	"github.com/ava-labs/avalanchego/proto/pb/p2p"
 	"github.com/ava-labs/avalanchego/staking"
 	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/utils/bloom"
+	"github.com/ava-labs/avalanchego/utils/compression"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/crypto/bls"
 	"github.com/ava-labs/avalanchego/utils/ips"
@@ -149,6 +151,11 @@ type peer struct {
 	supportedACPs set.Set[uint32]
 	objectedACPs  set.Set[uint32]
 
+	// compressionType is used to compress the messages sent to this peer. It
+	// is negotiated using the compression types provided in the Handshake
+	// message.
+	compressionType utils.Atomic[compression.Type]
+
 	// txIDOfVerifiedBLSKey is the txID that added the BLS key that was most
 	// recently verified to have signed the IP.
 	//
@@ -223,6 +230,9 @@ func Start(
 		observedUptimes:    make(map[ids.ID]uint32),
 		getPeerListChan:    make(chan struct{}, 1),
 	}
+	// Until the peer's Handshake is received, only compression types that
+	// all peers support are used.
+	p.compressionType.Set(message.NegotiateCompressionType(config.CompressionType, nil, nil))
 
 	go p.readMessages()
 	go p.writeMessages()
@@ -300,6 +310,7 @@ func (p *peer) Info() Info {
 		TrackedSubnets:        p.trackedSubnets,
 		SupportedACPs:         p.supportedACPs,
 		ObjectedACPs:          p.objectedACPs,
+		CompressionType:       p.compressionType.Get(),
 	}
 }
 
@@ -467,6 +478,7 @@ func (p *peer) readMessages() {
 			onFinishedHandling()
 			return
 		}
+		p.Capture.Record(capture.Inbound, p.id, msgBytes)
 
 		// Track the time it takes from now until the time the message is
 		// handled (in the event this message is handled at the network level)
@@ -491,6 +503,7 @@ func (p *peer) readMessages() {
 			)
 
 			p.Metrics.NumFailedToParse.Inc()
+			p.Reputation.RegisterInvalidMessage(p.id)
 
 			// Couldn't parse the message. Read the next one.
 			onFinishedHandling()
@@ -593,6 +606,17 @@ func (p *peer) writeMessages() {
 }
 
 func (p *peer) writeMessage(writer io.Writer, msg message.OutboundMessage) {
+	compressedMsg, err := msg.Compress(p.compressionType.Get())
+	if err != nil {
+		p.Log.Error("failed to compress message",
+			zap.Stringer("nodeID", p.id),
+			zap.Stringer("messageOp", msg.Op()),
+			zap.Error(err),
+		)
+		return
+	}
+	msg = compressedMsg
+
 	msgBytes := msg.Bytes()
 	p.Log.Verbo("sending message",
 		zap.Stringer("nodeID", p.id),
@@ -628,6 +652,8 @@ func (p *peer) writeMessage(writer io.Writer, msg message.OutboundMessage) {
 		return
 	}
 
+	p.Capture.Record(capture.Outbound, p.id, msgBytes)
+
 	now := p.Clock.Time()
 	p.storeLastSent(now)
 	p.Metrics.Sent(msg)
@@ -782,6 +808,7 @@ func (p *peer) handlePing(msg *p2p.Ping) {
 			zap.Stringer("subnetID", constants.PrimaryNetworkID),
 			zap.Uint32("uptime", msg.Uptime),
 		)
+		p.Reputation.RegisterInvalidMessage(p.id)
 		p.StartClose()
 		return
 	}
@@ -796,6 +823,7 @@ func (p *peer) handlePing(msg *p2p.Ping) {
 				zap.String("field", "subnetID"),
 				zap.Error(err),
 			)
+			p.Reputation.RegisterInvalidMessage(p.id)
 			p.StartClose()
 			return
 		}
@@ -819,6 +847,7 @@ func (p *peer) handlePing(msg *p2p.Ping) {
 				zap.Stringer("subnetID", subnetID),
 				zap.Uint32("uptime", uptime),
 			)
+			p.Reputation.RegisterInvalidMessage(p.id)
 			p.StartClose()
 			return
 		}
@@ -899,6 +928,7 @@ func (p *peer) handleHandshake(msg *p2p.Handshake) {
 			zap.Stringer("messageOp", message.HandshakeOp),
 			zap.String("reason", "already received handshake"),
 		)
+		p.Reputation.RegisterInvalidMessage(p.id)
 		p.StartClose()
 		return
 	}
@@ -964,6 +994,7 @@ func (p *peer) handleHandshake(msg *p2p.Handshake) {
 			zap.String("field", "trackedSubnets"),
 			zap.Int("numTrackedSubnets", numTrackedSubnets),
 		)
+		p.Reputation.RegisterInvalidMessage(p.id)
 		p.StartClose()
 		return
 	}
@@ -978,6 +1009,7 @@ func (p *peer) handleHandshake(msg *p2p.Handshake) {
 				zap.String("field", "trackedSubnets"),
 				zap.Error(err),
 			)
+			p.Reputation.RegisterInvalidMessage(p.id)
 			p.StartClose()
 			return
 		}
@@ -1007,6 +1039,12 @@ func (p *peer) handleHandshake(msg *p2p.Handshake) {
 		return
 	}
 
+	p.compressionType.Set(message.NegotiateCompressionType(
+		p.CompressionType,
+		msg.SupportedCompressionTypes,
+		msg.CompressionDictionaryIds,
+	))
+
 	var (
 		knownPeers = bloom.EmptyFilter
 		salt       []byte
@@ -1021,6 +1059,7 @@ func (p *peer) handleHandshake(msg *p2p.Handshake) {
 				zap.String("field", "knownPeers.filter"),
 				zap.Error(err),
 			)
+			p.Reputation.RegisterInvalidMessage(p.id)
 			p.StartClose()
 			return
 		}
@@ -1033,6 +1072,7 @@ func (p *peer) handleHandshake(msg *p2p.Handshake) {
 				zap.String("field", "knownPeers.salt"),
 				zap.Int("saltLen", saltLen),
 			)
+			p.Reputation.RegisterInvalidMessage(p.id)
 			p.StartClose()
 			return
 		}
@@ -1046,6 +1086,7 @@ func (p *peer) handleHandshake(msg *p2p.Handshake) {
 			zap.String("field", "ip"),
 			zap.Int("ipLen", ipLen),
 		)
+		p.Reputation.RegisterInvalidMessage(p.id)
 		p.StartClose()
 		return
 	}
@@ -1056,6 +1097,7 @@ func (p *peer) handleHandshake(msg *p2p.Handshake) {
 			zap.String("field", "port"),
 			zap.Uint32("port", msg.IpPort),
 		)
+		p.Reputation.RegisterInvalidMessage(p.id)
 		p.StartClose()
 		return
 	}
@@ -1084,6 +1126,7 @@ func (p *peer) handleHandshake(msg *p2p.Handshake) {
 			zap.Uint64("localTime", localUnixTime),
 			zap.Error(err),
 		)
+		p.registerInvalidIP(err)
 
 		p.StartClose()
 		return
@@ -1097,6 +1140,7 @@ func (p *peer) handleHandshake(msg *p2p.Handshake) {
 			zap.String("field", "blsSignature"),
 			zap.Error(err),
 		)
+		p.Reputation.RegisterInvalidMessage(p.id)
 		p.StartClose()
 		return
 	}
@@ -1160,6 +1204,7 @@ func (p *peer) handleGetPeerList(msg *p2p.GetPeerList) {
 			zap.String("field", "knownPeers.filter"),
 			zap.Error(err),
 		)
+		p.Reputation.RegisterInvalidMessage(p.id)
 		p.StartClose()
 		return
 	}
@@ -1172,6 +1217,7 @@ func (p *peer) handleGetPeerList(msg *p2p.GetPeerList) {
 			zap.String("field", "knownPeers.salt"),
 			zap.Int("saltLen", saltLen),
 		)
+		p.Reputation.RegisterInvalidMessage(p.id)
 		p.StartClose()
 		return
 	}
@@ -1220,6 +1266,7 @@ func (p *peer) handlePeerList(msg *p2p.PeerList) {
 				zap.String("field", "cert"),
 				zap.Error(err),
 			)
+			p.Reputation.RegisterInvalidMessage(p.id)
 			p.StartClose()
 			return
 		}
@@ -1232,6 +1279,7 @@ func (p *peer) handlePeerList(msg *p2p.PeerList) {
 				zap.String("field", "ip"),
 				zap.Int("ipLen", ipLen),
 			)
+			p.Reputation.RegisterInvalidMessage(p.id)
 			p.StartClose()
 			return
 		}
@@ -1242,6 +1290,7 @@ func (p *peer) handlePeerList(msg *p2p.PeerList) {
 				zap.String("field", "port"),
 				zap.Uint32("port", claimedIPPort.IpPort),
 			)
+			p.Reputation.RegisterInvalidMessage(p.id)
 			p.StartClose()
 			return
 		}
@@ -1264,10 +1313,20 @@ func (p *peer) handlePeerList(msg *p2p.PeerList) {
 			zap.String("field", "claimedIP"),
 			zap.Error(err),
 		)
+		p.registerInvalidIP(err)
 		p.StartClose()
 	}
 }
 
+// registerInvalidIP registers that the peer sent an IP that failed
+// verification with [err]. Clock skew isn't a protocol violation, so it doesn't
+// affect the peer's reputation.
+func (p *peer) registerInvalidIP(err error) {
+	if !errors.Is(err, errTimestampTooFarInFuture) {
+		p.Reputation.RegisterInvalidMessage(p.id)
+	}
+}
+
 func (p *peer) nextTimeout() time.Time {
 	return p.Clock.Time().Add(p.PongTimeout)
 }
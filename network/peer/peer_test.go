@@ -4,9 +4,11 @@
 package peer
 
 import (
+	"bytes"
 	"context"
 	"crypto"
 	"net"
+	"sync"
 	"testing"
 	"time"
 
@@ -15,6 +17,8 @@ import (
 
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/message"
+	"github.com/ava-labs/avalanchego/network/capture"
+	"github.com/ava-labs/avalanchego/network/reputation"
 	"github.com/ava-labs/avalanchego/network/throttling"
 	"github.com/ava-labs/avalanchego/proto/pb/p2p"
 	"github.com/ava-labs/avalanchego/snow/networking/router"
@@ -22,6 +26,7 @@ import (
 	"github.com/ava-labs/avalanchego/snow/uptime"
 	"github.com/ava-labs/avalanchego/snow/validators"
 	"github.com/ava-labs/avalanchego/staking"
+	"github.com/ava-labs/avalanchego/utils/compression"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/crypto/bls"
 	"github.com/ava-labs/avalanchego/utils/ips"
@@ -94,8 +99,11 @@ func newConfig(t *testing.T) Config {
 		PingFrequency:        constants.DefaultPingFrequency,
 		PongTimeout:          constants.DefaultPingPongTimeout,
 		MaxClockDifference:   time.Minute,
+		CompressionType:      constants.DefaultNetworkCompressionType,
 		ResourceTracker:      resourceTracker,
 		UptimeCalculator:     uptime.NoOpCalculator,
+		Reputation:           reputation.NewNoTracker(),
+		Capture:              capture.NewNoRecorder(),
 		IPSigner:             nil,
 	}
 }
@@ -112,10 +120,10 @@ func newRawTestPeer(t *testing.T, config Config) *rawTestPeer {
 
 	ip := ips.NewDynamicIPPort(net.IPv6loopback, 1)
 	tls := tlsCert.PrivateKey.(crypto.Signer)
-	bls, err := bls.NewSecretKey()
+	blsKey, err := bls.NewSecretKey()
 	require.NoError(err)
 
-	config.IPSigner = NewIPSigner(ip, tls, bls)
+	config.IPSigner = NewIPSigner(ip, tls, bls.NewLocalSigner(blsKey))
 
 	inboundMsgChan := make(chan message.InboundMessage)
 	config.Router = router.InboundHandlerFunc(func(_ context.Context, msg message.InboundMessage) {
@@ -210,6 +218,109 @@ func TestSend(t *testing.T) {
 	require.NoError(peer1.AwaitClosed(context.Background()))
 }
 
+type testRecorder struct {
+	lock    sync.Mutex
+	records []*capture.Record
+}
+
+func (r *testRecorder) Record(direction capture.Direction, nodeID ids.NodeID, msgBytes []byte) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.records = append(r.records, &capture.Record{
+		Direction: direction,
+		NodeID:    nodeID,
+		Bytes:     msgBytes,
+	})
+}
+
+func (*testRecorder) Close() error {
+	return nil
+}
+
+func (r *testRecorder) contains(direction capture.Direction, nodeID ids.NodeID, msgBytes []byte) bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	for _, record := range r.records {
+		if record.Direction == direction && record.NodeID == nodeID && bytes.Equal(record.Bytes, msgBytes) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestCapture(t *testing.T) {
+	require := require.New(t)
+
+	sharedConfig := newConfig(t)
+
+	rawPeer0 := newRawTestPeer(t, sharedConfig)
+	rawPeer1 := newRawTestPeer(t, sharedConfig)
+
+	recorder0 := &testRecorder{}
+	recorder1 := &testRecorder{}
+	rawPeer0.config.Capture = recorder0
+	rawPeer1.config.Capture = recorder1
+
+	peer0, peer1 := startTestPeers(rawPeer0, rawPeer1)
+	awaitReady(t, peer0, peer1)
+
+	outboundGetMsg, err := sharedConfig.MessageCreator.Get(ids.Empty, 1, time.Second, ids.Empty)
+	require.NoError(err)
+
+	require.True(peer0.Send(context.Background(), outboundGetMsg))
+	<-peer1.inboundMsgChan
+
+	peer1.StartClose()
+	require.NoError(peer0.AwaitClosed(context.Background()))
+	require.NoError(peer1.AwaitClosed(context.Background()))
+
+	msgBytes := outboundGetMsg.Bytes()
+	require.True(recorder0.contains(capture.Outbound, rawPeer1.nodeID, msgBytes))
+	require.True(recorder1.contains(capture.Inbound, rawPeer0.nodeID, msgBytes))
+	require.False(recorder1.contains(capture.Outbound, rawPeer0.nodeID, msgBytes))
+}
+
+func TestCompressionNegotiation(t *testing.T) {
+	require := require.New(t)
+
+	sharedConfig := newConfig(t)
+
+	rawPeer0 := newRawTestPeer(t, sharedConfig)
+	rawPeer1 := newRawTestPeer(t, sharedConfig)
+
+	recorder0 := &testRecorder{}
+	rawPeer0.config.Capture = recorder0
+	rawPeer0.config.CompressionType = compression.TypeSnappy
+	rawPeer1.config.CompressionType = compression.TypeZstdDict
+
+	peer0, peer1 := startTestPeers(rawPeer0, rawPeer1)
+	awaitReady(t, peer0, peer1)
+
+	// Both peers support each other's preferred compression type.
+	require.Equal(compression.TypeSnappy, peer0.Info().CompressionType)
+	require.Equal(compression.TypeZstdDict, peer1.Info().CompressionType)
+
+	container := []byte("container")
+	outboundPutMsg, err := sharedConfig.MessageCreator.Put(ids.Empty, 1, container)
+	require.NoError(err)
+
+	require.True(peer0.Send(context.Background(), outboundPutMsg))
+
+	inboundPutMsg := <-peer1.inboundMsgChan
+	require.Equal(message.PutOp, inboundPutMsg.Op())
+	require.Equal(container, inboundPutMsg.Message().(*p2p.Put).Container)
+
+	peer1.StartClose()
+	require.NoError(peer0.AwaitClosed(context.Background()))
+	require.NoError(peer1.AwaitClosed(context.Background()))
+
+	snappyPutMsg, err := outboundPutMsg.Compress(compression.TypeSnappy)
+	require.NoError(err)
+	require.True(recorder0.contains(capture.Outbound, rawPeer1.nodeID, snappyPutMsg.Bytes()))
+}
+
 func TestPingUptimes(t *testing.T) {
 	trackedSubnetID := ids.GenerateTestID()
 	untrackedSubnetID := ids.GenerateTestID()
@@ -411,7 +522,7 @@ func TestInvalidBLSKeyDisconnects(t *testing.T) {
 	require.NoError(rawPeer0.config.Validators.AddStaker(
 		constants.PrimaryNetworkID,
 		rawPeer1.nodeID,
-		bls.PublicFromSecretKey(rawPeer1.config.IPSigner.blsSigner),
+		rawPeer1.config.IPSigner.blsSigner.PublicKey(),
 		ids.GenerateTestID(),
 		1,
 	))
@@ -13,6 +13,8 @@ import (
 
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/message"
+	"github.com/ava-labs/avalanchego/network/capture"
+	"github.com/ava-labs/avalanchego/network/reputation"
 	"github.com/ava-labs/avalanchego/network/throttling"
 	"github.com/ava-labs/avalanchego/snow/networking/router"
 	"github.com/ava-labs/avalanchego/snow/networking/tracker"
@@ -125,9 +127,12 @@ func StartTestPeer(
 			PingFrequency:        constants.DefaultPingFrequency,
 			PongTimeout:          constants.DefaultPingPongTimeout,
 			MaxClockDifference:   time.Minute,
+			CompressionType:      constants.DefaultNetworkCompressionType,
 			ResourceTracker:      resourceTracker,
 			UptimeCalculator:     uptime.NoOpCalculator,
-			IPSigner:             NewIPSigner(signerIP, tlsKey, blsKey),
+			Reputation:           reputation.NewNoTracker(),
+			Capture:              capture.NewNoRecorder(),
+			IPSigner:             NewIPSigner(signerIP, tlsKey, bls.NewLocalSigner(blsKey)),
 		},
 		conn,
 		cert,
@@ -0,0 +1,511 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package reputation
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/utils/timer/mockable"
+)
+
+const (
+	// The penalty of each kind of event, relative to the reward of a
+	// response.
+	timeoutWeight        = 1
+	throttledWeight      = 0.5
+	invalidMessageWeight = 10
+
+	// The maximum fraction of a peer's score that is lost for responding with
+	// a lower bandwidth than the average peer.
+	bandwidthWeight = 0.5
+
+	// Once the decayed number of events of a peer drops below
+	// [forgetThreshold], the peer's record is removed.
+	forgetThreshold = 0.01
+
+	// Once the score of a disconnected peer decays back to at least
+	// [neutralScore], the peer's record is removed.
+	neutralScore = 0.99
+
+	recordLen = 7 * 8
+)
+
+var (
+	_ Tracker = (*tracker)(nil)
+
+	errInvalidRecordLen = errors.New("invalid record length")
+)
+
+// Tracker scores peers based on the quality of their interactions with this
+// node. Scores are in (0, 1], where 1 is the score of a peer that has never
+// misbehaved.
+type Tracker interface {
+	// RegisterResponse registers that [nodeID] responded to a request in time.
+	RegisterResponse(nodeID ids.NodeID)
+	// RegisterTimeout registers that a request sent to [nodeID] timed out.
+	RegisterTimeout(nodeID ids.NodeID)
+	// RegisterThrottled registers that messages from [nodeID] were throttled
+	// because [nodeID] exceeded its own resource allocation.
+	RegisterThrottled(nodeID ids.NodeID)
+	// RegisterInvalidMessage registers that [nodeID] sent a message that
+	// violates the protocol.
+	RegisterInvalidMessage(nodeID ids.NodeID)
+	// RegisterBandwidth registers that [nodeID] responded to a request with
+	// [bandwidth] bytes per second.
+	RegisterBandwidth(nodeID ids.NodeID, bandwidth float64)
+
+	// Connected registers that this node is connected to [nodeID].
+	Connected(nodeID ids.NodeID)
+	// Disconnected registers that this node is no longer connected to
+	// [nodeID]. The record of [nodeID] is removed once its score is neutral.
+	Disconnected(nodeID ids.NodeID)
+
+	// Score returns the current score of [nodeID].
+	Score(nodeID ids.NodeID) float64
+	// Scores returns the current scores of [nodeIDs]. If [nodeIDs] is empty,
+	// the scores of all the peers with a record are returned.
+	Scores(nodeIDs []ids.NodeID) []Score
+	// ShouldSample returns false if [nodeID]'s score is too low for it to be
+	// sampled for gossip or requests.
+	ShouldSample(nodeID ids.NodeID) bool
+	// ShouldDisconnect returns true if [nodeID]'s score is so low that this
+	// node should not be connected to it.
+	ShouldDisconnect(nodeID ids.NodeID) bool
+
+	// Persist writes the current records to disk, so that scores are kept
+	// across restarts.
+	Persist() error
+}
+
+type Config struct {
+	// HalfLife is the amount of time it takes for the effect of an event on a
+	// peer's score to halve. This applies to penalties as well as rewards, so
+	// a peer that stops misbehaving eventually regains a perfect score.
+	HalfLife time.Duration `json:"halfLife"`
+
+	// SampleThreshold is the minimum score a peer must have to be sampled for
+	// gossip and requests. If 0, peers are always sampled.
+	SampleThreshold float64 `json:"sampleThreshold"`
+
+	// DisconnectThreshold is the score below which peers are disconnected. If
+	// 0, peers are never disconnected due to their score.
+	DisconnectThreshold float64 `json:"disconnectThreshold"`
+
+	// UpdateFrequency is how frequently scores are persisted and peers with
+	// low scores are disconnected.
+	UpdateFrequency time.Duration `json:"updateFrequency"`
+}
+
+// Score describes the reputation of a peer.
+type Score struct {
+	NodeID ids.NodeID
+	// Score of the peer in (0, 1]
+	Score float64
+	// Number of responses, decayed by the half-life
+	Responses float64
+	// Number of timeouts, decayed by the half-life
+	Timeouts float64
+	// Number of throttled messages, decayed by the half-life
+	Throttled float64
+	// Number of invalid messages, decayed by the half-life
+	InvalidMessages float64
+	// Average bandwidth of the responses, in bytes per second
+	Bandwidth float64
+	// Last time an event was registered for the peer
+	LastUpdated time.Time
+}
+
+type record struct {
+	responses       float64
+	timeouts        float64
+	throttled       float64
+	invalidMessages float64
+	bandwidthSum    float64
+	bandwidthCount  float64
+	lastUpdated     time.Time
+}
+
+// decay applies the decay of the time that has passed since the record was
+// last updated.
+func (r *record) decay(now time.Time, halfLife time.Duration) {
+	elapsed := now.Sub(r.lastUpdated)
+	if elapsed <= 0 {
+		return
+	}
+	factor := math.Exp2(-float64(elapsed) / float64(halfLife))
+	r.responses *= factor
+	r.timeouts *= factor
+	r.throttled *= factor
+	r.invalidMessages *= factor
+	r.bandwidthSum *= factor
+	r.bandwidthCount *= factor
+	r.lastUpdated = now
+}
+
+// score returns the score of the record. [averageBandwidth] is the average
+// bandwidth of all the peers, or 0 if it is unknown.
+func (r *record) score(averageBandwidth float64) float64 {
+	penalty := timeoutWeight*r.timeouts +
+		throttledWeight*r.throttled +
+		invalidMessageWeight*r.invalidMessages
+	score := (r.responses + 1) / (r.responses + 1 + penalty)
+
+	bandwidth := r.bandwidth()
+	if bandwidth <= 0 || averageBandwidth <= 0 || bandwidth >= averageBandwidth {
+		return score
+	}
+	return score * (1 - bandwidthWeight*(1-bandwidth/averageBandwidth))
+}
+
+// bandwidth returns the average bandwidth of the record, or 0 if no bandwidth
+// was registered.
+func (r *record) bandwidth() float64 {
+	if r.bandwidthCount <= 0 {
+		return 0
+	}
+	return r.bandwidthSum / r.bandwidthCount
+}
+
+func (r *record) forgettable() bool {
+	return r.responses+r.timeouts+r.throttled+r.invalidMessages+r.bandwidthCount < forgetThreshold
+}
+
+func (r *record) bytes() []byte {
+	b := make([]byte, recordLen)
+	for i, v := range []float64{
+		r.responses,
+		r.timeouts,
+		r.throttled,
+		r.invalidMessages,
+		r.bandwidthSum,
+		r.bandwidthCount,
+	} {
+		binary.BigEndian.PutUint64(b[i*8:], math.Float64bits(v))
+	}
+	binary.BigEndian.PutUint64(b[6*8:], uint64(r.lastUpdated.Unix()))
+	return b
+}
+
+func parseRecord(b []byte) (*record, error) {
+	if len(b) != recordLen {
+		return nil, errInvalidRecordLen
+	}
+	values := make([]float64, 6)
+	for i := range values {
+		values[i] = math.Float64frombits(binary.BigEndian.Uint64(b[i*8:]))
+	}
+	return &record{
+		responses:       values[0],
+		timeouts:        values[1],
+		throttled:       values[2],
+		invalidMessages: values[3],
+		bandwidthSum:    values[4],
+		bandwidthCount:  values[5],
+		lastUpdated:     time.Unix(int64(binary.BigEndian.Uint64(b[6*8:])), 0),
+	}, nil
+}
+
+type tracker struct {
+	config Config
+	db     database.Database
+	clock  mockable.Clock
+
+	// persistLock ensures that records are written to disk in the order they
+	// were snapshotted.
+	persistLock sync.Mutex
+
+	lock    sync.Mutex
+	records map[ids.NodeID]*record
+	// Bandwidth registered for all peers, used as the reference for the
+	// bandwidth of a single peer
+	total record
+	// Peers whose records have changed since they were last persisted
+	modified map[ids.NodeID]struct{}
+	// Peers this node is currently connected to
+	connected set.Set[ids.NodeID]
+}
+
+// NewTracker returns a Tracker that persists its records in [db]. Records that
+// were previously persisted in [db] are loaded.
+func NewTracker(config Config, db database.Database) (Tracker, error) {
+	t := &tracker{
+		config:    config,
+		db:        db,
+		records:   make(map[ids.NodeID]*record),
+		modified:  make(map[ids.NodeID]struct{}),
+		connected: set.Set[ids.NodeID]{},
+	}
+	t.total.lastUpdated = t.clock.Time()
+
+	it := db.NewIterator()
+	defer it.Release()
+
+	for it.Next() {
+		nodeID, err := ids.ToNodeID(it.Key())
+		if err != nil {
+			return nil, err
+		}
+		r, err := parseRecord(it.Value())
+		if err != nil {
+			return nil, err
+		}
+		t.records[nodeID] = r
+	}
+	return t, it.Error()
+}
+
+func (t *tracker) RegisterResponse(nodeID ids.NodeID) {
+	t.update(nodeID, func(r *record) {
+		r.responses++
+	})
+}
+
+func (t *tracker) RegisterTimeout(nodeID ids.NodeID) {
+	t.update(nodeID, func(r *record) {
+		r.timeouts++
+	})
+}
+
+func (t *tracker) RegisterThrottled(nodeID ids.NodeID) {
+	t.update(nodeID, func(r *record) {
+		r.throttled++
+	})
+}
+
+func (t *tracker) RegisterInvalidMessage(nodeID ids.NodeID) {
+	t.update(nodeID, func(r *record) {
+		r.invalidMessages++
+	})
+}
+
+func (t *tracker) RegisterBandwidth(nodeID ids.NodeID, bandwidth float64) {
+	t.update(nodeID, func(r *record) {
+		r.bandwidthSum += bandwidth
+		r.bandwidthCount++
+
+		t.total.decay(r.lastUpdated, t.config.HalfLife)
+		t.total.bandwidthSum += bandwidth
+		t.total.bandwidthCount++
+	})
+}
+
+func (t *tracker) Connected(nodeID ids.NodeID) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.connected.Add(nodeID)
+}
+
+func (t *tracker) Disconnected(nodeID ids.NodeID) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.connected.Remove(nodeID)
+}
+
+func (t *tracker) update(nodeID ids.NodeID, f func(*record)) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	now := t.clock.Time()
+	r, ok := t.records[nodeID]
+	if ok {
+		r.decay(now, t.config.HalfLife)
+	} else {
+		r = &record{
+			lastUpdated: now,
+		}
+		t.records[nodeID] = r
+	}
+	f(r)
+	t.modified[nodeID] = struct{}{}
+}
+
+func (t *tracker) Score(nodeID ids.NodeID) float64 {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	return t.score(nodeID).Score
+}
+
+func (t *tracker) Scores(nodeIDs []ids.NodeID) []Score {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if len(nodeIDs) == 0 {
+		nodeIDs = make([]ids.NodeID, 0, len(t.records))
+		for nodeID := range t.records {
+			nodeIDs = append(nodeIDs, nodeID)
+		}
+	}
+
+	scores := make([]Score, len(nodeIDs))
+	for i, nodeID := range nodeIDs {
+		scores[i] = t.score(nodeID)
+	}
+	return scores
+}
+
+// Assumes [t.lock] is held.
+func (t *tracker) score(nodeID ids.NodeID) Score {
+	r, ok := t.records[nodeID]
+	if !ok {
+		return Score{
+			NodeID: nodeID,
+			Score:  1,
+		}
+	}
+
+	// The record is copied so that reading the score doesn't modify the
+	// record.
+	decayed := *r
+	decayed.decay(t.clock.Time(), t.config.HalfLife)
+	return Score{
+		NodeID:          nodeID,
+		Score:           decayed.score(t.total.bandwidth()),
+		Responses:       decayed.responses,
+		Timeouts:        decayed.timeouts,
+		Throttled:       decayed.throttled,
+		InvalidMessages: decayed.invalidMessages,
+		Bandwidth:       decayed.bandwidth(),
+		LastUpdated:     r.lastUpdated,
+	}
+}
+
+func (t *tracker) ShouldSample(nodeID ids.NodeID) bool {
+	return t.config.SampleThreshold <= 0 || t.Score(nodeID) >= t.config.SampleThreshold
+}
+
+func (t *tracker) ShouldDisconnect(nodeID ids.NodeID) bool {
+	return t.config.DisconnectThreshold > 0 && t.Score(nodeID) < t.config.DisconnectThreshold
+}
+
+func (t *tracker) Persist() error {
+	t.persistLock.Lock()
+	defer t.persistLock.Unlock()
+
+	forgotten, modified := t.snapshot()
+
+	batch := t.db.NewBatch()
+	for _, nodeID := range forgotten {
+		if err := batch.Delete(nodeID.Bytes()); err != nil {
+			return err
+		}
+	}
+	for nodeID, recordBytes := range modified {
+		if err := batch.Put(nodeID.Bytes(), recordBytes); err != nil {
+			t.markModified(modified)
+			return err
+		}
+	}
+	if err := batch.Write(); err != nil {
+		t.markModified(modified)
+		return err
+	}
+	return nil
+}
+
+// snapshot removes the records that have decayed away, and the records of
+// disconnected peers whose scores are neutral, and returns their nodeIDs, along
+// with the serialized records that were modified since the last
+// snapshot.
+func (t *tracker) snapshot() ([]ids.NodeID, map[ids.NodeID][]byte) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	var (
+		now              = t.clock.Time()
+		averageBandwidth = t.total.bandwidth()
+		forgotten        []ids.NodeID
+		modified         = make(map[ids.NodeID][]byte, len(t.modified))
+	)
+	for nodeID, r := range t.records {
+		// Records of peers that haven't misbehaved or responded in a long time
+		// are forgotten to bound the number of records. Records of
+		// disconnected peers are forgotten as soon as they no longer affect
+		// the peer's score, so that peers that are seen once don't accumulate.
+		decayed := *r
+		decayed.decay(now, t.config.HalfLife)
+		if decayed.forgettable() ||
+			(!t.connected.Contains(nodeID) && decayed.score(averageBandwidth) >= neutralScore) {
+			delete(t.records, nodeID)
+			forgotten = append(forgotten, nodeID)
+			continue
+		}
+
+		if _, ok := t.modified[nodeID]; ok {
+			modified[nodeID] = r.bytes()
+		}
+	}
+	clear(t.modified)
+	return forgotten, modified
+}
+
+// markModified marks [nodeIDs] as modified so that they are written by the
+// next call to Persist.
+func (t *tracker) markModified(nodeIDs map[ids.NodeID][]byte) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	for nodeID := range nodeIDs {
+		if _, ok := t.records[nodeID]; ok {
+			t.modified[nodeID] = struct{}{}
+		}
+	}
+}
+
+type noTracker struct{}
+
+// NewNoTracker returns a Tracker that gives every peer a perfect score.
+func NewNoTracker() Tracker {
+	return noTracker{}
+}
+
+func (noTracker) RegisterResponse(ids.NodeID) {}
+
+func (noTracker) RegisterTimeout(ids.NodeID) {}
+
+func (noTracker) RegisterThrottled(ids.NodeID) {}
+
+func (noTracker) RegisterInvalidMessage(ids.NodeID) {}
+
+func (noTracker) RegisterBandwidth(ids.NodeID, float64) {}
+
+func (noTracker) Connected(ids.NodeID) {}
+
+func (noTracker) Disconnected(ids.NodeID) {}
+
+func (noTracker) Score(ids.NodeID) float64 {
+	return 1
+}
+
+func (noTracker) Scores(nodeIDs []ids.NodeID) []Score {
+	scores := make([]Score, len(nodeIDs))
+	for i, nodeID := range nodeIDs {
+		scores[i] = Score{
+			NodeID: nodeID,
+			Score:  1,
+		}
+	}
+	return scores
+}
+
+func (noTracker) ShouldSample(ids.NodeID) bool {
+	return true
+}
+
+func (noTracker) ShouldDisconnect(ids.NodeID) bool {
+	return false
+}
+
+func (noTracker) Persist() error {
+	return nil
+}
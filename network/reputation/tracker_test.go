@@ -0,0 +1,181 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package reputation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/ids"
+)
+
+var testConfig = Config{
+	HalfLife:            time.Hour,
+	SampleThreshold:     0.5,
+	DisconnectThreshold: 0.1,
+	UpdateFrequency:     time.Minute,
+}
+
+func newTestTracker(require *require.Assertions, db *memdb.Database, now time.Time) *tracker {
+	t, err := NewTracker(testConfig, db)
+	require.NoError(err)
+
+	tracker := t.(*tracker)
+	tracker.clock.Set(now)
+	return tracker
+}
+
+func TestTrackerScore(t *testing.T) {
+	require := require.New(t)
+
+	now := time.Unix(1_000_000, 0)
+	tracker := newTestTracker(require, memdb.New(), now)
+	nodeID := ids.GenerateTestNodeID()
+
+	// Unknown peers have a perfect score.
+	require.Equal(1.0, tracker.Score(nodeID))
+	require.True(tracker.ShouldSample(nodeID))
+	require.False(tracker.ShouldDisconnect(nodeID))
+
+	tracker.RegisterResponse(nodeID)
+	require.Equal(1.0, tracker.Score(nodeID))
+
+	// (1 response + 1) / (1 response + 1 + 2 timeouts)
+	tracker.RegisterTimeout(nodeID)
+	tracker.RegisterTimeout(nodeID)
+	require.Equal(0.5, tracker.Score(nodeID))
+	require.True(tracker.ShouldSample(nodeID))
+
+	tracker.RegisterThrottled(nodeID)
+	require.False(tracker.ShouldSample(nodeID))
+	require.False(tracker.ShouldDisconnect(nodeID))
+
+	tracker.RegisterInvalidMessage(nodeID)
+	tracker.RegisterInvalidMessage(nodeID)
+	require.True(tracker.ShouldDisconnect(nodeID))
+
+	// After a half-life, the effect of the events is halved.
+	tracker.clock.Set(now.Add(testConfig.HalfLife))
+	scores := tracker.Scores([]ids.NodeID{nodeID})
+	require.Len(scores, 1)
+	score := scores[0]
+	require.Equal(nodeID, score.NodeID)
+	require.InDelta(0.5, score.Responses, 1e-9)
+	require.InDelta(1, score.Timeouts, 1e-9)
+	require.InDelta(0.5, score.Throttled, 1e-9)
+	require.InDelta(1, score.InvalidMessages, 1e-9)
+	require.Equal(now, score.LastUpdated)
+
+	// Eventually, the peer is forgiven.
+	tracker.clock.Set(now.Add(20 * testConfig.HalfLife))
+	require.InDelta(1, tracker.Score(nodeID), 1e-3)
+	require.False(tracker.ShouldDisconnect(nodeID))
+}
+
+func TestTrackerBandwidth(t *testing.T) {
+	require := require.New(t)
+
+	tracker := newTestTracker(require, memdb.New(), time.Unix(1_000_000, 0))
+	nodeID := ids.GenerateTestNodeID()
+
+	tracker.RegisterBandwidth(nodeID, 100)
+	tracker.RegisterBandwidth(nodeID, 300)
+
+	scores := tracker.Scores(nil)
+	require.Len(scores, 1)
+	require.Equal(nodeID, scores[0].NodeID)
+	require.Equal(200.0, scores[0].Bandwidth)
+	require.Equal(1.0, scores[0].Score)
+
+	// A peer that is slower than average loses part of its score.
+	slowNodeID := ids.GenerateTestNodeID()
+	tracker.RegisterBandwidth(slowNodeID, 50)
+	// The average bandwidth is (100 + 300 + 50) / 3 = 150
+	require.InDelta(1-bandwidthWeight*(1-50.0/150), tracker.Score(slowNodeID), 1e-9)
+	require.Equal(1.0, tracker.Score(nodeID))
+}
+
+func TestTrackerPersist(t *testing.T) {
+	require := require.New(t)
+
+	db := memdb.New()
+	now := time.Unix(1_000_000, 0)
+	tracker := newTestTracker(require, db, now)
+	badNodeID := ids.GenerateTestNodeID()
+	forgottenNodeID := ids.GenerateTestNodeID()
+
+	tracker.RegisterInvalidMessage(badNodeID)
+	tracker.RegisterTimeout(forgottenNodeID)
+	require.NoError(tracker.Persist())
+
+	// Scores are kept across restarts.
+	tracker = newTestTracker(require, db, now)
+	require.Len(tracker.Scores(nil), 2)
+	require.Equal(1.0/11, tracker.Score(badNodeID))
+
+	// Records that have decayed away are removed.
+	now = now.Add(10 * testConfig.HalfLife)
+	tracker.clock.Set(now)
+	tracker.RegisterInvalidMessage(badNodeID)
+	require.NoError(tracker.Persist())
+
+	tracker = newTestTracker(require, db, now)
+	scores := tracker.Scores(nil)
+	require.Len(scores, 1)
+	require.Equal(badNodeID, scores[0].NodeID)
+	require.InDelta(1+1.0/1024, scores[0].InvalidMessages, 1e-9)
+}
+
+func TestTrackerForgetsDisconnectedPeers(t *testing.T) {
+	require := require.New(t)
+
+	now := time.Unix(1_000_000, 0)
+	tracker := newTestTracker(require, memdb.New(), now)
+	connectedNodeID := ids.GenerateTestNodeID()
+	disconnectedNodeID := ids.GenerateTestNodeID()
+	badNodeID := ids.GenerateTestNodeID()
+
+	tracker.Connected(connectedNodeID)
+	tracker.Connected(disconnectedNodeID)
+	tracker.Connected(badNodeID)
+	for _, nodeID := range []ids.NodeID{connectedNodeID, disconnectedNodeID, badNodeID} {
+		tracker.RegisterResponse(nodeID)
+	}
+	tracker.RegisterInvalidMessage(badNodeID)
+	tracker.Disconnected(disconnectedNodeID)
+	tracker.Disconnected(badNodeID)
+
+	// The record of a disconnected peer with a neutral score is removed, while
+	// the record of a disconnected peer that misbehaved is kept.
+	require.NoError(tracker.Persist())
+	scores := tracker.Scores(nil)
+	require.Len(scores, 2)
+	require.NotContains([]ids.NodeID{scores[0].NodeID, scores[1].NodeID}, disconnectedNodeID)
+
+	// Once the penalty has decayed, the record of the misbehaving peer is
+	// removed as well.
+	tracker.clock.Set(now.Add(10 * testConfig.HalfLife))
+	tracker.RegisterResponse(connectedNodeID)
+	require.NoError(tracker.Persist())
+	scores = tracker.Scores(nil)
+	require.Len(scores, 1)
+	require.Equal(connectedNodeID, scores[0].NodeID)
+}
+
+func TestNoTracker(t *testing.T) {
+	require := require.New(t)
+
+	tracker := NewNoTracker()
+	nodeID := ids.GenerateTestNodeID()
+
+	tracker.RegisterInvalidMessage(nodeID)
+	require.Equal(1.0, tracker.Score(nodeID))
+	require.True(tracker.ShouldSample(nodeID))
+	require.False(tracker.ShouldDisconnect(nodeID))
+	require.Equal([]Score{{NodeID: nodeID, Score: 1}}, tracker.Scores([]ids.NodeID{nodeID}))
+	require.NoError(tracker.Persist())
+}
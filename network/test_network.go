@@ -15,8 +15,10 @@ import (
 
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/message"
+	"github.com/ava-labs/avalanchego/network/capture"
 	"github.com/ava-labs/avalanchego/network/dialer"
 	"github.com/ava-labs/avalanchego/network/peer"
+	"github.com/ava-labs/avalanchego/network/reputation"
 	"github.com/ava-labs/avalanchego/network/throttling"
 	"github.com/ava-labs/avalanchego/snow/networking/router"
 	"github.com/ava-labs/avalanchego/snow/networking/tracker"
@@ -162,6 +164,13 @@ func NewTestNetwork(
 			MaxReconnectDelay:     constants.DefaultNetworkMaxReconnectDelay,
 		},
 
+		ReputationConfig: reputation.Config{
+			HalfLife:            constants.DefaultNetworkReputationHalfLife,
+			SampleThreshold:     constants.DefaultNetworkReputationSampleThreshold,
+			DisconnectThreshold: constants.DefaultNetworkReputationDisconnectThreshold,
+			UpdateFrequency:     constants.DefaultNetworkReputationUpdateFrequency,
+		},
+
 		MaxClockDifference:           constants.DefaultNetworkMaxClockDifference,
 		CompressionType:              constants.DefaultNetworkCompressionType,
 		PingFrequency:                constants.DefaultPingFrequency,
@@ -184,15 +193,20 @@ func NewTestNetwork(
 	tlsConfig := peer.TLSConfig(*tlsCert, nil)
 	networkConfig.TLSConfig = tlsConfig
 	networkConfig.TLSKey = tlsCert.PrivateKey.(crypto.Signer)
-	networkConfig.BLSKey, err = bls.NewSecretKey()
+	blsKey, err := bls.NewSecretKey()
 	if err != nil {
 		return nil, err
 	}
+	networkConfig.BLSKey = bls.NewLocalSigner(blsKey)
 
 	networkConfig.Validators = currentValidators
 	networkConfig.Beacons = validators.NewManager()
 	// This never actually does anything because we never initialize the P-chain
 	networkConfig.UptimeCalculator = uptime.NoOpCalculator
+	// Reputations aren't persisted because the network doesn't have a
+	// database
+	networkConfig.Reputation = reputation.NewNoTracker()
+	networkConfig.Capture = capture.NewNoRecorder()
 
 	// TODO actually monitor usage
 	// TestNetwork doesn't use disk so we don't need to track it, but we should
@@ -29,7 +29,9 @@ type bandwidthThrottler interface {
 	// the last time RemoveNode([nodeID]) was called, if any.
 	// It's safe for multiple goroutines to concurrently call Acquire.
 	// Returns immediately if [ctx] is canceled.
-	Acquire(ctx context.Context, msgSize uint64, nodeID ids.NodeID)
+	// Returns true if [nodeID] had exceeded its bandwidth allocation, so
+	// reading the message had to wait for its allocation to refill.
+	Acquire(ctx context.Context, msgSize uint64, nodeID ids.NodeID) bool
 
 	// Add a new node to this throttler.
 	// Must be called before Acquire(..., [nodeID]) is called.
@@ -106,7 +108,7 @@ func (t *bandwidthThrottlerImpl) Acquire(
 	ctx context.Context,
 	msgSize uint64,
 	nodeID ids.NodeID,
-) {
+) bool {
 	startTime := time.Now()
 	t.metrics.awaitingAcquire.Inc()
 	defer func() {
@@ -123,7 +125,10 @@ func (t *bandwidthThrottlerImpl) Acquire(
 			zap.Uint64("messageSize", msgSize),
 			zap.Stringer("nodeID", nodeID),
 		)
-		return
+		return false
+	}
+	if limiter.AllowN(startTime, int(msgSize)) {
+		return false
 	}
 	if err := limiter.WaitN(ctx, int(msgSize)); err != nil {
 		// This should only happen on shutdown.
@@ -133,6 +138,7 @@ func (t *bandwidthThrottlerImpl) Acquire(
 			zap.Error(err),
 		)
 	}
+	return true
 }
 
 // See BandwidthThrottler.
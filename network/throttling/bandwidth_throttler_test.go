@@ -45,8 +45,11 @@ func TestBandwidthThrottler(t *testing.T) {
 	throttler.AddNode(nodeID1)
 	require.Len(throttler.limiters, 1)
 
-	// Should be able to acquire 8
-	throttler.Acquire(context.Background(), 8, nodeID1)
+	// Should be able to acquire 8 without exceeding the allocation
+	require.False(throttler.Acquire(context.Background(), 8, nodeID1))
+
+	// Acquiring more than the remaining allocation has to wait for a refill
+	require.True(throttler.Acquire(context.Background(), 8, nodeID1))
 
 	// Make several goroutines that acquire bytes.
 	wg := sync.WaitGroup{}
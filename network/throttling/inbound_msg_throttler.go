@@ -9,6 +9,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/network/reputation"
 	"github.com/ava-labs/avalanchego/snow/networking/tracker"
 	"github.com/ava-labs/avalanchego/snow/validators"
 	"github.com/ava-labs/avalanchego/utils/logging"
@@ -61,6 +62,7 @@ func NewInboundMsgThrottler(
 	resourceTracker tracker.ResourceTracker,
 	cpuTargeter tracker.Targeter,
 	diskTargeter tracker.Targeter,
+	reputation reputation.Tracker,
 ) (InboundMsgThrottler, error) {
 	byteThrottler, err := newInboundMsgByteThrottler(
 		log,
@@ -115,6 +117,7 @@ func NewInboundMsgThrottler(
 		bandwidthThrottler: bandwidthThrottler,
 		cpuThrottler:       cpuThrottler,
 		diskThrottler:      diskThrottler,
+		reputation:         reputation,
 	}, nil
 }
 
@@ -146,6 +149,8 @@ type inboundMsgThrottler struct {
 	cpuThrottler SystemThrottler
 	// Rate-limits based on disk usage caused by a given node.
 	diskThrottler SystemThrottler
+	// Notified when a node exceeds its bandwidth allocation.
+	reputation reputation.Tracker
 }
 
 // Returns when we can read a message of size [msgSize] from node [nodeID].
@@ -156,8 +161,12 @@ type inboundMsgThrottler struct {
 func (t *inboundMsgThrottler) Acquire(ctx context.Context, msgSize uint64, nodeID ids.NodeID) ReleaseFunc {
 	// Acquire space on the inbound message buffer
 	bufferRelease := t.bufferThrottler.Acquire(ctx, nodeID)
-	// Acquire bandwidth
-	t.bandwidthThrottler.Acquire(ctx, msgSize, nodeID)
+	// Acquire bandwidth. Unlike the other resources, the bandwidth allocation
+	// of a peer is only consumed by the peer itself, so waiting for it doesn't
+	// depend on the load of this node.
+	if t.bandwidthThrottler.Acquire(ctx, msgSize, nodeID) {
+		t.reputation.RegisterThrottled(nodeID)
+	}
 	// Wait until our CPU usage drops to an acceptable level.
 	t.cpuThrottler.Acquire(ctx, nodeID)
 	// Wait until our disk usage drops to an acceptable level.
@@ -23,12 +23,16 @@ import (
 	"github.com/ava-labs/avalanchego/utils/profiler"
 	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/utils/timer"
+	"github.com/ava-labs/avalanchego/vms/components/gas"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs/fee"
 )
 
 type APIIndexerConfig struct {
 	IndexAPIEnabled      bool `json:"indexAPIEnabled"`
 	IndexAllowIncomplete bool `json:"indexAllowIncomplete"`
+	// If true, accepted containers and chain state changes can be streamed
+	// over a websocket
+	IndexSubscriptionsEnabled bool `json:"indexSubscriptionsEnabled"`
 }
 
 type HTTPConfig struct {
@@ -77,11 +81,19 @@ type StakingConfig struct {
 	SybilProtectionEnabled        bool            `json:"sybilProtectionEnabled"`
 	PartialSyncPrimaryNetwork     bool            `json:"partialSyncPrimaryNetwork"`
 	StakingTLSCert                tls.Certificate `json:"-"`
-	StakingSigningKey             *bls.SecretKey  `json:"-"`
+	StakingSigningKey             bls.Signer      `json:"-"`
 	SybilProtectionDisabledWeight uint64          `json:"sybilProtectionDisabledWeight"`
 	StakingKeyPath                string          `json:"stakingKeyPath"`
 	StakingCertPath               string          `json:"stakingCertPath"`
 	StakingSignerPath             string          `json:"stakingSignerPath"`
+	StakingRPCSignerEndpoint      string          `json:"stakingRPCSignerEndpoint"`
+	StakingRPCSignerTimeout       time.Duration   `json:"stakingRPCSignerTimeout"`
+	// StakingRPCSignerTLSConfig is nil if the connection to the gRPC signer
+	// isn't encrypted.
+	StakingRPCSignerTLSConfig *tls.Config `json:"-"`
+	// StakingRPCSignerCert is the PEM encoded staking certificate whose private
+	// key is held by the gRPC signer.
+	StakingRPCSignerCert []byte `json:"-"`
 }
 
 type StateSyncConfig struct {
@@ -119,6 +131,19 @@ type DatabaseConfig struct {
 
 	// Path to config file
 	Config []byte `json:"-"`
+
+	// Path to the directory that snapshots created with the admin API are
+	// written to
+	SnapshotDir string `json:"snapshotDir"`
+
+	// If non-empty, the snapshot at this path is restored into the database
+	// on startup. The database must be empty unless this snapshot was
+	// previously restored, in which case the restore is skipped.
+	RestoreSnapshotFile string `json:"restoreSnapshotFile"`
+
+	// If non-empty, the database is migrated to this database type and the
+	// node exits without starting.
+	MigrateToType string `json:"migrateToType"`
 }
 
 // Config contains all of the configurations of an Avalanche node.
@@ -131,6 +156,8 @@ type Config struct {
 	BootstrapConfig  `json:"bootstrapConfig"`
 	DatabaseConfig   `json:"databaseConfig"`
 
+	DynamicFeeConfig gas.Config `json:"dynamicFeeConfig"`
+
 	// Genesis information
 	GenesisBytes []byte `json:"-"`
 	AvaxAssetID  ids.ID `json:"avaxAssetID"`
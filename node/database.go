@@ -0,0 +1,118 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package node
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/leveldb"
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/database/migrate"
+	"github.com/ava-labs/avalanchego/database/pebble"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/version"
+)
+
+var errInvalidMigration = errors.New("invalid database migration")
+
+// newDatabase opens the database described by [config]. Each database type is
+// stored in its own directory under [config.Path], so databases of different
+// types can exist side by side.
+func newDatabase(
+	config DatabaseConfig,
+	log logging.Logger,
+	registerer prometheus.Registerer,
+) (database.Database, error) {
+	switch config.Name {
+	case leveldb.Name:
+		// Prior to v1.10.15, the only on-disk database was leveldb, and its
+		// files went to [dbPath]/[networkID]/v1.4.5.
+		dbPath := filepath.Join(config.Path, version.CurrentDatabase.String())
+		db, err := leveldb.New(dbPath, config.Config, log, "db_internal", registerer)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't create leveldb at %s: %w", dbPath, err)
+		}
+		return db, nil
+	case memdb.Name:
+		return memdb.New(), nil
+	case pebble.Name:
+		dbPath := filepath.Join(config.Path, pebble.Name)
+		db, err := pebble.New(dbPath, config.Config, log, "db_internal", registerer)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't create pebbledb at %s: %w", dbPath, err)
+		}
+		return db, nil
+	default:
+		return nil, fmt.Errorf(
+			"db-type was %q but should have been one of {%s, %s, %s}",
+			config.Name,
+			leveldb.Name,
+			memdb.Name,
+			pebble.Name,
+		)
+	}
+}
+
+// MigrateDatabase copies every key of the database described by [config] into
+// a new database of type [config.MigrateToType] in the same directory. An
+// interrupted migration is resumed when MigrateDatabase is called again. Once
+// MigrateDatabase returns successfully, the node can be restarted with the new
+// db-type.
+func MigrateDatabase(ctx context.Context, config DatabaseConfig, log logging.Logger) error {
+	toType := config.MigrateToType
+	switch {
+	case config.Name == memdb.Name || toType == memdb.Name:
+		return fmt.Errorf("%w: %s databases are not persisted", errInvalidMigration, memdb.Name)
+	case config.Name == toType:
+		return fmt.Errorf("%w: database is already of type %s", errInvalidMigration, toType)
+	}
+
+	src, err := newDatabase(config, log, prometheus.NewRegistry())
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	// The database config is specific to the source database type, so the
+	// destination database is opened with its default config.
+	dst, err := newDatabase(
+		DatabaseConfig{
+			Path: config.Path,
+			Name: toType,
+		},
+		log,
+		prometheus.NewRegistry(),
+	)
+	if err != nil {
+		return err
+	}
+	defer dst.Close()
+
+	log.Info("migrating database",
+		zap.String("path", config.Path),
+		zap.String("from", config.Name),
+		zap.String("to", toType),
+	)
+
+	summary, err := migrate.Migrate(ctx, log, migrate.DefaultConfig, src, dst)
+	if err != nil {
+		return fmt.Errorf("couldn't migrate database: %w", err)
+	}
+
+	log.Info("migrated database",
+		zap.Uint64("numKeys", summary.NumKeys),
+		zap.Uint64("numBytes", summary.NumBytes),
+		zap.Bool("resumed", summary.Resumed),
+		zap.Int("numVerifiedPrefixes", summary.NumPrefixes),
+		zap.String("newDBType", toType),
+	)
+	return nil
+}
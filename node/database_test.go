@@ -0,0 +1,203 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package node
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/leveldb"
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/database/pebble"
+	"github.com/ava-labs/avalanchego/database/snapshot"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/perms"
+)
+
+func TestMigrateDatabase(t *testing.T) {
+	require := require.New(t)
+
+	config := DatabaseConfig{
+		Path:          t.TempDir(),
+		Name:          leveldb.Name,
+		MigrateToType: pebble.Name,
+	}
+
+	src, err := newDatabase(config, logging.NoLog{}, prometheus.NewRegistry())
+	require.NoError(err)
+	require.NoError(src.Put([]byte("hello"), []byte("world")))
+	require.NoError(src.Close())
+
+	require.NoError(MigrateDatabase(context.Background(), config, logging.NoLog{}))
+
+	config.Name = pebble.Name
+	dst, err := newDatabase(config, logging.NoLog{}, prometheus.NewRegistry())
+	require.NoError(err)
+	defer dst.Close()
+
+	value, err := dst.Get([]byte("hello"))
+	require.NoError(err)
+	require.Equal([]byte("world"), value)
+}
+
+func TestMigrateDatabaseInvalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		from   string
+		toType string
+	}{
+		{
+			name:   "same type",
+			from:   pebble.Name,
+			toType: pebble.Name,
+		},
+		{
+			name:   "from memdb",
+			from:   memdb.Name,
+			toType: pebble.Name,
+		},
+		{
+			name:   "to memdb",
+			from:   leveldb.Name,
+			toType: memdb.Name,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			config := DatabaseConfig{
+				Path:          t.TempDir(),
+				Name:          test.from,
+				MigrateToType: test.toType,
+			}
+			err := MigrateDatabase(context.Background(), config, logging.NoLog{})
+			require.ErrorIs(t, err, errInvalidMigration)
+		})
+	}
+}
+
+func TestRestoreDatabaseSnapshotOnce(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		snapshotDir  = t.TempDir()
+		snapshotPath = filepath.Join(snapshotDir, "db.snapshot")
+		otherPath    = filepath.Join(snapshotDir, "other.snapshot")
+	)
+	src := memdb.New()
+	require.NoError(src.Put([]byte("hello"), []byte("world")))
+	summary, err := snapshot.WriteFile(snapshotPath, src)
+	require.NoError(err)
+
+	require.NoError(src.Put([]byte("foo"), []byte("bar")))
+	_, err = snapshot.WriteFile(otherPath, src)
+	require.NoError(err)
+
+	n := &Node{
+		Log: logging.NoLog{},
+		Config: &Config{
+			DatabaseConfig: DatabaseConfig{
+				RestoreSnapshotFile: snapshotPath,
+			},
+		},
+		DB: memdb.New(),
+	}
+	require.NoError(n.restoreDatabaseSnapshot())
+
+	value, err := n.DB.Get([]byte("hello"))
+	require.NoError(err)
+	require.Equal([]byte("world"), value)
+	marker, err := n.DB.Get(restoredSnapshotKey)
+	require.NoError(err)
+	require.Equal(append([]byte{snapshotRestored}, summary.Checksum[:]...), marker)
+
+	// Restarting with the same snapshot skips the restore.
+	require.NoError(n.restoreDatabaseSnapshot())
+
+	// The snapshot may be removed once it has been restored.
+	require.NoError(os.Remove(snapshotPath))
+	require.NoError(n.restoreDatabaseSnapshot())
+
+	// Restarting with a different snapshot fails.
+	n.Config.DatabaseConfig.RestoreSnapshotFile = otherPath
+	err = n.restoreDatabaseSnapshot()
+	require.ErrorIs(err, errDifferentSnapshotRestored)
+}
+
+func TestRestoreDatabaseSnapshotInterrupted(t *testing.T) {
+	require := require.New(t)
+
+	snapshotPath := filepath.Join(t.TempDir(), "db.snapshot")
+	src := memdb.New()
+	require.NoError(src.Put([]byte("hello"), []byte("world")))
+	// The source database was itself restored from a snapshot.
+	require.NoError(putRestoredSnapshot(src, snapshotRestored, ids.GenerateTestID()))
+	summary, err := snapshot.WriteFile(snapshotPath, src)
+	require.NoError(err)
+
+	// Simulate a restore that was interrupted after writing some keys.
+	db := memdb.New()
+	require.NoError(putRestoredSnapshot(db, snapshotRestoring, summary.Checksum))
+	require.NoError(db.Put([]byte("partial"), []byte("value")))
+
+	n := &Node{
+		Log: logging.NoLog{},
+		Config: &Config{
+			DatabaseConfig: DatabaseConfig{
+				RestoreSnapshotFile: snapshotPath,
+			},
+		},
+		DB: db,
+	}
+	require.NoError(n.restoreDatabaseSnapshot())
+
+	value, err := db.Get([]byte("hello"))
+	require.NoError(err)
+	require.Equal([]byte("world"), value)
+	has, err := db.Has([]byte("partial"))
+	require.NoError(err)
+	require.False(has)
+
+	marker, err := db.Get(restoredSnapshotKey)
+	require.NoError(err)
+	require.Equal(append([]byte{snapshotRestored}, summary.Checksum[:]...), marker)
+}
+
+func TestRestoreDatabaseSnapshotCorrupted(t *testing.T) {
+	require := require.New(t)
+
+	snapshotPath := filepath.Join(t.TempDir(), "db.snapshot")
+	src := memdb.New()
+	require.NoError(src.Put([]byte("hello"), []byte("world")))
+	_, err := snapshot.WriteFile(snapshotPath, src)
+	require.NoError(err)
+
+	snapshotBytes, err := os.ReadFile(snapshotPath)
+	require.NoError(err)
+	snapshotBytes[len(snapshotBytes)-1] ^= 1
+	require.NoError(os.WriteFile(snapshotPath, snapshotBytes, perms.ReadWrite))
+
+	n := &Node{
+		Log: logging.NoLog{},
+		Config: &Config{
+			DatabaseConfig: DatabaseConfig{
+				RestoreSnapshotFile: snapshotPath,
+			},
+		},
+		DB: memdb.New(),
+	}
+	err = n.restoreDatabaseSnapshot()
+	require.ErrorIs(err, snapshot.ErrChecksumMismatch)
+
+	// The database wasn't written to.
+	isEmpty, err := database.IsEmpty(n.DB)
+	require.NoError(err)
+	require.True(isEmpty)
+}
@@ -4,6 +4,7 @@
 package node
 
 import (
+	"bytes"
 	"context"
 	"crypto"
 	"crypto/tls"
@@ -33,11 +34,10 @@ import (
 	"github.com/ava-labs/avalanchego/chains"
 	"github.com/ava-labs/avalanchego/chains/atomic"
 	"github.com/ava-labs/avalanchego/database"
-	"github.com/ava-labs/avalanchego/database/leveldb"
 	"github.com/ava-labs/avalanchego/database/memdb"
 	"github.com/ava-labs/avalanchego/database/meterdb"
-	"github.com/ava-labs/avalanchego/database/pebble"
 	"github.com/ava-labs/avalanchego/database/prefixdb"
+	"github.com/ava-labs/avalanchego/database/snapshot"
 	"github.com/ava-labs/avalanchego/database/versiondb"
 	"github.com/ava-labs/avalanchego/genesis"
 	"github.com/ava-labs/avalanchego/ids"
@@ -45,8 +45,10 @@ import (
 	"github.com/ava-labs/avalanchego/message"
 	"github.com/ava-labs/avalanchego/nat"
 	"github.com/ava-labs/avalanchego/network"
+	"github.com/ava-labs/avalanchego/network/capture"
 	"github.com/ava-labs/avalanchego/network/dialer"
 	"github.com/ava-labs/avalanchego/network/peer"
+	"github.com/ava-labs/avalanchego/network/reputation"
 	"github.com/ava-labs/avalanchego/network/throttling"
 	"github.com/ava-labs/avalanchego/snow"
 	"github.com/ava-labs/avalanchego/snow/networking/benchlist"
@@ -56,10 +58,10 @@ import (
 	"github.com/ava-labs/avalanchego/snow/uptime"
 	"github.com/ava-labs/avalanchego/snow/validators"
 	"github.com/ava-labs/avalanchego/staking"
+	"github.com/ava-labs/avalanchego/staking/gsigner"
 	"github.com/ava-labs/avalanchego/trace"
 	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/utils/constants"
-	"github.com/ava-labs/avalanchego/utils/crypto/bls"
 	"github.com/ava-labs/avalanchego/utils/dynamicip"
 	"github.com/ava-labs/avalanchego/utils/filesystem"
 	"github.com/ava-labs/avalanchego/utils/hashing"
@@ -70,6 +72,7 @@ import (
 	"github.com/ava-labs/avalanchego/utils/profiler"
 	"github.com/ava-labs/avalanchego/utils/resource"
 	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/utils/units"
 	"github.com/ava-labs/avalanchego/version"
 	"github.com/ava-labs/avalanchego/vms"
 	"github.com/ava-labs/avalanchego/vms/avm"
@@ -79,6 +82,7 @@ import (
 	"github.com/ava-labs/avalanchego/vms/registry"
 	"github.com/ava-labs/avalanchego/vms/rpcchainvm/runtime"
 
+	pb "github.com/ava-labs/avalanchego/proto/pb/signer"
 	avmconfig "github.com/ava-labs/avalanchego/vms/avm/config"
 	platformconfig "github.com/ava-labs/avalanchego/vms/platformvm/config"
 	coreth "github.com/ava-labs/coreth/plugin/evm"
@@ -89,17 +93,34 @@ const (
 	httpPortName    = constants.AppName + "-http"
 
 	ipResolutionTimeout = 30 * time.Second
+
+	// Status of the snapshot recorded under [restoredSnapshotKey].
+	snapshotRestoring byte = 0
+	snapshotRestored  byte = 1
+
+	// restoreSnapshotClearSize is the approximate number of bytes deleted in a
+	// single batch when wiping a partially restored snapshot.
+	restoreSnapshotClearSize = 4 * units.MiB
 )
 
 var (
 	genesisHashKey     = []byte("genesisID")
 	ungracefulShutdown = []byte("ungracefulShutdown")
+	// restoredSnapshotKey is the status and checksum of the snapshot that the
+	// database is being, or was, restored from, if any.
+	restoredSnapshotKey = []byte("restoredSnapshot")
 
-	indexerDBPrefix  = []byte{0x00}
-	keystoreDBPrefix = []byte("keystore")
+	indexerDBPrefix    = []byte{0x00}
+	keystoreDBPrefix   = []byte("keystore")
+	reputationDBPrefix = []byte("reputation")
 
 	errInvalidTLSKey = errors.New("invalid TLS key")
 	errShuttingDown  = errors.New("server shutting down")
+
+	errSnapshotMissingGenesis    = errors.New("restored database snapshot doesn't contain a genesis hash")
+	errDifferentSnapshotRestored = errors.New("database was restored from a different snapshot")
+	errSnapshotChanged           = errors.New("database snapshot changed while it was being restored")
+	errInvalidRestoredSnapshot   = errors.New("invalid restored snapshot marker")
 )
 
 // New returns an instance of Node
@@ -108,6 +129,15 @@ func New(
 	logFactory logging.Factory,
 	logger logging.Logger,
 ) (*Node, error) {
+	var stakingRPCSignerConn io.Closer
+	if config.StakingRPCSignerEndpoint != "" {
+		var err error
+		stakingRPCSignerConn, err = initStakingRPCSigner(&config.StakingConfig)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	tlsCert := config.StakingTLSCert.Leaf
 	stakingCert, err := staking.ParseCertificate(tlsCert.Raw)
 	if err != nil {
@@ -121,16 +151,21 @@ func New(
 		StakingTLSCert:   stakingCert,
 		ID:               ids.NodeIDFromCert(stakingCert),
 		Config:           config,
+
+		stakingRPCSignerConn: stakingRPCSignerConn,
 	}
 
 	n.DoneShuttingDown.Add(1)
 
-	pop := signer.NewProofOfPossession(n.Config.StakingSigningKey)
+	n.pop, err = signer.NewProofOfPossessionFromSigner(n.Config.StakingSigningKey)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't create proof of possession: %w", err)
+	}
 	logger.Info("initializing node",
 		zap.Stringer("version", version.CurrentApp),
 		zap.Stringer("nodeID", n.ID),
 		zap.Stringer("stakingKeyType", tlsCert.PublicKeyAlgorithm),
-		zap.Reflect("nodePOP", pop),
+		zap.Reflect("nodePOP", n.pop),
 		zap.Reflect("providedFlags", n.Config.ProvidedFlags),
 		zap.Reflect("config", n.Config),
 	)
@@ -253,6 +288,50 @@ func New(
 	return n, nil
 }
 
+// initStakingRPCSigner connects to the gRPC signer that holds the staking
+// private keys and sets the staking certificate and signing key of [config].
+// Returns the connection to the signer.
+func initStakingRPCSigner(config *StakingConfig) (io.Closer, error) {
+	endpoint := config.StakingRPCSignerEndpoint
+	conn, err := gsigner.Dial(endpoint, config.StakingRPCSignerTLSConfig)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't dial staking signer at %s: %w", endpoint, err)
+	}
+	client := pb.NewSignerClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), config.StakingRPCSignerTimeout)
+	defer cancel()
+
+	tlsSigner, err := gsigner.NewTLSClient(ctx, client, config.StakingRPCSignerTimeout)
+	if err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("couldn't fetch TLS key from staking signer at %s within %s: %w",
+			endpoint,
+			config.StakingRPCSignerTimeout,
+			err,
+		)
+	}
+	cert, err := staking.LoadTLSCertFromSigner(config.StakingRPCSignerCert, tlsSigner)
+	if err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("couldn't load staking certificate: %w", err)
+	}
+
+	blsSigner, err := gsigner.NewBLSClient(ctx, client, config.StakingRPCSignerTimeout)
+	if err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("couldn't fetch signing key from staking signer at %s within %s: %w",
+			endpoint,
+			config.StakingRPCSignerTimeout,
+			err,
+		)
+	}
+
+	config.StakingTLSCert = *cert
+	config.StakingSigningKey = blsSigner
+	return conn, nil
+}
+
 // Node is an instance of an Avalanche node.
 type Node struct {
 	Log          logging.Logger
@@ -266,8 +345,18 @@ type Node struct {
 	StakingTLSSigner crypto.Signer
 	StakingTLSCert   *staking.Certificate
 
+	// Connection to the gRPC signer that holds the staking private keys. Nil
+	// if the private keys are held by the node.
+	stakingRPCSignerConn io.Closer
+
+	// Proof of possession of this node's BLS key
+	pop *signer.ProofOfPossession
+
 	// Storage for this node
 	DB database.Database
+	// Used to create consistent snapshots of [DB]. Nil if the database type
+	// doesn't support snapshots.
+	dbSnapshotter database.Snapshotter
 
 	router     nat.Router
 	portMapper *nat.Mapper
@@ -302,6 +391,12 @@ type Node struct {
 	// Manages validator benching
 	benchlistManager benchlist.Manager
 
+	// Scores peers based on their behavior
+	reputation reputation.Tracker
+
+	// Records the messages exchanged with peers
+	capture capture.Recorder
+
 	uptimeCalculator uptime.LockedCalculator
 
 	// dispatcher for events as they happen in consensus
@@ -517,10 +612,21 @@ func (n *Node) initNetworking() error {
 		n.chainRouter = router.Trace(n.chainRouter, n.tracer)
 	}
 
+	n.reputation, err = reputation.NewTracker(
+		n.Config.NetworkConfig.ReputationConfig,
+		prefixdb.New(reputationDBPrefix, n.DB),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to initialize peer reputations: %w", err)
+	}
+
 	// Configure benchlist
 	n.Config.BenchlistConfig.Validators = n.vdrs
 	n.Config.BenchlistConfig.Benchable = n.chainRouter
-	n.benchlistManager = benchlist.NewManager(&n.Config.BenchlistConfig)
+	n.benchlistManager = benchlist.NewReputationManager(
+		benchlist.NewManager(&n.Config.BenchlistConfig),
+		n.reputation,
+	)
 
 	n.uptimeCalculator = uptime.NewLockedCalculator()
 
@@ -535,7 +641,7 @@ func (n *Node) initNetworking() error {
 		err := n.vdrs.AddStaker(
 			constants.PrimaryNetworkID,
 			n.ID,
-			bls.PublicFromSecretKey(n.Config.StakingSigningKey),
+			n.Config.StakingSigningKey.PublicKey(),
 			dummyTxID,
 			n.Config.SybilProtectionDisabledWeight,
 		)
@@ -599,6 +705,16 @@ func (n *Node) initNetworking() error {
 	n.Config.NetworkConfig.ResourceTracker = n.resourceTracker
 	n.Config.NetworkConfig.CPUTargeter = n.cpuTargeter
 	n.Config.NetworkConfig.DiskTargeter = n.diskTargeter
+	n.Config.NetworkConfig.Reputation = n.reputation
+
+	n.capture = capture.NewNoRecorder()
+	if n.Config.NetworkConfig.CaptureConfig.File != "" {
+		n.capture = capture.NewRecorder(n.Config.NetworkConfig.CaptureConfig, n.Log)
+		n.Log.Warn("capturing network messages",
+			zap.String("filename", n.Config.NetworkConfig.CaptureConfig.File),
+		)
+	}
+	n.Config.NetworkConfig.Capture = n.capture
 
 	n.Net, err = network.NewNetwork(
 		&n.Config.NetworkConfig,
@@ -698,6 +814,13 @@ func (n *Node) Dispatch() error {
 		}
 	}
 
+	if err := n.capture.Close(); err != nil {
+		n.Log.Error("closing network capture file failed",
+			zap.String("filename", n.Config.NetworkConfig.CaptureConfig.File),
+			zap.Error(err),
+		)
+	}
+
 	// Wait until the node is done shutting down before returning
 	n.DoneShuttingDown.Wait()
 
@@ -721,40 +844,27 @@ func (n *Node) Dispatch() error {
 
 func (n *Node) initDatabase() error {
 	// start the db
-	switch n.Config.DatabaseConfig.Name {
-	case leveldb.Name:
-		// Prior to v1.10.15, the only on-disk database was leveldb, and its
-		// files went to [dbPath]/[networkID]/v1.4.5.
-		dbPath := filepath.Join(n.Config.DatabaseConfig.Path, version.CurrentDatabase.String())
-		var err error
-		n.DB, err = leveldb.New(dbPath, n.Config.DatabaseConfig.Config, n.Log, "db_internal", n.MetricsRegisterer)
-		if err != nil {
-			return fmt.Errorf("couldn't create leveldb at %s: %w", dbPath, err)
-		}
-	case memdb.Name:
-		n.DB = memdb.New()
-	case pebble.Name:
-		dbPath := filepath.Join(n.Config.DatabaseConfig.Path, pebble.Name)
-		var err error
-		n.DB, err = pebble.New(dbPath, n.Config.DatabaseConfig.Config, n.Log, "db_internal", n.MetricsRegisterer)
-		if err != nil {
-			return fmt.Errorf("couldn't create pebbledb at %s: %w", dbPath, err)
+	var err error
+	n.DB, err = newDatabase(n.Config.DatabaseConfig, n.Log, n.MetricsRegisterer)
+	if err != nil {
+		return err
+	}
+
+	// Snapshots are taken of the underlying database, so that they include
+	// all of the persisted keys regardless of how the database is wrapped.
+	n.dbSnapshotter, _ = n.DB.(database.Snapshotter)
+
+	restoringSnapshot := n.Config.DatabaseConfig.RestoreSnapshotFile != ""
+	if restoringSnapshot {
+		if err := n.restoreDatabaseSnapshot(); err != nil {
+			return err
 		}
-	default:
-		return fmt.Errorf(
-			"db-type was %q but should have been one of {%s, %s, %s}",
-			n.Config.DatabaseConfig.Name,
-			leveldb.Name,
-			memdb.Name,
-			pebble.Name,
-		)
 	}
 
 	if n.Config.ReadOnly && n.Config.DatabaseConfig.Name != memdb.Name {
 		n.DB = versiondb.New(n.DB)
 	}
 
-	var err error
 	n.DB, err = meterdb.New("db", n.MetricsRegisterer, n.DB)
 	if err != nil {
 		return err
@@ -763,6 +873,9 @@ func (n *Node) initDatabase() error {
 	rawExpectedGenesisHash := hashing.ComputeHash256(n.Config.GenesisBytes)
 
 	rawGenesisHash, err := n.DB.Get(genesisHashKey)
+	if err == database.ErrNotFound && restoringSnapshot {
+		return errSnapshotMissingGenesis
+	}
 	if err == database.ErrNotFound {
 		rawGenesisHash = rawExpectedGenesisHash
 		err = n.DB.Put(genesisHashKey, rawGenesisHash)
@@ -807,6 +920,171 @@ func (n *Node) initDatabase() error {
 	return nil
 }
 
+// restoreDatabaseSnapshot writes the configured snapshot into the empty
+// database. The snapshot is verified before the database is written to, and
+// the restored contents are validated against the genesis of this node before
+// any chains are started.
+//
+// Before the snapshot is written, it is recorded in the database as being
+// restored. If the node stops during the restore, the partially restored
+// database is wiped and the restore starts over on the next start. Once the
+// snapshot has been restored, it is recorded as restored so that the restore
+// is skipped on subsequent restarts.
+func (n *Node) restoreDatabaseSnapshot() error {
+	path := n.Config.DatabaseConfig.RestoreSnapshotFile
+	marker, err := n.DB.Get(restoredSnapshotKey)
+	switch {
+	case err == database.ErrNotFound:
+	case err != nil:
+		return fmt.Errorf("failed to read restored snapshot key: %w", err)
+	default:
+		if len(marker) != 1+ids.IDLen {
+			return fmt.Errorf("%w: expected %d bytes but got %d",
+				errInvalidRestoredSnapshot,
+				1+ids.IDLen,
+				len(marker),
+			)
+		}
+		status := marker[0]
+		restoredChecksum := ids.ID(marker[1:])
+		switch status {
+		case snapshotRestored:
+			return n.verifyRestoredDatabaseSnapshot(path, restoredChecksum)
+		case snapshotRestoring:
+			n.Log.Warn("wiping partially restored database snapshot",
+				zap.Stringer("checksum", restoredChecksum),
+			)
+			if err := database.Clear(n.DB, restoreSnapshotClearSize); err != nil {
+				return fmt.Errorf("couldn't wipe partially restored database snapshot: %w", err)
+			}
+		default:
+			return fmt.Errorf("%w: unknown status %d", errInvalidRestoredSnapshot, status)
+		}
+	}
+
+	isEmpty, err := database.IsEmpty(n.DB)
+	if err != nil {
+		return err
+	}
+	if !isEmpty {
+		return fmt.Errorf("couldn't restore database snapshot %s: %w", path, snapshot.ErrDatabaseNotEmpty)
+	}
+
+	n.Log.Info("verifying database snapshot",
+		zap.String("path", path),
+	)
+
+	// Verify the entire snapshot before anything is written, so that a
+	// corrupted snapshot never touches the database.
+	verifiedSummary, err := snapshot.VerifyFile(path)
+	if err != nil {
+		return fmt.Errorf("couldn't verify database snapshot %s: %w", path, err)
+	}
+
+	n.Log.Info("restoring database snapshot",
+		zap.String("path", path),
+		zap.Stringer("checksum", verifiedSummary.Checksum),
+	)
+
+	if err := putRestoredSnapshot(n.DB, snapshotRestoring, verifiedSummary.Checksum); err != nil {
+		return err
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("couldn't open database snapshot %s: %w", path, err)
+	}
+	defer f.Close()
+
+	// The snapshot may have been taken from a database that was itself
+	// restored from a snapshot, so its marker must not replace ours.
+	summary, err := snapshot.Load(f, skipKeyBatcher{
+		Batcher: n.DB,
+		key:     restoredSnapshotKey,
+	})
+	if err != nil {
+		return fmt.Errorf("couldn't restore database snapshot %s: %w", path, err)
+	}
+	if summary.Checksum != verifiedSummary.Checksum {
+		return fmt.Errorf("%w: verified %s but restored %s",
+			errSnapshotChanged,
+			verifiedSummary.Checksum,
+			summary.Checksum,
+		)
+	}
+	if err := putRestoredSnapshot(n.DB, snapshotRestored, summary.Checksum); err != nil {
+		return err
+	}
+
+	n.Log.Info("restored database snapshot",
+		zap.String("path", path),
+		zap.Uint64("numKeys", summary.NumKeys),
+		zap.Stringer("checksum", summary.Checksum),
+	)
+	return nil
+}
+
+func putRestoredSnapshot(db database.KeyValueWriter, status byte, checksum ids.ID) error {
+	marker := make([]byte, 0, 1+ids.IDLen)
+	marker = append(marker, status)
+	marker = append(marker, checksum[:]...)
+	if err := db.Put(restoredSnapshotKey, marker); err != nil {
+		return fmt.Errorf("failed to write restored snapshot key: %w", err)
+	}
+	return nil
+}
+
+// skipKeyBatcher creates batches that drop writes to [key].
+type skipKeyBatcher struct {
+	database.Batcher
+	key []byte
+}
+
+func (b skipKeyBatcher) NewBatch() database.Batch {
+	return skipKeyBatch{
+		Batch: b.Batcher.NewBatch(),
+		key:   b.key,
+	}
+}
+
+type skipKeyBatch struct {
+	database.Batch
+	key []byte
+}
+
+func (b skipKeyBatch) Put(key, value []byte) error {
+	if bytes.Equal(key, b.key) {
+		return nil
+	}
+	return b.Batch.Put(key, value)
+}
+
+// verifyRestoredDatabaseSnapshot returns an error if the database was restored
+// from a different snapshot than the one at [path].
+func (n *Node) verifyRestoredDatabaseSnapshot(path string, restoredChecksum ids.ID) error {
+	checksum, err := snapshot.ReadFileChecksum(path)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		// The snapshot may have been removed after it was restored.
+	case err != nil:
+		return fmt.Errorf("couldn't read database snapshot %s: %w", path, err)
+	case checksum != restoredChecksum:
+		return fmt.Errorf("%w: database was restored from %s but %s is %s",
+			errDifferentSnapshotRestored,
+			restoredChecksum,
+			path,
+			checksum,
+		)
+	}
+
+	n.Log.Info("skipping database snapshot restore",
+		zap.String("reason", "snapshot was already restored"),
+		zap.String("path", path),
+		zap.Stringer("checksum", restoredChecksum),
+	)
+	return nil
+}
+
 // Set the node IDs of the peers this node should first connect to
 func (n *Node) initBootstrappers() error {
 	n.bootstrappers = validators.NewManager()
@@ -848,6 +1126,7 @@ func (n *Node) initIndexer() error {
 		ShutdownF: func() {
 			n.Shutdown(0) // TODO put exit code here
 		},
+		SubscriptionsEnabled: n.Config.IndexSubscriptionsEnabled,
 	})
 	if err != nil {
 		return fmt.Errorf("couldn't create index for txs: %w", err)
@@ -1096,6 +1375,7 @@ func (n *Node) initChainManager(avaxAssetID ids.ID) error {
 			ApricotPhase4Time:                       version.GetApricotPhase4Time(n.Config.NetworkID),
 			ApricotPhase4MinPChainHeight:            version.ApricotPhase4MinPChainHeight[n.Config.NetworkID],
 			ResourceTracker:                         n.resourceTracker,
+			Reputation:                              n.reputation,
 			StateSyncBeacons:                        n.Config.StateSyncIDs,
 			TracingEnabled:                          n.Config.TraceConfig.Enabled,
 			Tracer:                                  n.tracer,
@@ -1134,6 +1414,7 @@ func (n *Node) initVMs() error {
 				PartialSyncPrimaryNetwork: n.Config.PartialSyncPrimaryNetwork,
 				TrackedSubnets:            n.Config.TrackedSubnets,
 				StaticFeeConfig:           n.Config.StaticConfig,
+				DynamicFeeConfig:          n.Config.DynamicFeeConfig,
 				UptimePercentage:          n.Config.UptimeRequirement,
 				MinValidatorStake:         n.Config.MinValidatorStake,
 				MaxValidatorStake:         n.Config.MaxValidatorStake,
@@ -1262,15 +1543,20 @@ func (n *Node) initAdminAPI() error {
 	n.Log.Info("initializing admin API")
 	service, err := admin.NewService(
 		admin.Config{
-			Log:          n.Log,
-			DB:           n.DB,
-			ChainManager: n.chainManager,
-			HTTPServer:   n.APIServer,
-			ProfileDir:   n.Config.ProfilerConfig.Dir,
-			LogFactory:   n.LogFactory,
-			NodeConfig:   n.Config,
-			VMManager:    n.VMManager,
-			VMRegistry:   n.VMRegistry,
+			Log:           n.Log,
+			DB:            n.DB,
+			DBSnapshotter: n.dbSnapshotter,
+			SnapshotDir:   n.Config.DatabaseConfig.SnapshotDir,
+			ChainManager:  n.chainManager,
+			HTTPServer:    n.APIServer,
+			ProfileDir:    n.Config.ProfilerConfig.Dir,
+			LogFactory:    n.LogFactory,
+			NodeConfig:    n.Config,
+			VMManager:     n.VMManager,
+			VMRegistry:    n.VMRegistry,
+			Network:       n.Net,
+
+			ConnectionPolicyFile: n.Config.NetworkConfig.ConnectionPolicyFile,
 		},
 	)
 	if err != nil {
@@ -1319,7 +1605,7 @@ func (n *Node) initInfoAPI() error {
 		info.Parameters{
 			Version:                       version.CurrentApp,
 			NodeID:                        n.ID,
-			NodePOP:                       signer.NewProofOfPossession(n.Config.StakingSigningKey),
+			NodePOP:                       n.pop,
 			NetworkID:                     n.Config.NetworkID,
 			TxFee:                         n.Config.TxFee,
 			CreateAssetTxFee:              n.Config.CreateAssetTxFee,
@@ -1339,6 +1625,7 @@ func (n *Node) initInfoAPI() error {
 		n.Config.NetworkConfig.MyIPPort,
 		n.Net,
 		n.benchlistManager,
+		n.reputation,
 	)
 	if err != nil {
 		return err
@@ -1614,6 +1901,14 @@ func (n *Node) shutdown() {
 		}
 	}
 
+	if n.stakingRPCSignerConn != nil {
+		if err := n.stakingRPCSignerConn.Close(); err != nil {
+			n.Log.Debug("error closing connection to staking signer",
+				zap.Error(err),
+			)
+		}
+	}
+
 	if n.Config.TraceConfig.Enabled {
 		n.Log.Info("shutting down tracing")
 	}
@@ -0,0 +1,14 @@
+#!/usr/bin/env bash
+
+set -euo pipefail
+
+# Avalanchego root folder
+AVALANCHE_PATH=$( cd "$( dirname "${BASH_SOURCE[0]}" )"; cd .. && pwd )
+# Load the constants
+source "$AVALANCHE_PATH"/scripts/constants.sh
+
+echo "Building snapshotctl..."
+go build -ldflags\
+   "-X github.com/ava-labs/avalanchego/version.GitCommit=$git_commit $static_ld_flags"\
+   -o "$AVALANCHE_PATH/build/snapshotctl"\
+   "$AVALANCHE_PATH/database/snapshot/cmd/"*.go
@@ -76,6 +76,12 @@ func (sf *binarySnowflake) Finalized() bool {
 	return sf.finalized
 }
 
+func (sf *binarySnowflake) SetParameters(params Parameters) {
+	sf.alphaPreference = params.AlphaPreference
+	sf.alphaConfidence = params.AlphaConfidence
+	sf.beta = params.Beta
+}
+
 func (sf *binarySnowflake) String() string {
 	return fmt.Sprintf("SF(Confidence = %d, Finalized = %v, %s)",
 		sf.confidence,
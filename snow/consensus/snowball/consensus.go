@@ -38,6 +38,10 @@ type Consensus interface {
 
 	// Return whether a choice has been finalized
 	Finalized() bool
+
+	// SetParameters replaces the parameters of this instance. The preference
+	// and confidence of the instance are kept.
+	SetParameters(params Parameters)
 }
 
 // Factory produces Nnary and Unary decision instances
@@ -67,6 +71,10 @@ type Nnary interface {
 
 	// Return whether a choice has been finalized
 	Finalized() bool
+
+	// SetParameters replaces the parameters of this instance. The preference
+	// and confidence of the instance are kept.
+	SetParameters(params Parameters)
 }
 
 // Binary is a snow instance deciding between two values.
@@ -87,6 +95,10 @@ type Binary interface {
 
 	// Return whether a choice has been finalized
 	Finalized() bool
+
+	// SetParameters replaces the parameters of this instance. The preference
+	// and confidence of the instance are kept.
+	SetParameters(params Parameters)
 }
 
 // Unary is a snow instance deciding on one value.
@@ -105,6 +117,10 @@ type Unary interface {
 	// Return whether a choice has been finalized
 	Finalized() bool
 
+	// SetParameters replaces the parameters of this instance. The preference
+	// and confidence of the instance are kept.
+	SetParameters(params Parameters)
+
 	// Returns a new binary snowball instance with the original choice.
 	Extend(originalPreference int) Binary
 
@@ -44,6 +44,8 @@ func (*Byzantine) Finalized() bool {
 	return true
 }
 
+func (*Byzantine) SetParameters(Parameters) {}
+
 func (b *Byzantine) String() string {
 	return b.preference.String()
 }
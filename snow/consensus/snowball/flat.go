@@ -26,6 +26,11 @@ type Flat struct {
 	params Parameters
 }
 
+func (f *Flat) SetParameters(params Parameters) {
+	f.params = params
+	f.Nnary.SetParameters(params)
+}
+
 func (f *Flat) RecordPoll(votes bag.Bag[ids.ID]) bool {
 	pollMode, numVotes := votes.Mode()
 	f.Nnary.RecordPoll(numVotes, pollMode)
@@ -83,6 +83,12 @@ func (sf *nnarySnowflake) Finalized() bool {
 	return sf.finalized
 }
 
+func (sf *nnarySnowflake) SetParameters(params Parameters) {
+	sf.alphaPreference = params.AlphaPreference
+	sf.alphaConfidence = params.AlphaConfidence
+	sf.beta = params.Beta
+}
+
 func (sf *nnarySnowflake) String() string {
 	return fmt.Sprintf("SF(Confidence = %d, Finalized = %v, %s)",
 		sf.confidence,
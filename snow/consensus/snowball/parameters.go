@@ -122,3 +122,40 @@ func (p Parameters) MinPercentConnectedHealthy() float64 {
 	alphaRatio := float64(p.AlphaConfidence) / float64(p.K)
 	return alphaRatio*(1-MinPercentConnectedBuffer) + MinPercentConnectedBuffer
 }
+
+// Scale returns the parameters to use when sampling from a validator set with
+// [numValidators] distinct validators.
+//
+// Sampling more validators than there are in the set only repeats votes of the
+// same validators, so if there are fewer than K validators, K is lowered to the
+// number of validators, the alphas are scaled down proportionally, and
+// ConcurrentRepolls is scaled down proportionally to at least 1. Beta is never
+// modified.
+//
+// Scaling doesn't weaken the safety of a poll: the alphas are rounded up, so
+// the scaled alphas require at least the same fraction of the scaled K as the
+// original alphas required of K. They are also never lowered to a majority of
+// the scaled K or less. Because every sampled validator votes for a single
+// choice, two conflicting choices can't both reach alpha votes in the same poll
+// as long as alpha > K/2. The returned parameters are therefore valid if [p] is
+// valid.
+//
+// If there are at least K validators, [p] is returned unmodified.
+func (p Parameters) Scale(numValidators int) Parameters {
+	if numValidators <= 0 || numValidators >= p.K {
+		return p
+	}
+
+	k := numValidators
+	scaled := p
+	scaled.K = k
+	scaled.AlphaPreference = max(k/2+1, scaleUp(p.AlphaPreference, k, p.K))
+	scaled.AlphaConfidence = max(scaled.AlphaPreference, scaleUp(p.AlphaConfidence, k, p.K))
+	scaled.ConcurrentRepolls = max(1, scaleUp(p.ConcurrentRepolls, k, p.K))
+	return scaled
+}
+
+// scaleUp returns ceil(value * numerator / denominator).
+func scaleUp(value, numerator, denominator int) int {
+	return (value*numerator + denominator - 1) / denominator
+}
@@ -5,6 +5,7 @@ package snowball
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -256,3 +257,116 @@ func TestParametersMinPercentConnectedHealthy(t *testing.T) {
 		})
 	}
 }
+
+func TestParametersScale(t *testing.T) {
+	tests := []struct {
+		name           string
+		params         Parameters
+		numValidators  int
+		expectedParams Parameters
+	}{
+		{
+			name:           "no validators",
+			params:         DefaultParameters,
+			numValidators:  0,
+			expectedParams: DefaultParameters,
+		},
+		{
+			name:           "k validators",
+			params:         DefaultParameters,
+			numValidators:  DefaultParameters.K,
+			expectedParams: DefaultParameters,
+		},
+		{
+			name:           "more than k validators",
+			params:         DefaultParameters,
+			numValidators:  1000,
+			expectedParams: DefaultParameters,
+		},
+		{
+			name:          "5 validators",
+			params:        DefaultParameters,
+			numValidators: 5,
+			expectedParams: Parameters{
+				K:                     5,
+				AlphaPreference:       4,
+				AlphaConfidence:       4,
+				Beta:                  20,
+				ConcurrentRepolls:     1,
+				OptimalProcessing:     10,
+				MaxOutstandingItems:   256,
+				MaxItemProcessingTime: 30 * time.Second,
+			},
+		},
+		{
+			name:          "10 validators",
+			params:        DefaultParameters,
+			numValidators: 10,
+			expectedParams: Parameters{
+				K:                     10,
+				AlphaPreference:       8,
+				AlphaConfidence:       8,
+				Beta:                  20,
+				ConcurrentRepolls:     2,
+				OptimalProcessing:     10,
+				MaxOutstandingItems:   256,
+				MaxItemProcessingTime: 30 * time.Second,
+			},
+		},
+		{
+			name: "alphas kept above majority",
+			params: Parameters{
+				K:                     20,
+				AlphaPreference:       11,
+				AlphaConfidence:       12,
+				Beta:                  20,
+				ConcurrentRepolls:     4,
+				OptimalProcessing:     10,
+				MaxOutstandingItems:   256,
+				MaxItemProcessingTime: 30 * time.Second,
+			},
+			numValidators: 4,
+			expectedParams: Parameters{
+				K:                     4,
+				AlphaPreference:       3,
+				AlphaConfidence:       3,
+				Beta:                  20,
+				ConcurrentRepolls:     1,
+				OptimalProcessing:     10,
+				MaxOutstandingItems:   256,
+				MaxItemProcessingTime: 30 * time.Second,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			scaled := test.params.Scale(test.numValidators)
+			require.Equal(test.expectedParams, scaled)
+			require.NoError(scaled.Verify())
+		})
+	}
+}
+
+func TestParametersScaleValid(t *testing.T) {
+	require := require.New(t)
+
+	for k := 1; k <= 30; k++ {
+		for alphaPreference := k/2 + 1; alphaPreference <= k; alphaPreference++ {
+			for alphaConfidence := alphaPreference; alphaConfidence <= k; alphaConfidence++ {
+				params := DefaultParameters
+				params.K = k
+				params.AlphaPreference = alphaPreference
+				params.AlphaConfidence = alphaConfidence
+				require.NoError(params.Verify())
+
+				for numValidators := 1; numValidators <= k; numValidators++ {
+					scaled := params.Scale(numValidators)
+					require.NoError(scaled.Verify())
+					require.Greater(2*scaled.AlphaPreference, scaled.K)
+				}
+			}
+		}
+	}
+}
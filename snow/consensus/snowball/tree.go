@@ -90,6 +90,11 @@ func (t *Tree) RecordUnsuccessfulPoll() {
 	t.shouldReset = true
 }
 
+func (t *Tree) SetParameters(params Parameters) {
+	t.params = params
+	t.node.SetParameters(params)
+}
+
 func (t *Tree) String() string {
 	sb := strings.Builder{}
 
@@ -134,6 +139,8 @@ type node interface {
 	RecordPoll(votes bag.Bag[ids.ID], shouldReset bool) (newChild node, successful bool)
 	// Returns true if consensus has been reached on this node
 	Finalized() bool
+	// Replaces the parameters of the snow instances in this sub-tree
+	SetParameters(params Parameters)
 
 	Printable() (string, []node)
 }
@@ -452,6 +459,13 @@ func (u *unaryNode) Finalized() bool {
 	return u.snow.Finalized()
 }
 
+func (u *unaryNode) SetParameters(params Parameters) {
+	u.snow.SetParameters(params)
+	if u.child != nil {
+		u.child.SetParameters(params)
+	}
+}
+
 func (u *unaryNode) Printable() (string, []node) {
 	s := fmt.Sprintf("%s Bits = [%d, %d)",
 		u.snow, u.decidedPrefix, u.commonPrefix)
@@ -558,6 +572,15 @@ func (b *binaryNode) Finalized() bool {
 	return b.snow.Finalized()
 }
 
+func (b *binaryNode) SetParameters(params Parameters) {
+	b.snow.SetParameters(params)
+	for _, child := range b.children {
+		if child != nil {
+			child.SetParameters(params)
+		}
+	}
+}
+
 func (b *binaryNode) Printable() (string, []node) {
 	s := fmt.Sprintf("%s Bit = %d", b.snow, b.bit)
 	if b.children[0] == nil {
@@ -57,6 +57,52 @@ func TestSnowballSingleton(t *testing.T) {
 	require.True(tree.Finalized())
 }
 
+func TestSnowballSetParameters(t *testing.T) {
+	require := require.New(t)
+
+	params := Parameters{
+		K:               1,
+		AlphaPreference: 1,
+		AlphaConfidence: 1,
+		Beta:            2,
+	}
+	tree := NewTree(SnowballFactory, params, Red)
+	tree.Add(Blue)
+	tree.Add(Green)
+
+	oneBlue := bag.Of(Blue)
+	require.True(tree.RecordPoll(oneBlue))
+	require.Equal(Blue, tree.Preference())
+	require.False(tree.Finalized())
+
+	tree.SetParameters(Parameters{
+		K:               2,
+		AlphaPreference: 2,
+		AlphaConfidence: 2,
+		Beta:            3,
+	})
+
+	// The confidence is kept across parameter changes, so only 2 more
+	// successful polls are needed.
+	twoBlue := bag.Of(Blue, Blue)
+	require.True(tree.RecordPoll(twoBlue))
+	require.False(tree.Finalized())
+	require.True(tree.RecordPoll(twoBlue))
+	require.Equal(Blue, tree.Preference())
+	require.True(tree.Finalized())
+
+	// The new alpha is used.
+	tree = NewTree(SnowballFactory, params, Red)
+	tree.SetParameters(Parameters{
+		K:               2,
+		AlphaPreference: 2,
+		AlphaConfidence: 2,
+		Beta:            1,
+	})
+	require.False(tree.RecordPoll(bag.Of(Red)))
+	require.False(tree.Finalized())
+}
+
 func TestSnowballRecordUnsuccessfulPoll(t *testing.T) {
 	require := require.New(t)
 
@@ -54,6 +54,12 @@ func (sf *unarySnowflake) Finalized() bool {
 	return sf.finalized
 }
 
+func (sf *unarySnowflake) SetParameters(params Parameters) {
+	sf.alphaPreference = params.AlphaPreference
+	sf.alphaConfidence = params.AlphaConfidence
+	sf.beta = params.Beta
+}
+
 func (sf *unarySnowflake) Extend(choice int) Binary {
 	return &binarySnowflake{
 		binarySlush:     binarySlush{preference: choice},
@@ -28,6 +28,10 @@ type Consensus interface {
 		lastAcceptedTime time.Time,
 	) error
 
+	// SetParameters replaces the snowball parameters. Processing blocks keep
+	// their current preferences and confidence.
+	SetParameters(params snowball.Parameters) error
+
 	// Returns the number of blocks processing
 	NumProcessing() int
 
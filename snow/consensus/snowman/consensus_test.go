@@ -47,6 +47,7 @@ var (
 		RecordPollTransitiveVotingTest,
 		RecordPollDivergedVotingWithNoConflictingBitTest,
 		RecordPollChangePreferredChainTest,
+		SetParametersTest,
 		LastAcceptedTest,
 		MetricsProcessingErrorTest,
 		MetricsAcceptedErrorTest,
@@ -1020,6 +1021,74 @@ func RecordPollChangePreferredChainTest(t *testing.T, factory Factory) {
 	require.Equal(a2Block.ID(), pref)
 }
 
+func SetParametersTest(t *testing.T, factory Factory) {
+	require := require.New(t)
+
+	sm := factory.New()
+
+	snowCtx := snowtest.Context(t, snowtest.CChainID)
+	ctx := snowtest.ConsensusContext(snowCtx)
+	params := snowball.Parameters{
+		K:                     1,
+		AlphaPreference:       1,
+		AlphaConfidence:       1,
+		Beta:                  2,
+		ConcurrentRepolls:     1,
+		OptimalProcessing:     1,
+		MaxOutstandingItems:   1,
+		MaxItemProcessingTime: 1,
+	}
+	require.NoError(sm.Initialize(
+		ctx,
+		params,
+		snowmantest.GenesisID,
+		snowmantest.GenesisHeight,
+		snowmantest.GenesisTimestamp,
+	))
+
+	block0 := snowmantest.BuildChild(snowmantest.Genesis)
+	block1 := snowmantest.BuildChild(snowmantest.Genesis)
+	require.NoError(sm.Add(context.Background(), block0))
+	require.NoError(sm.Add(context.Background(), block1))
+
+	require.NoError(sm.RecordPoll(context.Background(), bag.Of(block1.ID())))
+	require.Equal(block1.ID(), sm.Preference())
+
+	invalidParams := params
+	invalidParams.AlphaPreference = 0
+	err := sm.SetParameters(invalidParams)
+	require.ErrorIs(err, snowball.ErrParametersInvalid)
+
+	newParams := snowball.Parameters{
+		K:                     2,
+		AlphaPreference:       2,
+		AlphaConfidence:       2,
+		Beta:                  3,
+		ConcurrentRepolls:     1,
+		OptimalProcessing:     1,
+		MaxOutstandingItems:   1,
+		MaxItemProcessingTime: 1,
+	}
+	require.NoError(sm.SetParameters(newParams))
+
+	// The preference is maintained across the parameter change.
+	require.Equal(block1.ID(), sm.Preference())
+	require.True(sm.IsPreferred(block1))
+	require.False(sm.IsPreferred(block0))
+
+	// The confidence gained under the prior parameters is kept, so only
+	// beta-1 more successful polls are required.
+	votes := bag.Of(block1.ID(), block1.ID())
+	for i := 0; i < newParams.Beta-2; i++ {
+		require.NoError(sm.RecordPoll(context.Background(), votes))
+		require.Equal(choices.Processing, block1.Status())
+	}
+	require.NoError(sm.RecordPoll(context.Background(), votes))
+	require.Equal(choices.Accepted, block1.Status())
+	require.Equal(choices.Rejected, block0.Status())
+	require.Zero(sm.NumProcessing())
+}
+
 func LastAcceptedTest(t *testing.T, factory Factory) {
 	sm := factory.New()
 	require := require.New(t)
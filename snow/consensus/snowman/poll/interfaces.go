@@ -19,6 +19,9 @@ type Set interface {
 	Vote(requestID uint32, vdr ids.NodeID, vote ids.ID) []bag.Bag[ids.ID]
 	Drop(requestID uint32, vdr ids.NodeID) []bag.Bag[ids.ID]
 	Len() int
+	// SetFactory replaces the factory used to create polls. Outstanding polls
+	// are kept and finish using the factory they were created with.
+	SetFactory(factory Factory)
 }
 
 // Poll is an outstanding poll
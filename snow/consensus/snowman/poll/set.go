@@ -204,6 +204,10 @@ func (s *set) Len() int {
 	return s.polls.Len()
 }
 
+func (s *set) SetFactory(factory Factory) {
+	s.factory = factory
+}
+
 func (s *set) String() string {
 	sb := strings.Builder{}
 	sb.WriteString(fmt.Sprintf("current polls: (Size = %d)", s.polls.Len()))
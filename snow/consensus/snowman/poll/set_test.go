@@ -243,6 +243,37 @@ func TestCreateAndFinishFailedPoll(t *testing.T) {
 	require.Empty(results[0].List())
 }
 
+func TestSetSetFactory(t *testing.T) {
+	require := require.New(t)
+
+	vdrs := bag.Of(vdr1, vdr2) // k = 2
+
+	factory := NewEarlyTermNoTraversalFactory(2, 2)
+	log := logging.NoLog{}
+	namespace := ""
+	registerer := prometheus.NewRegistry()
+	s, err := NewSet(factory, log, namespace, registerer)
+	require.NoError(err)
+
+	require.True(s.Add(0, vdrs))
+	require.Empty(s.Vote(0, vdr1, blkID1))
+
+	s.SetFactory(NewEarlyTermNoTraversalFactory(1, 1))
+	require.Equal(1, s.Len())
+
+	// New polls use the new factory, but results are only returned once the
+	// older outstanding poll finishes.
+	require.True(s.Add(1, bag.Of(vdr1)))
+	require.Empty(s.Vote(1, vdr1, blkID2))
+
+	// The outstanding poll still requires both votes.
+	results := s.Vote(0, vdr2, blkID1)
+	require.Len(results, 2)
+	require.Equal(2, results[0].Count(blkID1))
+	require.Equal(1, results[1].Count(blkID2))
+	require.Zero(s.Len())
+}
+
 func TestSetString(t *testing.T) {
 	require := require.New(t)
 
@@ -0,0 +1,88 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package simulation
+
+import (
+	"context"
+	"encoding/binary"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/choices"
+	"github.com/ava-labs/avalanchego/snow/consensus/snowman"
+	"github.com/ava-labs/avalanchego/utils/hashing"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+var _ snowman.Block = (*block)(nil)
+
+// blockTemplate is a block produced in the simulation. Every validator tracks
+// its own copy of the block, as the status of a block is validator specific.
+type blockTemplate struct {
+	id       ids.ID
+	parentID ids.ID
+	height   uint64
+	bytes    []byte
+	// producedAt is the simulated time the block was produced at
+	producedAt time.Duration
+}
+
+func newBlockTemplate(index uint64, parent *blockTemplate, producedAt time.Duration) *blockTemplate {
+	bytes := make([]byte, wrappers.LongLen+ids.IDLen)
+	binary.BigEndian.PutUint64(bytes, index)
+	copy(bytes[wrappers.LongLen:], parent.id[:])
+	return &blockTemplate{
+		id:         hashing.ComputeHash256Array(bytes),
+		parentID:   parent.id,
+		height:     parent.height + 1,
+		bytes:      bytes,
+		producedAt: producedAt,
+	}
+}
+
+type block struct {
+	*blockTemplate
+
+	node   *node
+	status choices.Status
+}
+
+func (b *block) ID() ids.ID {
+	return b.id
+}
+
+func (b *block) Accept(context.Context) error {
+	b.status = choices.Accepted
+	b.node.accepted(b.blockTemplate)
+	return nil
+}
+
+func (b *block) Reject(context.Context) error {
+	b.status = choices.Rejected
+	return nil
+}
+
+func (b *block) Status() choices.Status {
+	return b.status
+}
+
+func (b *block) Parent() ids.ID {
+	return b.parentID
+}
+
+func (*block) Verify(context.Context) error {
+	return nil
+}
+
+func (b *block) Bytes() []byte {
+	return b.bytes
+}
+
+func (b *block) Height() uint64 {
+	return b.height
+}
+
+func (b *block) Timestamp() time.Time {
+	return time.Unix(0, int64(b.producedAt))
+}
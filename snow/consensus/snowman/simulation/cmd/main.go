@@ -0,0 +1,90 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ava-labs/avalanchego/snow/consensus/snowman/simulation"
+)
+
+var (
+	errScenarioRequired = errors.New("--scenario is required")
+	errInvalidRuns      = errors.New("--runs must be positive")
+)
+
+func main() {
+	var (
+		scenarioFile string
+		seed         uint64
+		runs         int
+		printJSON    bool
+	)
+	rootCmd := &cobra.Command{
+		Use:   "snowsim",
+		Short: "Simulate a network of snowman validators described by a scenario file",
+		// Errors are printed by main.
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if len(scenarioFile) == 0 {
+				return errScenarioRequired
+			}
+			if runs <= 0 {
+				return errInvalidRuns
+			}
+			scenario, err := simulation.ParseScenarioFile(scenarioFile)
+			if err != nil {
+				return err
+			}
+			if cmd.Flags().Changed("seed") {
+				scenario.Seed = seed
+			}
+
+			reports := make([]*simulation.Report, 0, runs)
+			for i := 0; i < runs; i++ {
+				sim, err := simulation.New(scenario)
+				if err != nil {
+					return err
+				}
+				report, err := sim.Run()
+				if err != nil {
+					return fmt.Errorf("simulation with seed %d failed: %w", scenario.Seed, err)
+				}
+				reports = append(reports, report)
+				scenario.Seed++
+			}
+
+			if printJSON {
+				encoder := json.NewEncoder(os.Stdout)
+				encoder.SetIndent("", "  ")
+				return encoder.Encode(reports)
+			}
+			for i, report := range reports {
+				if i > 0 {
+					fmt.Fprintln(os.Stdout)
+				}
+				if err := report.Print(os.Stdout); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+	rootCmd.Flags().StringVar(&scenarioFile, "scenario", "", "Path to the yaml or json scenario file")
+	rootCmd.Flags().Uint64Var(&seed, "seed", 0, "Seed of the first run, overriding the seed in the scenario file")
+	rootCmd.Flags().IntVar(&runs, "runs", 1, "Number of runs, each run uses the seed of the previous run plus one")
+	rootCmd.Flags().BoolVar(&printJSON, "json", false, "Print the reports as json")
+
+	if err := rootCmd.Execute(); err != nil {
+		fmt.Fprintf(os.Stderr, "snowsim failed: %v\n", err)
+		os.Exit(1)
+	}
+	os.Exit(0)
+}
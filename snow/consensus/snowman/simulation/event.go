@@ -0,0 +1,21 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package simulation
+
+import "time"
+
+type event struct {
+	time time.Duration
+	// index breaks ties between events that occur at the same time, so that
+	// events are run in the order they were scheduled.
+	index uint64
+	run   func() error
+}
+
+func eventLess(a, b *event) bool {
+	if a.time != b.time {
+		return a.time < b.time
+	}
+	return a.index < b.index
+}
@@ -0,0 +1,316 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package simulation
+
+import (
+	"context"
+	"encoding/binary"
+	"slices"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/snow/choices"
+	"github.com/ava-labs/avalanchego/snow/consensus/snowman"
+	"github.com/ava-labs/avalanchego/snow/consensus/snowman/poll"
+	"github.com/ava-labs/avalanchego/utils/bag"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/set"
+)
+
+var _ snow.Acceptor = noOpAcceptor{}
+
+type noOpAcceptor struct{}
+
+func (noOpAcceptor) Accept(*snow.ConsensusContext, ids.ID, []byte) error {
+	return nil
+}
+
+// node is a simulated validator. Honest nodes run snowman consensus the same
+// way the snowman engine does, byzantine nodes only respond to queries.
+type node struct {
+	sim       *Simulation
+	index     int
+	nodeID    ids.NodeID
+	weight    uint64
+	byzantine bool
+
+	consensus snowman.Consensus
+	polls     poll.Set
+	requestID uint32
+	// requestID -> validators that haven't responded to the query yet
+	outstanding map[uint32]set.Set[int]
+
+	// blocks that have been delivered to this node and whose parent was
+	// known, so they were issued into consensus
+	blocks map[ids.ID]*block
+	// parentID -> blocks that are waiting for their parent to be delivered
+	waiting map[ids.ID][]*blockTemplate
+}
+
+func newNode(sim *Simulation, index int, weight uint64, byzantine bool) (*node, error) {
+	n := &node{
+		sim:         sim,
+		index:       index,
+		nodeID:      nodeID(index),
+		weight:      weight,
+		byzantine:   byzantine,
+		outstanding: make(map[uint32]set.Set[int]),
+		blocks:      make(map[ids.ID]*block),
+		waiting:     make(map[ids.ID][]*blockTemplate),
+	}
+	if byzantine {
+		return n, nil
+	}
+
+	registerer := prometheus.NewRegistry()
+	ctx := &snow.ConsensusContext{
+		Context: &snow.Context{
+			Log: logging.NoLog{},
+		},
+		Registerer:    registerer,
+		BlockAcceptor: noOpAcceptor{},
+	}
+
+	params := sim.scenario.Parameters
+	n.consensus = &snowman.Topological{}
+	genesis := sim.genesis
+	if err := n.consensus.Initialize(
+		ctx,
+		params,
+		genesis.id,
+		genesis.height,
+		time.Unix(0, 0),
+	); err != nil {
+		return nil, err
+	}
+
+	polls, err := poll.NewSet(
+		poll.NewEarlyTermNoTraversalFactory(params.AlphaPreference, params.AlphaConfidence),
+		ctx.Log,
+		"",
+		registerer,
+	)
+	if err != nil {
+		return nil, err
+	}
+	n.polls = polls
+	n.blocks[genesis.id] = &block{
+		blockTemplate: genesis,
+		node:          n,
+		status:        choices.Accepted,
+	}
+	return n, nil
+}
+
+// deliver [blk] to this node. If the parent of [blk] hasn't been delivered
+// yet, issuance is delayed until it is.
+func (n *node) deliver(blk *blockTemplate) error {
+	if _, ok := n.blocks[blk.id]; ok {
+		return nil
+	}
+	if _, ok := n.blocks[blk.parentID]; !ok {
+		n.waiting[blk.parentID] = append(n.waiting[blk.parentID], blk)
+		return nil
+	}
+
+	toIssue := []*blockTemplate{blk}
+	for len(toIssue) > 0 {
+		blk := toIssue[len(toIssue)-1]
+		toIssue = toIssue[:len(toIssue)-1]
+
+		localBlk := &block{
+			blockTemplate: blk,
+			node:          n,
+			status:        choices.Processing,
+		}
+		n.blocks[blk.id] = localBlk
+		// Blocks at or below the last accepted height have already been
+		// transitively rejected, so they must not be added to consensus.
+		if n.consensus.Decided(localBlk) {
+			localBlk.status = choices.Rejected
+		} else if err := n.consensus.Add(context.Background(), localBlk); err != nil {
+			return err
+		}
+
+		toIssue = append(toIssue, n.waiting[blk.id]...)
+		delete(n.waiting, blk.id)
+	}
+
+	n.repoll()
+	return nil
+}
+
+// repoll issues queries until there are ConcurrentRepolls outstanding polls,
+// if there are processing blocks.
+func (n *node) repoll() {
+	if n.consensus.NumProcessing() == 0 {
+		return
+	}
+	for i := n.polls.Len(); i < n.sim.scenario.Parameters.ConcurrentRepolls; i++ {
+		n.sendQuery()
+	}
+}
+
+func (n *node) sendQuery() {
+	indices, ok := n.sim.sampler.Sample(n.sim.scenario.Parameters.K)
+	if !ok {
+		return
+	}
+
+	n.requestID++
+	requestID := n.requestID
+
+	var (
+		vdrs    = bag.Bag[ids.NodeID]{}
+		queried = set.Set[int]{}
+		// distinct is used, rather than iterating over [queried], to keep the
+		// simulation deterministic.
+		distinct []int
+	)
+	for _, index := range indices {
+		vdrs.Add(nodeID(index))
+		if !queried.Contains(index) {
+			queried.Add(index)
+			distinct = append(distinct, index)
+		}
+	}
+	if !n.polls.Add(requestID, vdrs) {
+		return
+	}
+	n.outstanding[requestID] = queried
+	n.sim.queriesSent++
+
+	for _, index := range distinct {
+		to := n.sim.nodes[index]
+		n.sim.send(n, to, func() error {
+			return to.query(n, requestID)
+		})
+	}
+	n.sim.schedule(n.sim.scenario.Network.QueryTimeout, func() error {
+		return n.queryTimeout(requestID)
+	})
+}
+
+// query is called when [from] queries this node for its preference.
+func (n *node) query(from *node, requestID uint32) error {
+	var vote ids.ID
+	switch {
+	case !n.byzantine:
+		vote = n.consensus.Preference()
+	case n.sim.scenario.Byzantine.Behavior == Silent:
+		return nil
+	case n.sim.scenario.Byzantine.Behavior == Conflicting:
+		vote = from.conflictingBlock()
+	default:
+		vote = n.sim.randomBlock()
+	}
+
+	n.sim.send(n, from, func() error {
+		return from.chits(n, requestID, vote)
+	})
+	return nil
+}
+
+// chits is called when [from] responds to the query [requestID] with [vote].
+func (n *node) chits(from *node, requestID uint32, vote ids.ID) error {
+	outstanding, ok := n.outstanding[requestID]
+	if !ok || !outstanding.Contains(from.index) {
+		return nil
+	}
+	outstanding.Remove(from.index)
+	if outstanding.Len() == 0 {
+		delete(n.outstanding, requestID)
+	}
+
+	var results []bag.Bag[ids.ID]
+	if vote, ok := n.processingAncestor(vote); ok {
+		results = n.polls.Vote(requestID, from.nodeID, vote)
+	} else {
+		results = n.polls.Drop(requestID, from.nodeID)
+	}
+	return n.recordPolls(results)
+}
+
+func (n *node) queryTimeout(requestID uint32) error {
+	outstanding, ok := n.outstanding[requestID]
+	if !ok {
+		return nil
+	}
+	delete(n.outstanding, requestID)
+
+	indices := outstanding.List()
+	slices.Sort(indices)
+
+	var results []bag.Bag[ids.ID]
+	for _, index := range indices {
+		results = append(results, n.polls.Drop(requestID, nodeID(index))...)
+	}
+	return n.recordPolls(results)
+}
+
+func (n *node) recordPolls(results []bag.Bag[ids.ID]) error {
+	if len(results) == 0 {
+		return nil
+	}
+	for _, result := range results {
+		if err := n.consensus.RecordPoll(context.Background(), result); err != nil {
+			return err
+		}
+	}
+	n.repoll()
+	return nil
+}
+
+// processingAncestor returns the closest processing ancestor of [blkID],
+// including itself. This mirrors how the snowman engine bubbles votes.
+func (n *node) processingAncestor(blkID ids.ID) (ids.ID, bool) {
+	for {
+		if n.consensus.Processing(blkID) {
+			return blkID, true
+		}
+		if blk, ok := n.blocks[blkID]; ok && blk.status.Decided() {
+			return ids.Empty, false
+		}
+		blk, ok := n.sim.blocks[blkID]
+		if !ok {
+			return ids.Empty, false
+		}
+		blkID = blk.parentID
+	}
+}
+
+// conflictingBlock returns a processing block that conflicts with the lowest
+// possible block of this node's preferred chain. If there are no conflicting
+// blocks, the last accepted block is returned.
+func (n *node) conflictingBlock() ids.ID {
+	lastAcceptedID, height := n.consensus.LastAccepted()
+	parentID := lastAcceptedID
+	for {
+		height++
+		preferredID, ok := n.consensus.PreferenceAtHeight(height)
+		if !ok {
+			return lastAcceptedID
+		}
+		for _, childID := range n.sim.children[parentID] {
+			if childID != preferredID && n.consensus.Processing(childID) {
+				return childID
+			}
+		}
+		parentID = preferredID
+	}
+}
+
+// accepted is called when [blk] is accepted by this node.
+func (n *node) accepted(blk *blockTemplate) {
+	n.sim.accepted(n, blk)
+}
+
+func nodeID(index int) ids.NodeID {
+	var nodeID ids.NodeID
+	binary.BigEndian.PutUint64(nodeID[:], uint64(index))
+	return nodeID
+}
@@ -0,0 +1,152 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package simulation
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+)
+
+// Report is the outcome of a simulation.
+type Report struct {
+	Name string `json:"name"`
+	Seed uint64 `json:"seed"`
+	// Duration is the amount of simulated time that elapsed.
+	Duration time.Duration `json:"duration"`
+	// ByzantineWeight is the fraction of the total weight held by byzantine
+	// validators.
+	ByzantineWeight float64 `json:"byzantineWeight"`
+
+	BlocksProduced int `json:"blocksProduced"`
+	// BlocksAccepted is the number of blocks accepted by every honest
+	// validator.
+	BlocksAccepted int `json:"blocksAccepted"`
+	// StuckValidators is the number of honest validators that still had
+	// processing blocks when the simulation ended.
+	StuckValidators int `json:"stuckValidators"`
+
+	QueriesSent  int `json:"queriesSent"`
+	MessagesSent int `json:"messagesSent"`
+
+	// ValidatorFinality is the distribution of the time between a block being
+	// produced and it being accepted by an honest validator, over every honest
+	// validator and accepted block.
+	ValidatorFinality Distribution `json:"validatorFinality"`
+	// NetworkFinality is the distribution of the time between a block being
+	// produced and it being accepted by every honest validator.
+	NetworkFinality Distribution `json:"networkFinality"`
+
+	SafetyViolations []SafetyViolation `json:"safetyViolations"`
+}
+
+// SafetyViolation is reported when two honest validators accept different
+// blocks at the same height.
+type SafetyViolation struct {
+	Time       time.Duration `json:"time"`
+	Height     uint64        `json:"height"`
+	AcceptedID ids.ID        `json:"acceptedID"`
+	// ConflictingID was accepted at [Height] by [Validator] after [AcceptedID]
+	// was accepted by another honest validator.
+	ConflictingID ids.ID `json:"conflictingID"`
+	Validator     int    `json:"validator"`
+}
+
+// Distribution summarizes a set of durations.
+type Distribution struct {
+	Count int           `json:"count"`
+	Mean  time.Duration `json:"mean"`
+	P50   time.Duration `json:"p50"`
+	P90   time.Duration `json:"p90"`
+	P99   time.Duration `json:"p99"`
+	Max   time.Duration `json:"max"`
+}
+
+func newDistribution(samples []time.Duration) Distribution {
+	if len(samples) == 0 {
+		return Distribution{}
+	}
+
+	sorted := slices.Clone(samples)
+	slices.Sort(sorted)
+
+	var sum time.Duration
+	for _, sample := range sorted {
+		sum += sample
+	}
+	return Distribution{
+		Count: len(sorted),
+		Mean:  sum / time.Duration(len(sorted)),
+		P50:   percentile(sorted, 50),
+		P90:   percentile(sorted, 90),
+		P99:   percentile(sorted, 99),
+		Max:   sorted[len(sorted)-1],
+	}
+}
+
+// percentile returns the nearest-rank percentile of [sorted].
+func percentile(sorted []time.Duration, p int) time.Duration {
+	rank := (p*len(sorted) + 99) / 100
+	return sorted[max(rank, 1)-1]
+}
+
+func (d Distribution) String() string {
+	return fmt.Sprintf(
+		"count=%d mean=%s p50=%s p90=%s p99=%s max=%s",
+		d.Count,
+		d.Mean,
+		d.P50,
+		d.P90,
+		d.P99,
+		d.Max,
+	)
+}
+
+// Print writes a human readable summary of the report to [w].
+func (r *Report) Print(w io.Writer) error {
+	_, err := fmt.Fprintf(w, `scenario:            %s (seed %d)
+simulated duration:  %s
+byzantine weight:    %.2f%%
+blocks produced:     %d
+blocks accepted:     %d
+stuck validators:    %d
+queries sent:        %d
+messages sent:       %d
+validator finality:  %s
+network finality:    %s
+safety violations:   %d
+`,
+		r.Name,
+		r.Seed,
+		r.Duration,
+		100*r.ByzantineWeight,
+		r.BlocksProduced,
+		r.BlocksAccepted,
+		r.StuckValidators,
+		r.QueriesSent,
+		r.MessagesSent,
+		r.ValidatorFinality,
+		r.NetworkFinality,
+		len(r.SafetyViolations),
+	)
+	if err != nil {
+		return err
+	}
+	for _, violation := range r.SafetyViolations {
+		_, err := fmt.Fprintf(w, "  at %s validator %d accepted %s at height %d, conflicting with %s\n",
+			violation.Time,
+			violation.Validator,
+			violation.ConflictingID,
+			violation.Height,
+			violation.AcceptedID,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
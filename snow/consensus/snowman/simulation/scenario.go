@@ -0,0 +1,256 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package simulation
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/ava-labs/avalanchego/snow/consensus/snowball"
+)
+
+const (
+	UniformWeights = "uniform"
+	ZipfWeights    = "zipf"
+	CustomWeights  = "custom"
+
+	// Silent byzantine validators never respond to queries.
+	Silent = "silent"
+	// Conflicting byzantine validators respond to queries with a block that
+	// conflicts with the querier's preference, if one exists.
+	Conflicting = "conflicting"
+	// Random byzantine validators respond to queries with a random block.
+	Random = "random"
+
+	// defaultWeight is the weight of every validator when using uniform
+	// weights, and the weight of the heaviest validator when using zipf
+	// weights.
+	defaultWeight = 1_000_000
+)
+
+var (
+	errNoValidators           = errors.New("at least one validator is required")
+	errUnknownWeights         = errors.New("unknown weight distribution")
+	errWrongNumWeights        = errors.New("wrong number of custom weights")
+	errZeroWeight             = errors.New("validator weights must be positive")
+	errInvalidZipfExponent    = errors.New("zipf exponent must be positive")
+	errTooManyByzantine       = errors.New("at least one validator must be honest")
+	errUnknownBehavior        = errors.New("unknown byzantine behavior")
+	errInvalidLatency         = errors.New("latency must satisfy 0 <= minLatency <= maxLatency")
+	errInvalidDropProbability = errors.New("drop probability must be in [0, 1)")
+	errInvalidQueryTimeout    = errors.New("query timeout must be positive")
+	errNoBlocks               = errors.New("at least one block must be produced")
+	errInvalidBlockInterval   = errors.New("block interval must be positive")
+	errInvalidConflict        = errors.New("conflict probability must be in [0, 1]")
+	errInvalidPartition       = errors.New("partition must satisfy start < end")
+	errUnknownPartitionNode   = errors.New("partition contains an unknown validator")
+	errInvalidMaxDuration     = errors.New("max duration must be positive")
+)
+
+// Scenario describes a simulated network and the workload it runs.
+type Scenario struct {
+	// Name of the scenario, only used for reporting.
+	Name string `json:"name" yaml:"name"`
+	// Seed of all the randomness in the simulation. Running the same scenario
+	// with the same seed produces the same report.
+	Seed uint64 `json:"seed" yaml:"seed"`
+	// Parameters used by every honest validator.
+	Parameters snowball.Parameters `json:"parameters" yaml:"parameters"`
+	Validators Validators          `json:"validators" yaml:"validators"`
+	Byzantine  Byzantine           `json:"byzantine"  yaml:"byzantine"`
+	Network    Network             `json:"network"    yaml:"network"`
+	Blocks     Blocks              `json:"blocks"     yaml:"blocks"`
+	// Partitions that are applied to the network during the simulation.
+	Partitions []Partition `json:"partitions" yaml:"partitions"`
+	// MaxDuration is the amount of simulated time after which the simulation
+	// is stopped, even if blocks are still processing.
+	MaxDuration time.Duration `json:"maxDuration" yaml:"maxDuration"`
+}
+
+// Validators describes the validator set.
+type Validators struct {
+	Count int `json:"count" yaml:"count"`
+	// Weights is the distribution of the validator weights. One of
+	// [uniform, zipf, custom].
+	Weights string `json:"weights" yaml:"weights"`
+	// ZipfExponent is the exponent used for zipf weights. The i-th validator
+	// is given a weight proportional to 1/(i+1)^ZipfExponent.
+	ZipfExponent float64 `json:"zipfExponent" yaml:"zipfExponent"`
+	// CustomWeights are the weights of the validators when using custom
+	// weights.
+	CustomWeights []uint64 `json:"customWeights" yaml:"customWeights"`
+}
+
+// Byzantine describes the validators that don't follow the protocol. The
+// byzantine validators are chosen randomly from the validator set.
+type Byzantine struct {
+	Count int `json:"count" yaml:"count"`
+	// Behavior of the byzantine validators. One of
+	// [silent, conflicting, random].
+	Behavior string `json:"behavior" yaml:"behavior"`
+}
+
+// Network describes the links between validators.
+type Network struct {
+	// The latency of every message is sampled uniformly from
+	// [MinLatency, MaxLatency].
+	MinLatency time.Duration `json:"minLatency" yaml:"minLatency"`
+	MaxLatency time.Duration `json:"maxLatency" yaml:"maxLatency"`
+	// DropProbability is the probability that a query or a response is lost.
+	// Blocks are always eventually delivered.
+	DropProbability float64 `json:"dropProbability" yaml:"dropProbability"`
+	// QueryTimeout is the duration after which a validator that hasn't
+	// responded to a query is considered to have dropped its vote.
+	QueryTimeout time.Duration `json:"queryTimeout" yaml:"queryTimeout"`
+}
+
+// Blocks describes how blocks are produced.
+type Blocks struct {
+	// Count is the number of times a block is produced.
+	Count int `json:"count" yaml:"count"`
+	// Interval between block productions.
+	Interval time.Duration `json:"interval" yaml:"interval"`
+	// ConflictProbability is the probability that a second validator
+	// concurrently produces a conflicting block.
+	ConflictProbability float64 `json:"conflictProbability" yaml:"conflictProbability"`
+}
+
+// Partition separates [Nodes] from all other validators between [Start] and
+// [End]. Messages sent across the partition are lost, and blocks are delivered
+// once the partition heals.
+type Partition struct {
+	Start time.Duration `json:"start" yaml:"start"`
+	End   time.Duration `json:"end"   yaml:"end"`
+	// Nodes are the indices of the validators on one side of the partition.
+	Nodes []int `json:"nodes" yaml:"nodes"`
+}
+
+// ParseScenario parses a scenario from yaml, or json, and verifies it.
+// Unspecified fields are set to their defaults.
+func ParseScenario(scenarioBytes []byte) (*Scenario, error) {
+	scenario := &Scenario{
+		Seed:       1,
+		Parameters: snowball.DefaultParameters,
+		Validators: Validators{
+			Weights:      UniformWeights,
+			ZipfExponent: 1,
+		},
+		Byzantine: Byzantine{
+			Behavior: Silent,
+		},
+		Network: Network{
+			MinLatency:   10 * time.Millisecond,
+			MaxLatency:   100 * time.Millisecond,
+			QueryTimeout: 2 * time.Second,
+		},
+		Blocks: Blocks{
+			Interval: 2 * time.Second,
+		},
+		MaxDuration: time.Hour,
+	}
+	if err := yaml.Unmarshal(scenarioBytes, scenario); err != nil {
+		return nil, fmt.Errorf("couldn't parse scenario: %w", err)
+	}
+	if err := scenario.Verify(); err != nil {
+		return nil, err
+	}
+	return scenario, nil
+}
+
+// ParseScenarioFile parses the scenario stored at [path].
+func ParseScenarioFile(path string) (*Scenario, error) {
+	scenarioBytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParseScenario(scenarioBytes)
+}
+
+// Verify returns nil if the scenario can be simulated.
+func (s *Scenario) Verify() error {
+	if err := s.Parameters.Verify(); err != nil {
+		return err
+	}
+	if _, err := s.Validators.weights(); err != nil {
+		return err
+	}
+
+	switch s.Byzantine.Behavior {
+	case Silent, Conflicting, Random:
+	default:
+		return fmt.Errorf("%w: %q", errUnknownBehavior, s.Byzantine.Behavior)
+	}
+
+	switch {
+	case s.Byzantine.Count < 0 || s.Byzantine.Count >= s.Validators.Count:
+		return fmt.Errorf("%w: %d byzantine out of %d validators", errTooManyByzantine, s.Byzantine.Count, s.Validators.Count)
+	case s.Network.MinLatency < 0 || s.Network.MaxLatency < s.Network.MinLatency:
+		return fmt.Errorf("%w: minLatency = %s, maxLatency = %s", errInvalidLatency, s.Network.MinLatency, s.Network.MaxLatency)
+	case s.Network.DropProbability < 0 || s.Network.DropProbability >= 1:
+		return fmt.Errorf("%w: %f", errInvalidDropProbability, s.Network.DropProbability)
+	case s.Network.QueryTimeout <= 0:
+		return fmt.Errorf("%w: %s", errInvalidQueryTimeout, s.Network.QueryTimeout)
+	case s.Blocks.Count <= 0:
+		return fmt.Errorf("%w: %d", errNoBlocks, s.Blocks.Count)
+	case s.Blocks.Interval <= 0:
+		return fmt.Errorf("%w: %s", errInvalidBlockInterval, s.Blocks.Interval)
+	case s.Blocks.ConflictProbability < 0 || s.Blocks.ConflictProbability > 1:
+		return fmt.Errorf("%w: %f", errInvalidConflict, s.Blocks.ConflictProbability)
+	case s.MaxDuration <= 0:
+		return fmt.Errorf("%w: %s", errInvalidMaxDuration, s.MaxDuration)
+	}
+
+	for i, partition := range s.Partitions {
+		if partition.End <= partition.Start {
+			return fmt.Errorf("%w: partition %d has start = %s, end = %s", errInvalidPartition, i, partition.Start, partition.End)
+		}
+		for _, node := range partition.Nodes {
+			if node < 0 || node >= s.Validators.Count {
+				return fmt.Errorf("%w: partition %d contains %d", errUnknownPartitionNode, i, node)
+			}
+		}
+	}
+	return nil
+}
+
+// weights returns the weight of every validator.
+func (v *Validators) weights() ([]uint64, error) {
+	if v.Count <= 0 {
+		return nil, fmt.Errorf("%w: %d", errNoValidators, v.Count)
+	}
+
+	weights := make([]uint64, v.Count)
+	switch v.Weights {
+	case UniformWeights:
+		for i := range weights {
+			weights[i] = defaultWeight
+		}
+	case ZipfWeights:
+		if v.ZipfExponent <= 0 {
+			return nil, fmt.Errorf("%w: %f", errInvalidZipfExponent, v.ZipfExponent)
+		}
+		for i := range weights {
+			weight := defaultWeight / math.Pow(float64(i+1), v.ZipfExponent)
+			weights[i] = max(1, uint64(weight))
+		}
+	case CustomWeights:
+		if len(v.CustomWeights) != v.Count {
+			return nil, fmt.Errorf("%w: expected %d but got %d", errWrongNumWeights, v.Count, len(v.CustomWeights))
+		}
+		for i, weight := range v.CustomWeights {
+			if weight == 0 {
+				return nil, fmt.Errorf("%w: validator %d has weight 0", errZeroWeight, i)
+			}
+		}
+		copy(weights, v.CustomWeights)
+	default:
+		return nil, fmt.Errorf("%w: %q", errUnknownWeights, v.Weights)
+	}
+	return weights, nil
+}
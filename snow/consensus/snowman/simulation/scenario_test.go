@@ -0,0 +1,219 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package simulation
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/snow/consensus/snowball"
+)
+
+func TestParseScenarioDefaults(t *testing.T) {
+	require := require.New(t)
+
+	scenario, err := ParseScenario([]byte(`
+name: defaults
+validators:
+  count: 25
+blocks:
+  count: 10
+parameters:
+  beta: 15
+`))
+	require.NoError(err)
+
+	expectedParams := snowball.DefaultParameters
+	expectedParams.Beta = 15
+	require.Equal(&Scenario{
+		Name:       "defaults",
+		Seed:       1,
+		Parameters: expectedParams,
+		Validators: Validators{
+			Count:        25,
+			Weights:      UniformWeights,
+			ZipfExponent: 1,
+		},
+		Byzantine: Byzantine{
+			Behavior: Silent,
+		},
+		Network: Network{
+			MinLatency:   10 * time.Millisecond,
+			MaxLatency:   100 * time.Millisecond,
+			QueryTimeout: 2 * time.Second,
+		},
+		Blocks: Blocks{
+			Count:    10,
+			Interval: 2 * time.Second,
+		},
+		MaxDuration: time.Hour,
+	}, scenario)
+}
+
+func TestParseScenarioFiles(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("scenarios", "*.yaml"))
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+
+	for _, file := range files {
+		t.Run(filepath.Base(file), func(t *testing.T) {
+			_, err := ParseScenarioFile(file)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestScenarioVerify(t *testing.T) {
+	tests := []struct {
+		name        string
+		modify      func(*Scenario)
+		expectedErr error
+	}{
+		{
+			name:        "valid",
+			modify:      func(*Scenario) {},
+			expectedErr: nil,
+		},
+		{
+			name: "invalid parameters",
+			modify: func(s *Scenario) {
+				s.Parameters.K = 0
+			},
+			expectedErr: snowball.ErrParametersInvalid,
+		},
+		{
+			name: "no validators",
+			modify: func(s *Scenario) {
+				s.Validators.Count = 0
+			},
+			expectedErr: errNoValidators,
+		},
+		{
+			name: "unknown weights",
+			modify: func(s *Scenario) {
+				s.Validators.Weights = "pareto"
+			},
+			expectedErr: errUnknownWeights,
+		},
+		{
+			name: "invalid zipf exponent",
+			modify: func(s *Scenario) {
+				s.Validators.Weights = ZipfWeights
+				s.Validators.ZipfExponent = 0
+			},
+			expectedErr: errInvalidZipfExponent,
+		},
+		{
+			name: "wrong number of custom weights",
+			modify: func(s *Scenario) {
+				s.Validators.Weights = CustomWeights
+				s.Validators.CustomWeights = []uint64{1, 2, 3}
+			},
+			expectedErr: errWrongNumWeights,
+		},
+		{
+			name: "zero custom weight",
+			modify: func(s *Scenario) {
+				s.Validators.Weights = CustomWeights
+				s.Validators.CustomWeights = make([]uint64, s.Validators.Count)
+			},
+			expectedErr: errZeroWeight,
+		},
+		{
+			name: "no honest validators",
+			modify: func(s *Scenario) {
+				s.Byzantine.Count = s.Validators.Count
+			},
+			expectedErr: errTooManyByzantine,
+		},
+		{
+			name: "unknown behavior",
+			modify: func(s *Scenario) {
+				s.Byzantine.Behavior = "equivocating"
+			},
+			expectedErr: errUnknownBehavior,
+		},
+		{
+			name: "invalid latency",
+			modify: func(s *Scenario) {
+				s.Network.MaxLatency = s.Network.MinLatency - 1
+			},
+			expectedErr: errInvalidLatency,
+		},
+		{
+			name: "invalid drop probability",
+			modify: func(s *Scenario) {
+				s.Network.DropProbability = 1
+			},
+			expectedErr: errInvalidDropProbability,
+		},
+		{
+			name: "invalid query timeout",
+			modify: func(s *Scenario) {
+				s.Network.QueryTimeout = 0
+			},
+			expectedErr: errInvalidQueryTimeout,
+		},
+		{
+			name: "no blocks",
+			modify: func(s *Scenario) {
+				s.Blocks.Count = 0
+			},
+			expectedErr: errNoBlocks,
+		},
+		{
+			name: "invalid block interval",
+			modify: func(s *Scenario) {
+				s.Blocks.Interval = 0
+			},
+			expectedErr: errInvalidBlockInterval,
+		},
+		{
+			name: "invalid conflict probability",
+			modify: func(s *Scenario) {
+				s.Blocks.ConflictProbability = 1.5
+			},
+			expectedErr: errInvalidConflict,
+		},
+		{
+			name: "invalid partition",
+			modify: func(s *Scenario) {
+				s.Partitions = []Partition{{
+					Start: time.Second,
+					End:   time.Second,
+				}}
+			},
+			expectedErr: errInvalidPartition,
+		},
+		{
+			name: "unknown partition node",
+			modify: func(s *Scenario) {
+				s.Partitions = []Partition{{
+					Start: time.Second,
+					End:   2 * time.Second,
+					Nodes: []int{s.Validators.Count},
+				}}
+			},
+			expectedErr: errUnknownPartitionNode,
+		},
+		{
+			name: "invalid max duration",
+			modify: func(s *Scenario) {
+				s.MaxDuration = 0
+			},
+			expectedErr: errInvalidMaxDuration,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			scenario := newTestScenario()
+			test.modify(scenario)
+			err := scenario.Verify()
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
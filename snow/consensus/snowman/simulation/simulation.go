@@ -0,0 +1,325 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package simulation simulates networks of snowman consensus instances to
+// evaluate consensus parameters under configurable network conditions and
+// adversaries.
+package simulation
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"gonum.org/v1/gonum/mathext/prng"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/heap"
+	"github.com/ava-labs/avalanchego/utils/sampler"
+
+	safemath "github.com/ava-labs/avalanchego/utils/math"
+)
+
+var errInsufficientWeight = errors.New("total weight is less than k")
+
+// Simulation is a discrete event simulation of a network of validators
+// running snowman consensus. Time only advances between events, so the
+// simulation runs as fast as the consensus instances can process messages.
+type Simulation struct {
+	scenario *Scenario
+
+	source  sampler.Source
+	uniform sampler.Uniform
+	// sampler samples validators by weight for queries
+	sampler sampler.WeightedWithoutReplacement
+
+	now    time.Duration
+	events heap.Queue[*event]
+	// numEvents is used to order events that occur at the same time
+	numEvents uint64
+
+	nodes  []*node
+	honest []*node
+	// proposer samples honest validators by weight to produce blocks
+	proposer sampler.WeightedWithoutReplacement
+
+	genesis  *blockTemplate
+	blocks   map[ids.ID]*blockTemplate
+	blockIDs []ids.ID
+	// parentID -> children
+	children map[ids.ID][]ids.ID
+
+	// height -> blockID accepted by the first honest validator to accept a
+	// block at the height
+	acceptedAtHeight map[uint64]ids.ID
+	// blockID -> number of honest validators that accepted the block
+	numAccepted map[ids.ID]int
+
+	queriesSent       int
+	messagesSent      int
+	validatorFinality []time.Duration
+	networkFinality   []time.Duration
+	safetyViolations  []SafetyViolation
+}
+
+// New returns a simulation of [scenario]. The scenario is assumed to be
+// valid.
+func New(scenario *Scenario) (*Simulation, error) {
+	weights, err := scenario.Validators.weights()
+	if err != nil {
+		return nil, err
+	}
+
+	var totalWeight uint64
+	for _, weight := range weights {
+		totalWeight, err = safemath.Add64(totalWeight, weight)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if totalWeight < uint64(scenario.Parameters.K) {
+		return nil, fmt.Errorf("%w: %d < %d", errInsufficientWeight, totalWeight, scenario.Parameters.K)
+	}
+
+	source := prng.NewMT19937()
+	source.Seed(scenario.Seed)
+	genesis := &blockTemplate{}
+	s := &Simulation{
+		scenario:         scenario,
+		events:           heap.NewQueue(eventLess),
+		source:           source,
+		uniform:          sampler.NewDeterministicUniform(source),
+		sampler:          sampler.NewDeterministicWeightedWithoutReplacement(source),
+		proposer:         sampler.NewDeterministicWeightedWithoutReplacement(source),
+		genesis:          genesis,
+		blocks:           map[ids.ID]*blockTemplate{genesis.id: genesis},
+		children:         make(map[ids.ID][]ids.ID),
+		acceptedAtHeight: make(map[uint64]ids.ID),
+		numAccepted:      make(map[ids.ID]int),
+	}
+	if err := s.sampler.Initialize(weights); err != nil {
+		return nil, err
+	}
+
+	s.uniform.Initialize(uint64(len(weights)))
+	byzantineIndices, _ := s.uniform.Sample(scenario.Byzantine.Count)
+	byzantine := make([]bool, len(weights))
+	for _, index := range byzantineIndices {
+		byzantine[index] = true
+	}
+
+	var honestWeights []uint64
+	for i, weight := range weights {
+		node, err := newNode(s, i, weight, byzantine[i])
+		if err != nil {
+			return nil, err
+		}
+		s.nodes = append(s.nodes, node)
+		if !node.byzantine {
+			s.honest = append(s.honest, node)
+			honestWeights = append(honestWeights, weight)
+		}
+	}
+	if err := s.proposer.Initialize(honestWeights); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Run the simulation until every block has been produced and decided by
+// every honest validator, or until the scenario's max duration elapses.
+func (s *Simulation) Run() (*Report, error) {
+	for i := 0; i < s.scenario.Blocks.Count; i++ {
+		index := uint64(i)
+		s.schedule(time.Duration(i)*s.scenario.Blocks.Interval, func() error {
+			return s.produceBlocks(index)
+		})
+	}
+
+	for {
+		e, ok := s.events.Pop()
+		if !ok || e.time > s.scenario.MaxDuration {
+			break
+		}
+		s.now = e.time
+		if err := e.run(); err != nil {
+			return nil, err
+		}
+	}
+	return s.report(), nil
+}
+
+// produceBlocks produces a block on top of the preference of a random honest
+// validator. With probability ConflictProbability, a conflicting block is
+// produced by another honest validator.
+func (s *Simulation) produceBlocks(index uint64) error {
+	proposers := 1
+	if s.float64() < s.scenario.Blocks.ConflictProbability {
+		proposers++
+	}
+	proposerIndices, ok := s.proposer.Sample(proposers)
+	if !ok {
+		return nil
+	}
+
+	for i, proposerIndex := range proposerIndices {
+		proposer := s.honest[proposerIndex]
+		parent := s.blocks[proposer.consensus.Preference()]
+		blk := newBlockTemplate(2*index+uint64(i), parent, s.now)
+		s.blocks[blk.id] = blk
+		s.blockIDs = append(s.blockIDs, blk.id)
+		s.children[parent.id] = append(s.children[parent.id], blk.id)
+
+		if err := proposer.deliver(blk); err != nil {
+			return err
+		}
+		for _, node := range s.honest {
+			if node == proposer {
+				continue
+			}
+			s.gossip(proposer, node, blk)
+		}
+	}
+	return nil
+}
+
+// gossip [blk] from [from] to [to]. If the validators are partitioned, the
+// block is delivered once the partition heals.
+func (s *Simulation) gossip(from, to *node, blk *blockTemplate) {
+	sendTime := s.now
+	for {
+		healTime, partitioned := s.partitionedUntil(from.index, to.index, sendTime)
+		if !partitioned {
+			break
+		}
+		sendTime = healTime
+	}
+
+	s.messagesSent++
+	delay := sendTime - s.now + s.latency()
+	s.schedule(delay, func() error {
+		return to.deliver(blk)
+	})
+}
+
+// send a message from [from] to [to]. The message is lost if the validators
+// are partitioned or if it is randomly dropped.
+func (s *Simulation) send(from, to *node, onReceive func() error) {
+	s.messagesSent++
+	if from == to {
+		s.schedule(0, onReceive)
+		return
+	}
+	if _, partitioned := s.partitionedUntil(from.index, to.index, s.now); partitioned {
+		return
+	}
+	if s.float64() < s.scenario.Network.DropProbability {
+		return
+	}
+	s.schedule(s.latency(), onReceive)
+}
+
+// partitionedUntil returns true if there is a partition separating [a] and [b]
+// at [t], along with the time the partition heals.
+func (s *Simulation) partitionedUntil(a, b int, t time.Duration) (time.Duration, bool) {
+	for _, partition := range s.scenario.Partitions {
+		if t < partition.Start || t >= partition.End {
+			continue
+		}
+		var containsA, containsB bool
+		for _, node := range partition.Nodes {
+			containsA = containsA || node == a
+			containsB = containsB || node == b
+		}
+		if containsA != containsB {
+			return partition.End, true
+		}
+	}
+	return 0, false
+}
+
+func (s *Simulation) accepted(n *node, blk *blockTemplate) {
+	s.validatorFinality = append(s.validatorFinality, s.now-blk.producedAt)
+
+	s.numAccepted[blk.id]++
+	if s.numAccepted[blk.id] == len(s.honest) {
+		s.networkFinality = append(s.networkFinality, s.now-blk.producedAt)
+	}
+
+	acceptedID, ok := s.acceptedAtHeight[blk.height]
+	switch {
+	case !ok:
+		s.acceptedAtHeight[blk.height] = blk.id
+	case acceptedID != blk.id:
+		s.safetyViolations = append(s.safetyViolations, SafetyViolation{
+			Time:          s.now,
+			Height:        blk.height,
+			AcceptedID:    acceptedID,
+			ConflictingID: blk.id,
+			Validator:     n.index,
+		})
+	}
+}
+
+func (s *Simulation) report() *Report {
+	var (
+		totalWeight     uint64
+		byzantineWeight uint64
+		stuck           int
+	)
+	for _, node := range s.nodes {
+		totalWeight += node.weight
+		if node.byzantine {
+			byzantineWeight += node.weight
+		} else if node.consensus.NumProcessing() > 0 {
+			stuck++
+		}
+	}
+
+	return &Report{
+		Name:              s.scenario.Name,
+		Seed:              s.scenario.Seed,
+		Duration:          s.now,
+		ByzantineWeight:   float64(byzantineWeight) / float64(totalWeight),
+		BlocksProduced:    len(s.blockIDs),
+		BlocksAccepted:    len(s.networkFinality),
+		StuckValidators:   stuck,
+		QueriesSent:       s.queriesSent,
+		MessagesSent:      s.messagesSent,
+		ValidatorFinality: newDistribution(s.validatorFinality),
+		NetworkFinality:   newDistribution(s.networkFinality),
+		SafetyViolations:  s.safetyViolations,
+	}
+}
+
+// randomBlock returns a uniformly random block that has been produced.
+func (s *Simulation) randomBlock() ids.ID {
+	if len(s.blockIDs) == 0 {
+		return s.genesis.id
+	}
+	s.uniform.Initialize(uint64(len(s.blockIDs)))
+	index, _ := s.uniform.Next()
+	return s.blockIDs[index]
+}
+
+// latency returns a uniformly random latency in [MinLatency, MaxLatency].
+func (s *Simulation) latency() time.Duration {
+	network := s.scenario.Network
+	spread := network.MaxLatency - network.MinLatency
+	return network.MinLatency + time.Duration(s.float64()*float64(spread))
+}
+
+// float64 returns a uniformly random number in [0, 1).
+func (s *Simulation) float64() float64 {
+	return float64(s.source.Uint64()>>11) / (1 << 53)
+}
+
+// schedule [run] to be executed after [delay].
+func (s *Simulation) schedule(delay time.Duration, run func() error) {
+	s.numEvents++
+	s.events.Push(&event{
+		time:  s.now + delay,
+		index: s.numEvents,
+		run:   run,
+	})
+}
@@ -0,0 +1,212 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package simulation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/snow/consensus/snowball"
+)
+
+func newTestScenario() *Scenario {
+	return &Scenario{
+		Name: "test",
+		Seed: 1,
+		Parameters: snowball.Parameters{
+			K:                     5,
+			AlphaPreference:       3,
+			AlphaConfidence:       4,
+			Beta:                  10,
+			ConcurrentRepolls:     2,
+			OptimalProcessing:     10,
+			MaxOutstandingItems:   256,
+			MaxItemProcessingTime: 30 * time.Second,
+		},
+		Validators: Validators{
+			Count:   10,
+			Weights: UniformWeights,
+		},
+		Byzantine: Byzantine{
+			Behavior: Silent,
+		},
+		Network: Network{
+			MinLatency:   10 * time.Millisecond,
+			MaxLatency:   100 * time.Millisecond,
+			QueryTimeout: time.Second,
+		},
+		Blocks: Blocks{
+			Count:    20,
+			Interval: time.Second,
+		},
+		MaxDuration: time.Hour,
+	}
+}
+
+func runScenario(t *testing.T, scenario *Scenario) *Report {
+	require := require.New(t)
+
+	require.NoError(scenario.Verify())
+	sim, err := New(scenario)
+	require.NoError(err)
+	report, err := sim.Run()
+	require.NoError(err)
+	return report
+}
+
+func TestSimulationHonest(t *testing.T) {
+	require := require.New(t)
+
+	scenario := newTestScenario()
+	report := runScenario(t, scenario)
+
+	require.Equal(scenario.Blocks.Count, report.BlocksProduced)
+	require.Equal(scenario.Blocks.Count, report.BlocksAccepted)
+	require.Zero(report.StuckValidators)
+	require.Empty(report.SafetyViolations)
+	require.Zero(report.ByzantineWeight)
+	require.Equal(scenario.Blocks.Count*scenario.Validators.Count, report.ValidatorFinality.Count)
+	require.Equal(scenario.Blocks.Count, report.NetworkFinality.Count)
+	require.LessOrEqual(report.ValidatorFinality.P50, report.ValidatorFinality.P99)
+	require.LessOrEqual(report.NetworkFinality.P99, report.NetworkFinality.Max)
+	require.Positive(report.QueriesSent)
+}
+
+func TestSimulationDeterministic(t *testing.T) {
+	require := require.New(t)
+
+	scenario := newTestScenario()
+	scenario.Validators.Weights = ZipfWeights
+	scenario.Validators.ZipfExponent = 1
+	scenario.Byzantine = Byzantine{
+		Count:    2,
+		Behavior: Random,
+	}
+	scenario.Network.DropProbability = .1
+	scenario.Blocks.ConflictProbability = .5
+
+	report := runScenario(t, scenario)
+	require.Equal(report, runScenario(t, scenario))
+
+	scenario.Seed++
+	require.NotEqual(report, runScenario(t, scenario))
+}
+
+func TestSimulationConflicts(t *testing.T) {
+	require := require.New(t)
+
+	scenario := newTestScenario()
+	scenario.Blocks.ConflictProbability = 1
+	report := runScenario(t, scenario)
+
+	// Conflicting blocks may be produced at different heights, so both may be
+	// accepted.
+	require.Equal(2*scenario.Blocks.Count, report.BlocksProduced)
+	require.GreaterOrEqual(report.BlocksAccepted, scenario.Blocks.Count)
+	require.Less(report.BlocksAccepted, report.BlocksProduced)
+	require.Zero(report.StuckValidators)
+	require.Empty(report.SafetyViolations)
+}
+
+func TestSimulationByzantine(t *testing.T) {
+	tests := []string{
+		Silent,
+		Conflicting,
+		Random,
+	}
+	for _, behavior := range tests {
+		t.Run(behavior, func(t *testing.T) {
+			require := require.New(t)
+
+			scenario := newTestScenario()
+			scenario.Byzantine = Byzantine{
+				Count:    1,
+				Behavior: behavior,
+			}
+			scenario.Blocks.ConflictProbability = .5
+			report := runScenario(t, scenario)
+
+			require.InDelta(.1, report.ByzantineWeight, 1e-9)
+			require.Zero(report.StuckValidators)
+			require.Empty(report.SafetyViolations)
+			require.Equal(scenario.Validators.Count-1, report.ValidatorFinality.Count/report.BlocksAccepted)
+		})
+	}
+}
+
+func TestSimulationPartition(t *testing.T) {
+	require := require.New(t)
+
+	scenario := newTestScenario()
+	scenario.Partitions = []Partition{
+		{
+			Start: 5 * time.Second,
+			End:   15 * time.Second,
+			Nodes: []int{0, 1, 2, 3, 4},
+		},
+	}
+	report := runScenario(t, scenario)
+
+	// Blocks produced on either side of the partition are built on different
+	// chains. Once the partition heals, the network converges on one of them.
+	require.Positive(report.BlocksAccepted)
+	require.Less(report.BlocksAccepted, report.BlocksProduced)
+	require.Zero(report.StuckValidators)
+	require.Empty(report.SafetyViolations)
+	require.Greater(report.NetworkFinality.Max, 5*time.Second)
+}
+
+func TestSimulationMaxDuration(t *testing.T) {
+	require := require.New(t)
+
+	scenario := newTestScenario()
+	scenario.Byzantine = Byzantine{
+		Count:    6,
+		Behavior: Silent,
+	}
+	scenario.MaxDuration = time.Minute
+	report := runScenario(t, scenario)
+
+	// A majority of the validators never respond, so no block can be
+	// finalized.
+	require.Zero(report.BlocksAccepted)
+	require.Equal(scenario.Validators.Count-scenario.Byzantine.Count, report.StuckValidators)
+	require.LessOrEqual(report.Duration, scenario.MaxDuration)
+}
+
+func TestNewInsufficientWeight(t *testing.T) {
+	scenario := newTestScenario()
+	scenario.Validators = Validators{
+		Count:         2,
+		Weights:       CustomWeights,
+		CustomWeights: []uint64{1, 1},
+	}
+
+	_, err := New(scenario)
+	require.ErrorIs(t, err, errInsufficientWeight)
+}
+
+func TestSimulationSkewedStake(t *testing.T) {
+	require := require.New(t)
+
+	scenario := newTestScenario()
+	scenario.Validators = Validators{
+		Count:        20,
+		Weights:      ZipfWeights,
+		ZipfExponent: 1.2,
+	}
+	scenario.Byzantine = Byzantine{
+		Count:    2,
+		Behavior: Conflicting,
+	}
+	scenario.Network.MaxLatency = 250 * time.Millisecond
+	scenario.Blocks.ConflictProbability = .5
+	report := runScenario(t, scenario)
+
+	require.Positive(report.BlocksAccepted)
+	require.Zero(report.StuckValidators)
+	require.Empty(report.SafetyViolations)
+}
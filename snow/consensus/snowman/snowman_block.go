@@ -47,6 +47,15 @@ func (n *snowmanBlock) AddChild(child Block) {
 	n.children[childID] = child
 }
 
+// SetParameters replaces the parameters used by the snowball instance. The
+// preference and confidence of the snowball instance are kept.
+func (n *snowmanBlock) SetParameters(params snowball.Parameters) {
+	n.params = params
+	if n.sb != nil {
+		n.sb.SetParameters(params)
+	}
+}
+
 func (n *snowmanBlock) Accepted() bool {
 	// if the block is nil, then this is the genesis which is defined as
 	// accepted
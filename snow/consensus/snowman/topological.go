@@ -134,6 +134,18 @@ func (ts *Topological) Initialize(
 	return nil
 }
 
+func (ts *Topological) SetParameters(params snowball.Parameters) error {
+	if err := params.Verify(); err != nil {
+		return err
+	}
+
+	ts.params = params
+	for _, n := range ts.blocks {
+		n.SetParameters(params)
+	}
+	return nil
+}
+
 func (ts *Topological) NumProcessing() int {
 	return len(ts.blocks) - 1
 }
@@ -0,0 +1,32 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package block
+
+import (
+	"context"
+	"errors"
+)
+
+var ErrPipelinedVMNotImplemented = errors.New("vm does not implement PipelinedChainVM interface")
+
+// PipelinedChainVM defines the interface a ChainVM can optionally implement to
+// allow blocks to be prepared concurrently with the execution of their
+// ancestors during bootstrapping.
+type PipelinedChainVM interface {
+	// PrepareBlock performs the work needed to execute [blkBytes] that does
+	// not depend on the state of the chain, such as parsing the block and
+	// verifying its signatures. The block will later be parsed, verified and
+	// accepted in order, so the VM may cache the result of this work to be
+	// used at that time.
+	//
+	// PrepareBlock may be called concurrently with itself and with any other
+	// method of the VM, without holding the context lock. Therefore, it must
+	// not modify the state of the chain.
+	//
+	// Returned errors are not fatal, as the block will be verified when it is
+	// executed. VMs that wrap other VMs should return
+	// ErrPipelinedVMNotImplemented if the wrapped VM doesn't implement
+	// PipelinedChainVM.
+	PrepareBlock(ctx context.Context, blkBytes []byte) error
+}
@@ -643,6 +643,10 @@ func (b *Bootstrapper) tryStartExecuting(ctx context.Context) error {
 		log = b.Ctx.Log.Debug
 	}
 
+	// If the VM supports it, blocks are prepared concurrently ahead of their
+	// execution.
+	preparer, _ := b.VM.(block.PipelinedChainVM)
+
 	numToExecute := b.tree.Len()
 	err = execute(
 		ctx,
@@ -654,6 +658,8 @@ func (b *Bootstrapper) tryStartExecuting(ctx context.Context) error {
 			ctx:         b.Ctx,
 			numAccepted: b.numAccepted,
 		},
+		preparer,
+		b.Ctx.Log.Debug,
 		b.tree,
 		lastAccepted.Height(),
 	)
@@ -17,6 +17,7 @@ import (
 	"github.com/ava-labs/avalanchego/database/memdb"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/network/p2p"
+	"github.com/ava-labs/avalanchego/network/reputation"
 	"github.com/ava-labs/avalanchego/snow"
 	"github.com/ava-labs/avalanchego/snow/choices"
 	"github.com/ava-labs/avalanchego/snow/consensus/snowman"
@@ -85,6 +86,7 @@ func newConfig(t *testing.T) (Config, ids.NodeID, *common.SenderTest, *block.Tes
 		prometheus.NewRegistry(),
 		nil,
 		nil,
+		reputation.NewNoTracker(),
 	)
 	require.NoError(err)
 
@@ -136,6 +138,7 @@ func TestBootstrapperStartsOnlyIfEnoughStakeIsConnected(t *testing.T) {
 		prometheus.NewRegistry(),
 		nil,
 		nil,
+		reputation.NewNoTracker(),
 	)
 	require.NoError(err)
 
@@ -666,6 +669,7 @@ func TestBootstrapNoParseOnNew(t *testing.T) {
 		prometheus.NewRegistry(),
 		nil,
 		nil,
+		reputation.NewNoTracker(),
 	)
 	require.NoError(err)
 
@@ -0,0 +1,165 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package bootstrap
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/block"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/bootstrap/interval"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/logging"
+)
+
+// pipelineDepth is the maximum number of blocks that are queued to be prepared
+// ahead of the block being executed.
+const pipelineDepth = 64
+
+type preparationJob struct {
+	height   uint64
+	blkBytes []byte
+}
+
+// pipeline prepares blocks concurrently ahead of their execution. Blocks must
+// still be executed in order by the caller.
+//
+// It is assumed that the blocks to execute form a contiguous range of heights
+// starting right after the last accepted height.
+type pipeline struct {
+	db       database.Iteratee
+	preparer block.PipelinedChainVM
+	log      logging.Func
+
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	// executing is the height of the block currently being executed. Blocks
+	// at or below this height no longer need to be prepared.
+	executing utils.Atomic[uint64]
+	// unsupported is set once the VM reports that it doesn't support
+	// preparing blocks.
+	unsupported utils.Atomic[bool]
+
+	// nextHeight is the height of the next block to read from the iterator.
+	nextHeight                    uint64
+	iterator                      database.Iterator
+	processedSinceIteratorRelease uint
+	// pending is a block that was read from the iterator but couldn't be
+	// queued because the workers were busy.
+	pending *preparationJob
+
+	jobs    chan preparationJob
+	workers sync.WaitGroup
+}
+
+func newPipeline(
+	ctx context.Context,
+	db database.Iteratee,
+	preparer block.PipelinedChainVM,
+	log logging.Func,
+	lastAcceptedHeight uint64,
+	numWorkers int,
+) *pipeline {
+	ctx, cancel := context.WithCancel(ctx)
+	p := &pipeline{
+		db:         db,
+		preparer:   preparer,
+		log:        log,
+		ctx:        ctx,
+		cancel:     cancel,
+		nextHeight: lastAcceptedHeight + 1,
+		iterator:   interval.GetBlockIteratorWithStart(db, lastAcceptedHeight+1),
+		jobs:       make(chan preparationJob, pipelineDepth),
+	}
+	p.executing.Set(lastAcceptedHeight)
+
+	p.workers.Add(numWorkers)
+	for i := 0; i < numWorkers; i++ {
+		go p.work()
+	}
+	return p
+}
+
+func (p *pipeline) work() {
+	defer p.workers.Done()
+
+	for job := range p.jobs {
+		if p.unsupported.Get() || job.height <= p.executing.Get() {
+			continue
+		}
+
+		// Errors other than ErrPipelinedVMNotImplemented don't stop the
+		// pipeline, as they will be reported when the block is executed.
+		err := p.preparer.PrepareBlock(p.ctx, job.blkBytes)
+		switch {
+		case errors.Is(err, block.ErrPipelinedVMNotImplemented):
+			p.unsupported.Set(true)
+		case err != nil && p.ctx.Err() == nil:
+			p.log("failed to prepare block",
+				zap.Uint64("height", job.height),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+// execute notifies the pipeline that the block at [height] is about to be
+// executed, and queues the blocks after it to be prepared. Blocks are only
+// queued if there are fewer than pipelineDepth blocks waiting to be prepared,
+// so this never blocks on the workers.
+func (p *pipeline) execute(height uint64) error {
+	p.executing.Set(height)
+	if p.unsupported.Get() {
+		return nil
+	}
+
+	for p.nextHeight <= height+pipelineDepth {
+		if p.pending == nil {
+			if !p.iterator.Next() {
+				return p.iterator.Error()
+			}
+			p.pending = &preparationJob{
+				height:   p.nextHeight,
+				blkBytes: p.iterator.Value(),
+			}
+			p.nextHeight++
+
+			// Periodically release and re-grab the database iterator to avoid
+			// keeping a reference to an old database revision.
+			p.processedSinceIteratorRelease++
+			if p.processedSinceIteratorRelease >= iteratorReleasePeriod {
+				if err := p.iterator.Error(); err != nil {
+					return err
+				}
+				p.processedSinceIteratorRelease = 0
+				p.iterator.Release()
+				p.iterator = interval.GetBlockIteratorWithStart(p.db, p.nextHeight)
+			}
+		}
+
+		// Blocks that are already executing don't need to be prepared.
+		if p.pending.height > height {
+			select {
+			case p.jobs <- *p.pending:
+			default:
+				return nil
+			}
+		}
+		p.pending = nil
+	}
+	return nil
+}
+
+// close stops the workers and waits for them to exit.
+func (p *pipeline) close() {
+	p.cancel()
+	close(p.jobs)
+	p.workers.Wait()
+	p.iterator.Release()
+}
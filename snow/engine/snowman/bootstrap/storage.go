@@ -6,6 +6,7 @@ package bootstrap
 import (
 	"context"
 	"fmt"
+	"runtime"
 	"time"
 
 	"go.uber.org/zap"
@@ -124,6 +125,10 @@ func process(
 //
 // execute assumes that getMissingBlockIDs would return an empty set.
 //
+// If [preparer] is non-nil, blocks are prepared concurrently ahead of their
+// execution and failures to prepare them are logged with [prepareLog]. Blocks
+// are always executed in order.
+//
 // TODO: Replace usage of haltable with context cancellation.
 func execute(
 	ctx context.Context,
@@ -131,6 +136,8 @@ func execute(
 	log logging.Func,
 	db database.Database,
 	parser block.Parser,
+	preparer block.PipelinedChainVM,
+	prepareLog logging.Func,
 	tree *interval.Tree,
 	lastAcceptedHeight uint64,
 ) error {
@@ -194,6 +201,12 @@ func execute(
 		zap.Uint64("numToExecute", totalNumberToProcess),
 	)
 
+	var pipeline *pipeline
+	if preparer != nil {
+		pipeline = newPipeline(ctx, db, preparer, prepareLog, lastAcceptedHeight, runtime.NumCPU())
+		defer pipeline.close()
+	}
+
 	for !haltable.Halted() && iterator.Next() {
 		blkBytes := iterator.Value()
 		blk, err := parser.ParseBlock(ctx, blkBytes)
@@ -252,6 +265,12 @@ func execute(
 			continue
 		}
 
+		if pipeline != nil {
+			if err := pipeline.execute(height); err != nil {
+				return err
+			}
+		}
+
 		if err := blk.Verify(ctx); err != nil {
 			return fmt.Errorf("failed to verify block %s (height=%d, parentID=%s) in bootstrapping: %w",
 				blk.ID(),
@@ -273,6 +273,8 @@ func TestExecute(t *testing.T) {
 				logging.NoLog{}.Info,
 				db,
 				parser,
+				nil,
+				logging.NoLog{}.Debug,
 				tree,
 				test.lastAcceptedHeight,
 			))
@@ -294,6 +296,119 @@ func TestExecute(t *testing.T) {
 	}
 }
 
+func TestExecutePipelined(t *testing.T) {
+	const (
+		numBlocks          = 3 * pipelineDepth
+		lastAcceptedHeight = 10
+	)
+
+	require := require.New(t)
+
+	db := memdb.New()
+	tree, err := interval.NewTree(db)
+	require.NoError(err)
+
+	blocks := snowmantest.BuildChain(numBlocks)
+	prepared := make([]chan struct{}, numBlocks)
+	for i, blk := range blocks {
+		_, err := interval.Add(db, tree, 0, blk.Height(), blk.Bytes())
+		require.NoError(err)
+		prepared[i] = make(chan struct{})
+	}
+
+	preparer := &testPreparer{
+		prepareBlock: func(_ context.Context, b []byte) error {
+			for i, blk := range blocks {
+				if bytes.Equal(b, blk.Bytes()) {
+					close(prepared[i])
+					return nil
+				}
+			}
+			return database.ErrNotFound
+		},
+	}
+
+	// Every block, other than the first block to execute, should have been
+	// prepared before it is executed.
+	parser := makeParser(blocks)
+	waitingParser := testParser(func(ctx context.Context, b []byte) (snowman.Block, error) {
+		blk, err := parser.ParseBlock(ctx, b)
+		if err != nil {
+			return nil, err
+		}
+		if height := blk.Height(); height > lastAcceptedHeight+1 {
+			<-prepared[height]
+		}
+		return blk, nil
+	})
+
+	require.NoError(execute(
+		context.Background(),
+		&common.Halter{},
+		logging.NoLog{}.Info,
+		db,
+		waitingParser,
+		preparer,
+		logging.NoLog{}.Debug,
+		tree,
+		lastAcceptedHeight,
+	))
+	for _, blk := range blocks[lastAcceptedHeight+1:] {
+		require.Equal(choices.Accepted, blk.Status())
+	}
+	for _, blk := range blocks[1 : lastAcceptedHeight+1] {
+		require.Equal(choices.Processing, blk.Status())
+	}
+
+	size, err := database.Count(db)
+	require.NoError(err)
+	require.Zero(size)
+}
+
+func TestExecutePipelinedNotImplemented(t *testing.T) {
+	const numBlocks = 3 * pipelineDepth
+
+	require := require.New(t)
+
+	db := memdb.New()
+	tree, err := interval.NewTree(db)
+	require.NoError(err)
+
+	blocks := snowmantest.BuildChain(numBlocks)
+	for _, blk := range blocks {
+		_, err := interval.Add(db, tree, 0, blk.Height(), blk.Bytes())
+		require.NoError(err)
+	}
+
+	preparer := &testPreparer{
+		prepareBlock: func(context.Context, []byte) error {
+			return block.ErrPipelinedVMNotImplemented
+		},
+	}
+	require.NoError(execute(
+		context.Background(),
+		&common.Halter{},
+		logging.NoLog{}.Info,
+		db,
+		makeParser(blocks),
+		preparer,
+		logging.NoLog{}.Debug,
+		tree,
+		0,
+	))
+	for _, blk := range blocks {
+		require.Equal(choices.Accepted, blk.Status())
+	}
+}
+
+type testPreparer struct {
+	prepareBlock func(context.Context, []byte) error
+}
+
+func (p *testPreparer) PrepareBlock(ctx context.Context, b []byte) error {
+	return p.prepareBlock(ctx, b)
+}
+
 type testParser func(context.Context, []byte) (snowman.Block, error)
 
 func (f testParser) ParseBlock(ctx context.Context, bytes []byte) (snowman.Block, error) {
@@ -23,6 +23,9 @@ type Config struct {
 	Validators          validators.Manager
 	ConnectedValidators tracker.Peers
 	Params              snowball.Parameters
-	Consensus           snowman.Consensus
-	PartialSync         bool
+	// AdaptiveParams scales down Params while the subnet has fewer than K
+	// validators.
+	AdaptiveParams bool
+	Consensus      snowman.Consensus
+	PartialSync    bool
 }
@@ -16,6 +16,7 @@ import (
 	"github.com/ava-labs/avalanchego/proto/pb/p2p"
 	"github.com/ava-labs/avalanchego/snow"
 	"github.com/ava-labs/avalanchego/snow/choices"
+	"github.com/ava-labs/avalanchego/snow/consensus/snowball"
 	"github.com/ava-labs/avalanchego/snow/consensus/snowman"
 	"github.com/ava-labs/avalanchego/snow/consensus/snowman/poll"
 	"github.com/ava-labs/avalanchego/snow/engine/common"
@@ -58,6 +59,10 @@ type Transitive struct {
 
 	requestID uint32
 
+	// params are the parameters currently used by consensus and polls. If
+	// AdaptiveParams is set, these are Params scaled to the validator set.
+	params snowball.Parameters
+
 	// track outstanding preference requests
 	polls poll.Set
 
@@ -111,12 +116,8 @@ func New(config Config) (*Transitive, error) {
 	acceptedFrontiers := tracker.NewAccepted()
 	config.Validators.RegisterSetCallbackListener(config.Ctx.SubnetID, acceptedFrontiers)
 
-	factory := poll.NewEarlyTermNoTraversalFactory(
-		config.Params.AlphaPreference,
-		config.Params.AlphaConfidence,
-	)
 	polls, err := poll.NewSet(
-		factory,
+		newPollFactory(config.Params),
 		config.Ctx.Log,
 		"",
 		config.Ctx.Registerer,
@@ -144,6 +145,7 @@ func New(config Config) (*Transitive, error) {
 		nonVerifieds:                ancestor.NewTree(),
 		nonVerifiedCache:            nonVerifiedCache,
 		acceptedFrontiers:           acceptedFrontiers,
+		params:                      config.Params,
 		polls:                       polls,
 		blkReqs:                     bimap.New[common.Request, ids.ID](),
 		blkReqSourceMetric:          make(map[common.Request]prometheus.Counter),
@@ -474,9 +476,13 @@ func (t *Transitive) Start(ctx context.Context, startReqID uint32) error {
 		return err
 	}
 
+	if err := t.updateParams(); err != nil {
+		return err
+	}
+
 	// initialize consensus to the last accepted blockID
 	lastAcceptedHeight := lastAccepted.Height()
-	if err := t.Consensus.Initialize(t.Ctx, t.Params, lastAcceptedID, lastAcceptedHeight, lastAccepted.Timestamp()); err != nil {
+	if err := t.Consensus.Initialize(t.Ctx, t.params, lastAcceptedID, lastAcceptedHeight, lastAccepted.Timestamp()); err != nil {
 		return err
 	}
 
@@ -523,6 +529,36 @@ func (t *Transitive) Start(ctx context.Context, startReqID uint32) error {
 	return t.executeDeferredWork(ctx)
 }
 
+// SetParameters replaces the consensus parameters of the engine. If [adaptive]
+// is true, the parameters are scaled down while the total stake weight of the
+// subnet is less than K.
+//
+// Processing blocks keep their preferences and confidence. Outstanding polls
+// are kept and are finished using the parameters they were created with.
+func (t *Transitive) SetParameters(ctx context.Context, params snowball.Parameters, adaptive bool) error {
+	if err := params.Verify(); err != nil {
+		return err
+	}
+
+	t.Params = params
+	t.AdaptiveParams = adaptive
+	if err := t.updateParams(); err != nil {
+		return err
+	}
+
+	// If consensus hasn't started yet, the parameters will be used once it
+	// does.
+	if t.Ctx.State.Get().State != snow.NormalOp {
+		return nil
+	}
+
+	// The number of concurrent repolls may have increased.
+	if t.Consensus.NumProcessing() > 0 {
+		t.repoll(ctx)
+	}
+	return t.executeDeferredWork(ctx)
+}
+
 func (t *Transitive) HealthCheck(ctx context.Context) (interface{}, error) {
 	t.Ctx.Lock.Lock()
 	defer t.Ctx.Lock.Unlock()
@@ -539,6 +575,7 @@ func (t *Transitive) HealthCheck(ctx context.Context) (interface{}, error) {
 	vmIntf, vmErr := t.VM.HealthCheck(ctx)
 	intf := map[string]interface{}{
 		"consensus": consensusIntf,
+		"params":    t.params,
 		"vm":        vmIntf,
 	}
 	if consensusErr == nil {
@@ -646,7 +683,7 @@ func (t *Transitive) buildBlocks(ctx context.Context) error {
 	if err := t.errs.Err; err != nil {
 		return err
 	}
-	for t.pendingBuildBlocks > 0 && t.Consensus.NumProcessing() < t.Params.OptimalProcessing {
+	for t.pendingBuildBlocks > 0 && t.Consensus.NumProcessing() < t.params.OptimalProcessing {
 		t.pendingBuildBlocks--
 
 		blk, err := t.VM.BuildBlock(ctx)
@@ -701,11 +738,49 @@ func (t *Transitive) repoll(ctx context.Context) {
 	// propagate the most likely branch as quickly as possible
 	prefID := t.Consensus.Preference()
 
-	for i := t.polls.Len(); i < t.Params.ConcurrentRepolls; i++ {
+	for i := t.polls.Len(); i < t.params.ConcurrentRepolls; i++ {
 		t.sendQuery(ctx, prefID, nil, false)
 	}
 }
 
+// updateParams applies the parameters that should be used with the current
+// validator set, if they differ from the parameters currently being used.
+func (t *Transitive) updateParams() error {
+	params := t.Params
+	if t.AdaptiveParams {
+		params = params.Scale(t.Validators.Count(t.Ctx.SubnetID))
+	}
+	if params == t.params {
+		return nil
+	}
+
+	// Consensus is only initialized once the engine has started.
+	if t.Ctx.State.Get().State == snow.NormalOp {
+		if err := t.Consensus.SetParameters(params); err != nil {
+			return err
+		}
+	}
+
+	t.Ctx.Log.Info("updating consensus parameters",
+		zap.Int("k", params.K),
+		zap.Int("alphaPreference", params.AlphaPreference),
+		zap.Int("alphaConfidence", params.AlphaConfidence),
+		zap.Int("beta", params.Beta),
+		zap.Int("concurrentRepolls", params.ConcurrentRepolls),
+	)
+
+	t.polls.SetFactory(newPollFactory(params))
+	t.params = params
+	return nil
+}
+
+func newPollFactory(params snowball.Parameters) poll.Factory {
+	return poll.NewEarlyTermNoTraversalFactory(
+		params.AlphaPreference,
+		params.AlphaConfidence,
+	)
+}
+
 // issueFromByID attempts to issue the branch ending with a block [blkID] into consensus.
 // If we do not have [blkID], request it.
 // Returns true if the block is processing in consensus or is decided.
@@ -895,16 +970,25 @@ func (t *Transitive) sendQuery(
 	blkBytes []byte,
 	push bool,
 ) {
+	if err := t.updateParams(); err != nil {
+		t.Ctx.Log.Error("dropped query for block",
+			zap.String("reason", "failed to update consensus parameters"),
+			zap.Stringer("blkID", blkID),
+			zap.Error(err),
+		)
+		return
+	}
+
 	t.Ctx.Log.Verbo("sampling from validators",
 		zap.Stringer("validators", t.Validators),
 	)
 
-	vdrIDs, err := t.Validators.Sample(t.Ctx.SubnetID, t.Params.K)
+	vdrIDs, err := t.Validators.Sample(t.Ctx.SubnetID, t.params.K)
 	if err != nil {
 		t.Ctx.Log.Warn("dropped query for block",
 			zap.String("reason", "insufficient number of validators"),
 			zap.Stringer("blkID", blkID),
-			zap.Int("size", t.Params.K),
+			zap.Int("size", t.params.K),
 		)
 		return
 	}
@@ -28,6 +28,7 @@ import (
 	"github.com/ava-labs/avalanchego/snow/snowtest"
 	"github.com/ava-labs/avalanchego/snow/validators"
 	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/utils/units"
 	"github.com/ava-labs/avalanchego/version"
 )
 
@@ -3023,3 +3024,131 @@ func TestGetProcessingAncestor(t *testing.T) {
 		})
 	}
 }
+
+func TestEngineSetParametersKeepsOutstandingPolls(t *testing.T) {
+	require := require.New(t)
+
+	vdr, _, sender, vm, te := setup(t, DefaultConfig(t))
+
+	sender.Default(true)
+
+	blk := snowmantest.BuildChild(snowmantest.Genesis)
+
+	vm.ParseBlockF = func(_ context.Context, b []byte) (snowman.Block, error) {
+		if bytes.Equal(b, blk.Bytes()) {
+			return blk, nil
+		}
+		return nil, errUnknownBytes
+	}
+	vm.GetBlockF = func(_ context.Context, blkID ids.ID) (snowman.Block, error) {
+		switch blkID {
+		case snowmantest.GenesisID:
+			return snowmantest.Genesis, nil
+		case blk.ID():
+			return blk, nil
+		default:
+			return nil, errUnknownBlock
+		}
+	}
+	sender.SendChitsF = func(context.Context, ids.NodeID, uint32, ids.ID, ids.ID, ids.ID) {}
+
+	var queryRequestIDs []uint32
+	sender.SendPullQueryF = func(_ context.Context, _ set.Set[ids.NodeID], requestID uint32, blkID ids.ID, _ uint64) {
+		require.Equal(blk.ID(), blkID)
+		queryRequestIDs = append(queryRequestIDs, requestID)
+	}
+
+	require.NoError(te.PushQuery(context.Background(), vdr, 0, blk.Bytes(), 1))
+	require.Len(queryRequestIDs, 1)
+	oldRequestID := queryRequestIDs[0]
+
+	params := te.Params
+	params.Beta = 2
+	params.ConcurrentRepolls = 2
+	require.NoError(te.SetParameters(context.Background(), params, false))
+	require.Equal(params, te.params)
+
+	// The outstanding poll was kept and a new poll was added to reach the
+	// new number of concurrent repolls.
+	require.Len(queryRequestIDs, 2)
+	require.Equal(2, te.polls.Len())
+
+	// Votes for the outstanding poll are still applied.
+	require.NoError(te.Chits(context.Background(), vdr, oldRequestID, blk.ID(), blk.ID(), blk.ID()))
+	require.Equal(choices.Processing, blk.Status())
+
+	require.NoError(te.Chits(context.Background(), vdr, queryRequestIDs[1], blk.ID(), blk.ID(), blk.ID()))
+	require.Equal(choices.Accepted, blk.Status())
+}
+
+func TestEngineSetParametersInvalid(t *testing.T) {
+	require := require.New(t)
+
+	_, _, _, _, te := setup(t, DefaultConfig(t))
+
+	params := te.Params
+	params.AlphaPreference = 0
+	err := te.SetParameters(context.Background(), params, false)
+	require.ErrorIs(err, snowball.ErrParametersInvalid)
+	require.NotEqual(params, te.Params)
+}
+
+func TestEngineAdaptiveParams(t *testing.T) {
+	require := require.New(t)
+
+	config := DefaultConfig(t)
+	config.Params.K = 3
+	config.Params.AlphaPreference = 2
+	config.Params.AlphaConfidence = 2
+	config.AdaptiveParams = true
+
+	vdr, vdrs, sender, _, te := setup(t, config)
+
+	// There is only a single validator, so the sample size is lowered.
+	require.Equal(1, te.params.K)
+	require.Equal(1, te.params.AlphaPreference)
+	require.Equal(1, te.params.AlphaConfidence)
+
+	sender.Default(true)
+
+	var queried set.Set[ids.NodeID]
+	sender.SendPullQueryF = func(_ context.Context, inVdrs set.Set[ids.NodeID], _ uint32, _ ids.ID, _ uint64) {
+		queried = inVdrs
+	}
+
+	te.repoll(context.Background())
+	require.Equal(set.Of(vdr), queried)
+
+	// As validators are added, the sample size is raised again.
+	vdr1 := ids.GenerateTestNodeID()
+	vdr2 := ids.GenerateTestNodeID()
+	require.NoError(vdrs.AddStaker(te.Ctx.SubnetID, vdr1, nil, ids.Empty, 1))
+	require.NoError(vdrs.AddStaker(te.Ctx.SubnetID, vdr2, nil, ids.Empty, 1))
+
+	te.sendQuery(context.Background(), snowmantest.GenesisID, nil, false)
+	require.Equal(set.Of(vdr, vdr1, vdr2), queried)
+	require.Equal(config.Params, te.params)
+}
+
+func TestEngineAdaptiveParamsStakeWeights(t *testing.T) {
+	require := require.New(t)
+
+	config := DefaultConfig(t)
+	config.Params = snowball.DefaultParameters
+	config.AdaptiveParams = true
+
+	_, vdrs, _, _, te := setup(t, config)
+
+	// Validators of permissioned subnets commonly have weights denominated in
+	// nAVAX, so the total weight is far larger than K even though there are
+	// only a few validators.
+	for i := 0; i < 4; i++ {
+		require.NoError(vdrs.AddStaker(te.Ctx.SubnetID, ids.GenerateTestNodeID(), nil, ids.Empty, 2_000*units.Avax))
+	}
+	require.NoError(te.updateParams())
+
+	expectedParams := config.Params.Scale(5)
+	require.Equal(5, expectedParams.K)
+	require.Equal(expectedParams, te.params)
+	require.Greater(2*te.params.AlphaPreference, te.params.K)
+}
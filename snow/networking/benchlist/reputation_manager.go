@@ -0,0 +1,37 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package benchlist
+
+import (
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/network/reputation"
+)
+
+var _ Manager = (*reputationManager)(nil)
+
+// reputationManager reports the query results to a reputation tracker before
+// passing them to the wrapped benchlist.
+type reputationManager struct {
+	Manager
+	reputation reputation.Tracker
+}
+
+// NewReputationManager returns a manager that also registers query responses
+// and failures with [reputation].
+func NewReputationManager(manager Manager, reputation reputation.Tracker) Manager {
+	return &reputationManager{
+		Manager:    manager,
+		reputation: reputation,
+	}
+}
+
+func (m *reputationManager) RegisterResponse(chainID ids.ID, nodeID ids.NodeID) {
+	m.reputation.RegisterResponse(nodeID)
+	m.Manager.RegisterResponse(chainID, nodeID)
+}
+
+func (m *reputationManager) RegisterFailure(chainID ids.ID, nodeID ids.NodeID) {
+	m.reputation.RegisterTimeout(nodeID)
+	m.Manager.RegisterFailure(chainID, nodeID)
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/message"
 	"github.com/ava-labs/avalanchego/network/p2p"
+	"github.com/ava-labs/avalanchego/network/reputation"
 	"github.com/ava-labs/avalanchego/snow"
 	"github.com/ava-labs/avalanchego/snow/engine/common"
 	"github.com/ava-labs/avalanchego/snow/networking/tracker"
@@ -63,6 +64,7 @@ func TestHandlerDropsTimedOutMessages(t *testing.T) {
 		prometheus.NewRegistry(),
 		nil,
 		version.CurrentApp,
+		reputation.NewNoTracker(),
 	)
 	require.NoError(err)
 
@@ -169,6 +171,7 @@ func TestHandlerClosesOnError(t *testing.T) {
 		prometheus.NewRegistry(),
 		nil,
 		version.CurrentApp,
+		reputation.NewNoTracker(),
 	)
 	require.NoError(err)
 
@@ -271,6 +274,7 @@ func TestHandlerDropsGossipDuringBootstrapping(t *testing.T) {
 		prometheus.NewRegistry(),
 		nil,
 		version.CurrentApp,
+		reputation.NewNoTracker(),
 	)
 	require.NoError(err)
 
@@ -361,6 +365,7 @@ func TestHandlerDispatchInternal(t *testing.T) {
 		prometheus.NewRegistry(),
 		nil,
 		version.CurrentApp,
+		reputation.NewNoTracker(),
 	)
 	require.NoError(err)
 
@@ -446,6 +451,7 @@ func TestHandlerSubnetConnector(t *testing.T) {
 		prometheus.NewRegistry(),
 		nil,
 		version.CurrentApp,
+		reputation.NewNoTracker(),
 	)
 	require.NoError(err)
 
@@ -627,6 +633,7 @@ func TestDynamicEngineTypeDispatch(t *testing.T) {
 				prometheus.NewRegistry(),
 				nil,
 				version.CurrentApp,
+				reputation.NewNoTracker(),
 			)
 			require.NoError(err)
 
@@ -709,6 +716,7 @@ func TestHandlerStartError(t *testing.T) {
 		prometheus.NewRegistry(),
 		nil,
 		version.CurrentApp,
+		reputation.NewNoTracker(),
 	)
 	require.NoError(err)
 
@@ -13,6 +13,7 @@ import (
 
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/network/p2p"
+	"github.com/ava-labs/avalanchego/network/reputation"
 	"github.com/ava-labs/avalanchego/snow"
 	"github.com/ava-labs/avalanchego/snow/consensus/snowball"
 	"github.com/ava-labs/avalanchego/snow/engine/common"
@@ -79,6 +80,7 @@ func TestHealthCheckSubnet(t *testing.T) {
 				prometheus.NewRegistry(),
 				nil,
 				version.CurrentApp,
+				reputation.NewNoTracker(),
 			)
 			require.NoError(err)
 
@@ -0,0 +1,74 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package replay
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/message"
+	"github.com/ava-labs/avalanchego/network/capture"
+	"github.com/ava-labs/avalanchego/snow/networking/handler"
+)
+
+// Replay pushes the inbound messages of [records] that are destined to the
+// chain of [h] into [h], in the order they were captured. Each message is
+// handled before the next message is pushed, so that replaying a capture is
+// deterministic.
+//
+// [h] must have been started. Its engines should send messages through a
+// [Sender] so that the replay doesn't reach the network.
+//
+// Returns the number of replayed messages.
+func Replay(
+	ctx context.Context,
+	records []*capture.Record,
+	parser message.InboundMsgBuilder,
+	h handler.Handler,
+) (int, error) {
+	var (
+		chainID     = h.Context().ChainID
+		numReplayed = 0
+	)
+	for i, record := range records {
+		if record.Direction != capture.Inbound {
+			continue
+		}
+
+		handled := make(chan struct{}, 1)
+		msg, err := parser.Parse(record.Bytes, record.NodeID, func() {
+			select {
+			case handled <- struct{}{}:
+			default:
+			}
+		})
+		if err != nil {
+			return numReplayed, fmt.Errorf("couldn't parse record %d: %w", i, err)
+		}
+
+		// Network messages and messages for other chains aren't replayed.
+		m := msg.Message()
+		destinationChainID, err := message.GetChainID(m)
+		if err != nil || destinationChainID != chainID {
+			msg.OnFinishedHandling()
+			continue
+		}
+
+		// Note: engineType is not guaranteed to be one of the explicitly named
+		// enum values. If it was not specified it defaults to UNSPECIFIED.
+		engineType, _ := message.GetEngineType(m)
+		h.Push(ctx, handler.Message{
+			InboundMessage: msg,
+			EngineType:     engineType,
+		})
+
+		select {
+		case <-handled:
+		case <-ctx.Done():
+			return numReplayed, ctx.Err()
+		}
+		numReplayed++
+	}
+	return numReplayed, nil
+}
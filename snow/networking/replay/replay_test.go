@@ -0,0 +1,174 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package replay
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/message"
+	"github.com/ava-labs/avalanchego/network/capture"
+	"github.com/ava-labs/avalanchego/snow/engine/common"
+	"github.com/ava-labs/avalanchego/snow/networking/handler"
+	"github.com/ava-labs/avalanchego/snow/snowtest"
+	"github.com/ava-labs/avalanchego/subnets"
+	"github.com/ava-labs/avalanchego/utils/compression"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/set"
+)
+
+func newMessageCreator(t *testing.T) message.Creator {
+	mc, err := message.NewCreator(
+		logging.NoLog{},
+		prometheus.NewRegistry(),
+		"",
+		compression.TypeZstd,
+		10*time.Second,
+	)
+	require.NoError(t, err)
+	return mc
+}
+
+func TestReplay(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+
+	mc := newMessageCreator(t)
+	snowCtx := snowtest.Context(t, snowtest.CChainID)
+	ctx := snowtest.ConsensusContext(snowCtx)
+	otherChainID := ids.GenerateTestID()
+	nodeID := ids.GenerateTestNodeID()
+
+	ping, err := mc.Ping(100, nil)
+	require.NoError(err)
+	pushQuery, err := mc.PushQuery(ctx.ChainID, 1, time.Second, []byte{1}, 0)
+	require.NoError(err)
+	otherChainGossip, err := mc.AppGossip(otherChainID, []byte{2})
+	require.NoError(err)
+	chits, err := mc.Chits(ctx.ChainID, 2, ids.GenerateTestID(), ids.GenerateTestID(), ids.GenerateTestID())
+	require.NoError(err)
+
+	records := []*capture.Record{
+		{
+			Direction: capture.Inbound,
+			NodeID:    nodeID,
+			Bytes:     ping.Bytes(),
+		},
+		{
+			Direction: capture.Inbound,
+			NodeID:    nodeID,
+			Bytes:     pushQuery.Bytes(),
+		},
+		{
+			Direction: capture.Inbound,
+			NodeID:    nodeID,
+			Bytes:     otherChainGossip.Bytes(),
+		},
+		{
+			Direction: capture.Outbound,
+			NodeID:    nodeID,
+			Bytes:     chits.Bytes(),
+		},
+		{
+			Direction: capture.Inbound,
+			NodeID:    nodeID,
+			Bytes:     chits.Bytes(),
+		},
+	}
+
+	var replayed []message.Op
+	h := handler.NewMockHandler(ctrl)
+	h.EXPECT().Context().Return(ctx).AnyTimes()
+	h.EXPECT().Push(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, msg handler.Message) {
+			require.Equal(nodeID, msg.NodeID())
+			replayed = append(replayed, msg.Op())
+			go msg.OnFinishedHandling()
+		},
+	).Times(2)
+
+	numReplayed, err := Replay(context.Background(), records, mc, h)
+	require.NoError(err)
+	require.Equal(2, numReplayed)
+	require.Equal([]message.Op{message.PushQueryOp, message.ChitsOp}, replayed)
+}
+
+func TestReplayCancelled(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+
+	mc := newMessageCreator(t)
+	snowCtx := snowtest.Context(t, snowtest.CChainID)
+	ctx := snowtest.ConsensusContext(snowCtx)
+
+	pushQuery, err := mc.PushQuery(ctx.ChainID, 1, time.Second, []byte{1}, 0)
+	require.NoError(err)
+	records := []*capture.Record{{
+		Direction: capture.Inbound,
+		NodeID:    ids.GenerateTestNodeID(),
+		Bytes:     pushQuery.Bytes(),
+	}}
+
+	replayCtx, cancel := context.WithCancel(context.Background())
+
+	// The handler never finishes handling the message.
+	h := handler.NewMockHandler(ctrl)
+	h.EXPECT().Context().Return(ctx).AnyTimes()
+	h.EXPECT().Push(gomock.Any(), gomock.Any()).Do(
+		func(context.Context, handler.Message) {
+			cancel()
+		},
+	)
+
+	numReplayed, err := Replay(replayCtx, records, mc, h)
+	require.ErrorIs(err, context.Canceled)
+	require.Zero(numReplayed)
+}
+
+func TestSender(t *testing.T) {
+	require := require.New(t)
+
+	mc := newMessageCreator(t)
+	msg, err := mc.AppGossip(ids.GenerateTestID(), []byte{1})
+	require.NoError(err)
+
+	now := time.Unix(1, 0)
+	s := &Sender{}
+	s.Clock.Set(now)
+
+	nodeID := ids.GenerateTestNodeID()
+	nodeIDs := set.Of(nodeID)
+	sentTo := s.Send(
+		msg,
+		common.SendConfig{
+			NodeIDs:    nodeIDs,
+			Validators: 1,
+		},
+		ids.Empty,
+		subnets.NoOpAllower,
+	)
+	require.Equal(nodeIDs, sentTo)
+	require.Equal(
+		[]*capture.Record{
+			{
+				Time:      now,
+				Direction: capture.Outbound,
+				NodeID:    nodeID,
+				Bytes:     msg.Bytes(),
+			},
+			{
+				Time:      now,
+				Direction: capture.Outbound,
+				Bytes:     msg.Bytes(),
+			},
+		},
+		s.Sent(),
+	)
+}
@@ -0,0 +1,70 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package replay
+
+import (
+	"sync"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/message"
+	"github.com/ava-labs/avalanchego/network/capture"
+	"github.com/ava-labs/avalanchego/snow/engine/common"
+	"github.com/ava-labs/avalanchego/snow/networking/sender"
+	"github.com/ava-labs/avalanchego/subnets"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/utils/timer/mockable"
+)
+
+var _ sender.ExternalSender = (*Sender)(nil)
+
+// Sender records the messages that are sent during a replay rather than
+// sending them to the network. The recorded messages can be compared to the
+// outbound messages of the capture.
+type Sender struct {
+	Clock mockable.Clock
+
+	lock sync.Mutex
+	sent []*capture.Record
+}
+
+// Send records [msg] as having been sent to every node in [config.NodeIDs].
+// Messages that would have been sent to sampled peers are recorded with an
+// empty nodeID.
+func (s *Sender) Send(
+	msg message.OutboundMessage,
+	config common.SendConfig,
+	_ ids.ID,
+	_ subnets.Allower,
+) set.Set[ids.NodeID] {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	now := s.Clock.Time()
+	for nodeID := range config.NodeIDs {
+		s.sent = append(s.sent, &capture.Record{
+			Time:      now,
+			Direction: capture.Outbound,
+			NodeID:    nodeID,
+			Bytes:     msg.Bytes(),
+		})
+	}
+	if config.Validators+config.NonValidators+config.Peers > 0 {
+		s.sent = append(s.sent, &capture.Record{
+			Time:      now,
+			Direction: capture.Outbound,
+			Bytes:     msg.Bytes(),
+		})
+	}
+	return config.NodeIDs
+}
+
+// Sent returns the messages that were sent, in the order they were sent.
+func (s *Sender) Sent() []*capture.Record {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	sent := make([]*capture.Record, len(s.sent))
+	copy(sent, s.sent)
+	return sent
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/message"
 	"github.com/ava-labs/avalanchego/network/p2p"
+	"github.com/ava-labs/avalanchego/network/reputation"
 	"github.com/ava-labs/avalanchego/snow"
 	"github.com/ava-labs/avalanchego/snow/engine/common"
 	"github.com/ava-labs/avalanchego/snow/networking/benchlist"
@@ -100,6 +101,7 @@ func TestShutdown(t *testing.T) {
 		prometheus.NewRegistry(),
 		nil,
 		version.CurrentApp,
+		reputation.NewNoTracker(),
 	)
 	require.NoError(err)
 
@@ -249,6 +251,7 @@ func TestShutdownTimesOut(t *testing.T) {
 		prometheus.NewRegistry(),
 		nil,
 		version.CurrentApp,
+		reputation.NewNoTracker(),
 	)
 	require.NoError(err)
 
@@ -419,6 +422,7 @@ func TestRouterTimeout(t *testing.T) {
 		prometheus.NewRegistry(),
 		nil,
 		version.CurrentApp,
+		reputation.NewNoTracker(),
 	)
 	require.NoError(err)
 
@@ -1003,6 +1007,7 @@ func TestValidatorOnlyMessageDrops(t *testing.T) {
 		prometheus.NewRegistry(),
 		nil,
 		version.CurrentApp,
+		reputation.NewNoTracker(),
 	)
 	require.NoError(err)
 
@@ -1285,6 +1290,7 @@ func TestValidatorOnlyAllowedNodeMessageDrops(t *testing.T) {
 		prometheus.NewRegistry(),
 		nil,
 		version.CurrentApp,
+		reputation.NewNoTracker(),
 	)
 	require.NoError(err)
 
@@ -1625,6 +1631,7 @@ func newChainRouterTest(t *testing.T) (*ChainRouter, *common.EngineTest) {
 		prometheus.NewRegistry(),
 		nil,
 		version.CurrentApp,
+		reputation.NewNoTracker(),
 	)
 	require.NoError(t, err)
 
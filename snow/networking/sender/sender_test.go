@@ -17,6 +17,7 @@ import (
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/message"
 	"github.com/ava-labs/avalanchego/network/p2p"
+	"github.com/ava-labs/avalanchego/network/reputation"
 	"github.com/ava-labs/avalanchego/snow"
 	"github.com/ava-labs/avalanchego/snow/engine/common"
 	"github.com/ava-labs/avalanchego/snow/networking/benchlist"
@@ -119,6 +120,7 @@ func TestTimeout(t *testing.T) {
 		prometheus.NewRegistry(),
 		nil,
 		version.CurrentApp,
+		reputation.NewNoTracker(),
 	)
 	require.NoError(err)
 
@@ -396,6 +398,7 @@ func TestReliableMessages(t *testing.T) {
 		prometheus.NewRegistry(),
 		nil,
 		version.CurrentApp,
+		reputation.NewNoTracker(),
 	)
 	require.NoError(err)
 
@@ -553,6 +556,7 @@ func TestReliableMessagesToMyself(t *testing.T) {
 		prometheus.NewRegistry(),
 		nil,
 		version.CurrentApp,
+		reputation.NewNoTracker(),
 	)
 	require.NoError(err)
 
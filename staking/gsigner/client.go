@@ -0,0 +1,131 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package gsigner
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/ava-labs/avalanchego/utils/crypto/bls"
+
+	pb "github.com/ava-labs/avalanchego/proto/pb/signer"
+)
+
+var (
+	_ bls.Signer    = (*BLSClient)(nil)
+	_ crypto.Signer = (*TLSClient)(nil)
+)
+
+// BLSClient is a BLS signer whose secret key is held by a remote process.
+type BLSClient struct {
+	client    pb.SignerClient
+	timeout   time.Duration
+	publicKey *bls.PublicKey
+}
+
+// NewBLSClient fetches the public key of the remote signer and returns a BLS
+// signer that forwards signing requests to it. Each signing request fails if
+// the remote signer doesn't respond within [timeout].
+func NewBLSClient(ctx context.Context, client pb.SignerClient, timeout time.Duration) (*BLSClient, error) {
+	resp, err := client.BLSPublicKey(ctx, &pb.BLSPublicKeyRequest{})
+	if err != nil {
+		return nil, err
+	}
+	publicKey, err := bls.PublicKeyFromCompressedBytes(resp.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't parse BLS public key: %w", err)
+	}
+	return &BLSClient{
+		client:    client,
+		timeout:   timeout,
+		publicKey: publicKey,
+	}, nil
+}
+
+func (c *BLSClient) PublicKey() *bls.PublicKey {
+	return c.publicKey
+}
+
+func (c *BLSClient) Sign(msg []byte) (*bls.Signature, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	resp, err := c.client.BLSSign(ctx, &pb.BLSSignRequest{
+		Message: msg,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return bls.SignatureFromBytes(resp.Signature)
+}
+
+func (c *BLSClient) SignProofOfPossession(msg []byte) (*bls.Signature, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	resp, err := c.client.BLSSignProofOfPossession(ctx, &pb.BLSSignProofOfPossessionRequest{
+		Message: msg,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return bls.SignatureFromBytes(resp.Signature)
+}
+
+// TLSClient is a TLS signer whose private key is held by a remote process.
+type TLSClient struct {
+	client    pb.SignerClient
+	timeout   time.Duration
+	publicKey crypto.PublicKey
+}
+
+// NewTLSClient fetches the public key of the remote signer and returns a TLS
+// signer that forwards signing requests to it. Each signing request fails if
+// the remote signer doesn't respond within [timeout].
+func NewTLSClient(ctx context.Context, client pb.SignerClient, timeout time.Duration) (*TLSClient, error) {
+	resp, err := client.TLSPublicKey(ctx, &pb.TLSPublicKeyRequest{})
+	if err != nil {
+		return nil, err
+	}
+	publicKey, err := x509.ParsePKIXPublicKey(resp.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't parse TLS public key: %w", err)
+	}
+	return &TLSClient{
+		client:    client,
+		timeout:   timeout,
+		publicKey: publicKey,
+	}, nil
+}
+
+func (c *TLSClient) Public() crypto.PublicKey {
+	return c.publicKey
+}
+
+// Sign signs [digest] with the remote key. The randomness source is provided
+// by the remote signer, so [rand] is ignored.
+func (c *TLSClient) Sign(_ io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	req := &pb.TLSSignRequest{
+		Digest: digest,
+		Hash:   uint32(opts.HashFunc()),
+	}
+	if pssOpts, ok := opts.(*rsa.PSSOptions); ok {
+		req.Pss = true
+		req.PssSaltLength = int32(pssOpts.SaltLength)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	resp, err := c.client.TLSSign(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Signature, nil
+}
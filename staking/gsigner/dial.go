@@ -0,0 +1,73 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package gsigner
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/ava-labs/avalanchego/vms/rpcchainvm/grpcutils"
+)
+
+// UnixSocketPrefix prefixes endpoints that refer to a unix socket rather than
+// a host:port address.
+const UnixSocketPrefix = "unix://"
+
+var ErrInsecureEndpoint = errors.New("signer endpoints without TLS must be a unix socket or a loopback address")
+
+// VerifyEndpoint returns an error if [endpoint] can't be used to reach a
+// signer. Without TLS, anyone that can reach the signer can request
+// signatures from it, so only unix sockets and loopback addresses are
+// allowed.
+func VerifyEndpoint(endpoint string, tlsEnabled bool) error {
+	if path, ok := strings.CutPrefix(endpoint, UnixSocketPrefix); ok {
+		if path == "" {
+			return fmt.Errorf("missing unix socket path in %q", endpoint)
+		}
+		return nil
+	}
+
+	host, _, err := net.SplitHostPort(endpoint)
+	if err != nil {
+		return fmt.Errorf("invalid signer endpoint %q: %w", endpoint, err)
+	}
+	if tlsEnabled || host == "localhost" {
+		return nil
+	}
+	if ip := net.ParseIP(host); ip != nil && ip.IsLoopback() {
+		return nil
+	}
+	return fmt.Errorf("%w: %s", ErrInsecureEndpoint, endpoint)
+}
+
+// Dial returns a connection to the signer at [endpoint]. If [tlsConfig] is
+// nil, the connection is neither encrypted nor authenticated.
+func Dial(endpoint string, tlsConfig *tls.Config) (*grpc.ClientConn, error) {
+	if err := VerifyEndpoint(endpoint, tlsConfig != nil); err != nil {
+		return nil, err
+	}
+
+	var opts []grpcutils.DialOption
+	if tlsConfig != nil {
+		opts = append(opts, grpcutils.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+	}
+	addr := endpoint
+	if path, ok := strings.CutPrefix(endpoint, UnixSocketPrefix); ok {
+		addr = path
+		opts = append(opts, grpcutils.WithContextDialer(dialUnix))
+	}
+	return grpcutils.Dial(addr, opts...)
+}
+
+func dialUnix(ctx context.Context, path string) (net.Conn, error) {
+	var dialer net.Dialer
+	return dialer.DialContext(ctx, "unix", path)
+}
@@ -0,0 +1,87 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package gsigner
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+
+	"github.com/ava-labs/avalanchego/utils/crypto/bls"
+
+	pb "github.com/ava-labs/avalanchego/proto/pb/signer"
+)
+
+var _ pb.SignerServer = (*Server)(nil)
+
+// Server exposes staking keys to a node over gRPC. It is intended to be run
+// in a separate process from the node.
+type Server struct {
+	pb.UnsafeSignerServer
+	blsSigner bls.Signer
+	tlsSigner crypto.Signer
+}
+
+func NewServer(blsSigner bls.Signer, tlsSigner crypto.Signer) *Server {
+	return &Server{
+		blsSigner: blsSigner,
+		tlsSigner: tlsSigner,
+	}
+}
+
+func (s *Server) BLSPublicKey(context.Context, *pb.BLSPublicKeyRequest) (*pb.BLSPublicKeyResponse, error) {
+	return &pb.BLSPublicKeyResponse{
+		PublicKey: bls.PublicKeyToCompressedBytes(s.blsSigner.PublicKey()),
+	}, nil
+}
+
+func (s *Server) BLSSign(_ context.Context, req *pb.BLSSignRequest) (*pb.BLSSignResponse, error) {
+	sig, err := s.blsSigner.Sign(req.Message)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.BLSSignResponse{
+		Signature: bls.SignatureToBytes(sig),
+	}, nil
+}
+
+func (s *Server) BLSSignProofOfPossession(_ context.Context, req *pb.BLSSignProofOfPossessionRequest) (*pb.BLSSignProofOfPossessionResponse, error) {
+	sig, err := s.blsSigner.SignProofOfPossession(req.Message)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.BLSSignProofOfPossessionResponse{
+		Signature: bls.SignatureToBytes(sig),
+	}, nil
+}
+
+func (s *Server) TLSPublicKey(context.Context, *pb.TLSPublicKeyRequest) (*pb.TLSPublicKeyResponse, error) {
+	publicKey, err := x509.MarshalPKIXPublicKey(s.tlsSigner.Public())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.TLSPublicKeyResponse{
+		PublicKey: publicKey,
+	}, nil
+}
+
+func (s *Server) TLSSign(_ context.Context, req *pb.TLSSignRequest) (*pb.TLSSignResponse, error) {
+	var opts crypto.SignerOpts = crypto.Hash(req.Hash)
+	if req.Pss {
+		opts = &rsa.PSSOptions{
+			SaltLength: int(req.PssSaltLength),
+			Hash:       crypto.Hash(req.Hash),
+		}
+	}
+
+	sig, err := s.tlsSigner.Sign(rand.Reader, req.Digest, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.TLSSignResponse{
+		Signature: sig,
+	}, nil
+}
@@ -0,0 +1,262 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package gsigner
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ava-labs/avalanchego/utils/crypto/bls"
+	"github.com/ava-labs/avalanchego/utils/hashing"
+	"github.com/ava-labs/avalanchego/vms/rpcchainvm/grpcutils"
+
+	pb "github.com/ava-labs/avalanchego/proto/pb/signer"
+)
+
+const testTimeout = 10 * time.Second
+
+func setupClient(t *testing.T, blsSigner bls.Signer, tlsSigner crypto.Signer) pb.SignerClient {
+	require := require.New(t)
+
+	listener, err := grpcutils.NewListener()
+	require.NoError(err)
+	serverCloser := grpcutils.ServerCloser{}
+
+	server := grpcutils.NewServer()
+	pb.RegisterSignerServer(server, NewServer(blsSigner, tlsSigner))
+	serverCloser.Add(server)
+
+	go grpcutils.Serve(listener, server)
+
+	conn, err := grpcutils.Dial(listener.Addr().String())
+	require.NoError(err)
+
+	t.Cleanup(func() {
+		serverCloser.Stop()
+		_ = conn.Close()
+		_ = listener.Close()
+	})
+	return pb.NewSignerClient(conn)
+}
+
+func TestBLSClient(t *testing.T) {
+	require := require.New(t)
+
+	sk, err := bls.NewSecretKey()
+	require.NoError(err)
+	localSigner := bls.NewLocalSigner(sk)
+
+	tlsKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(err)
+
+	client, err := NewBLSClient(
+		context.Background(),
+		setupClient(t, localSigner, tlsKey),
+		testTimeout,
+	)
+	require.NoError(err)
+
+	pk := client.PublicKey()
+	require.Equal(localSigner.PublicKey(), pk)
+
+	msg := []byte("hello world")
+	sig, err := client.Sign(msg)
+	require.NoError(err)
+	require.True(bls.Verify(pk, sig, msg))
+	require.False(bls.VerifyProofOfPossession(pk, sig, msg))
+
+	sig, err = client.SignProofOfPossession(msg)
+	require.NoError(err)
+	require.True(bls.VerifyProofOfPossession(pk, sig, msg))
+	require.False(bls.Verify(pk, sig, msg))
+}
+
+func TestTLSClient(t *testing.T) {
+	sk, err := bls.NewSecretKey()
+	require.NoError(t, err)
+	blsSigner := bls.NewLocalSigner(sk)
+
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	msg := []byte("hello world")
+	digest := hashing.ComputeHash256(msg)
+	tests := []struct {
+		name   string
+		key    crypto.Signer
+		opts   crypto.SignerOpts
+		verify func(t *testing.T, pk crypto.PublicKey, sig []byte)
+	}{
+		{
+			name: "ecdsa",
+			key:  ecdsaKey,
+			opts: crypto.SHA256,
+			verify: func(t *testing.T, pk crypto.PublicKey, sig []byte) {
+				require.IsType(t, &ecdsa.PublicKey{}, pk)
+				require.True(t, ecdsa.VerifyASN1(pk.(*ecdsa.PublicKey), digest, sig))
+			},
+		},
+		{
+			name: "rsa pkcs1v15",
+			key:  rsaKey,
+			opts: crypto.SHA256,
+			verify: func(t *testing.T, pk crypto.PublicKey, sig []byte) {
+				require.IsType(t, &rsa.PublicKey{}, pk)
+				require.NoError(t, rsa.VerifyPKCS1v15(pk.(*rsa.PublicKey), crypto.SHA256, digest, sig))
+			},
+		},
+		{
+			name: "rsa pss",
+			key:  rsaKey,
+			opts: &rsa.PSSOptions{
+				SaltLength: rsa.PSSSaltLengthEqualsHash,
+				Hash:       crypto.SHA256,
+			},
+			verify: func(t *testing.T, pk crypto.PublicKey, sig []byte) {
+				require.IsType(t, &rsa.PublicKey{}, pk)
+				require.NoError(t, rsa.VerifyPSS(pk.(*rsa.PublicKey), crypto.SHA256, digest, sig, &rsa.PSSOptions{
+					SaltLength: rsa.PSSSaltLengthEqualsHash,
+				}))
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			client, err := NewTLSClient(
+				context.Background(),
+				setupClient(t, blsSigner, test.key),
+				testTimeout,
+			)
+			require.NoError(err)
+			require.Equal(test.key.Public(), client.Public())
+
+			sig, err := client.Sign(rand.Reader, digest, test.opts)
+			require.NoError(err)
+			test.verify(t, client.Public(), sig)
+		})
+	}
+}
+
+// blockingSigner doesn't respond to signing requests until [unblock] is
+// closed.
+type blockingSigner struct {
+	bls.Signer
+	unblock chan struct{}
+}
+
+func (s *blockingSigner) Sign(msg []byte) (*bls.Signature, error) {
+	<-s.unblock
+	return s.Signer.Sign(msg)
+}
+
+func TestBLSClientTimeout(t *testing.T) {
+	require := require.New(t)
+
+	sk, err := bls.NewSecretKey()
+	require.NoError(err)
+	tlsKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(err)
+
+	signer := &blockingSigner{
+		Signer:  bls.NewLocalSigner(sk),
+		unblock: make(chan struct{}),
+	}
+	defer close(signer.unblock)
+
+	client, err := NewBLSClient(
+		context.Background(),
+		setupClient(t, signer, tlsKey),
+		10*time.Millisecond,
+	)
+	require.NoError(err)
+
+	_, err = client.Sign([]byte("hello world"))
+	require.Equal(codes.DeadlineExceeded, status.Code(err))
+}
+
+func TestVerifyEndpoint(t *testing.T) {
+	tests := []struct {
+		endpoint    string
+		tlsEnabled  bool
+		expectedErr error
+	}{
+		{
+			endpoint: "unix:///tmp/signer.sock",
+		},
+		{
+			endpoint: "127.0.0.1:9999",
+		},
+		{
+			endpoint: "[::1]:9999",
+		},
+		{
+			endpoint: "localhost:9999",
+		},
+		{
+			endpoint:    "10.0.0.1:9999",
+			expectedErr: ErrInsecureEndpoint,
+		},
+		{
+			endpoint:    "signer.example.com:9999",
+			expectedErr: ErrInsecureEndpoint,
+		},
+		{
+			endpoint:   "10.0.0.1:9999",
+			tlsEnabled: true,
+		},
+		{
+			endpoint:   "signer.example.com:9999",
+			tlsEnabled: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.endpoint, func(t *testing.T) {
+			err := VerifyEndpoint(test.endpoint, test.tlsEnabled)
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
+
+func TestDialUnixSocket(t *testing.T) {
+	require := require.New(t)
+
+	sk, err := bls.NewSecretKey()
+	require.NoError(err)
+	localSigner := bls.NewLocalSigner(sk)
+	tlsKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(err)
+
+	socketPath := filepath.Join(t.TempDir(), "signer.sock")
+	listener, err := net.Listen("unix", socketPath)
+	require.NoError(err)
+
+	server := grpcutils.NewServer()
+	pb.RegisterSignerServer(server, NewServer(localSigner, tlsKey))
+	go grpcutils.Serve(listener, server)
+	defer server.Stop()
+
+	conn, err := Dial(UnixSocketPrefix+socketPath, nil)
+	require.NoError(err)
+	defer conn.Close()
+
+	client, err := NewBLSClient(context.Background(), pb.NewSignerClient(conn), testTimeout)
+	require.NoError(err)
+	require.Equal(localSigner.PublicKey(), client.PublicKey())
+}
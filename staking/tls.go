@@ -5,12 +5,14 @@ package staking
 
 import (
 	"bytes"
+	"crypto"
 	"crypto/ecdsa"
 	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/pem"
+	"errors"
 	"fmt"
 	"math/big"
 	"os"
@@ -20,6 +22,11 @@ import (
 	"github.com/ava-labs/avalanchego/utils/perms"
 )
 
+var (
+	errInvalidCertificatePEM = errors.New("invalid certificate PEM")
+	errPublicKeyMismatch     = errors.New("certificate public key doesn't match signer")
+)
+
 // InitNodeStakingKeyPair generates a self-signed TLS key/cert pair to use in
 // staking. The key and files will be placed at [keyPath] and [certPath],
 // respectively. If there is already a file at [keyPath], returns nil.
@@ -99,6 +106,34 @@ func LoadTLSCertFromFiles(keyPath, certPath string) (*tls.Certificate, error) {
 	return &cert, nil
 }
 
+// LoadTLSCertFromSigner returns a TLS certificate whose private key is held by
+// [signer]. The public key of the PEM encoded [certBytes] must match the public
+// key of [signer].
+func LoadTLSCertFromSigner(certBytes []byte, signer crypto.Signer) (*tls.Certificate, error) {
+	block, _ := pem.Decode(certBytes)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, errInvalidCertificatePEM
+	}
+
+	leaf, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing cert: %w", err)
+	}
+
+	publicKey, ok := leaf.PublicKey.(interface {
+		Equal(crypto.PublicKey) bool
+	})
+	if !ok || !publicKey.Equal(signer.Public()) {
+		return nil, errPublicKeyMismatch
+	}
+
+	return &tls.Certificate{
+		Certificate: [][]byte{block.Bytes},
+		PrivateKey:  signer,
+		Leaf:        leaf,
+	}, nil
+}
+
 func NewTLSCert() (*tls.Certificate, error) {
 	certBytes, keyBytes, err := NewCertAndKeyBytes()
 	if err != nil {
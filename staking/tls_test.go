@@ -30,6 +30,58 @@ func TestMakeKeys(t *testing.T) {
 	require.NoError(cert.Leaf.CheckSignature(cert.Leaf.SignatureAlgorithm, msg, sig))
 }
 
+func TestLoadTLSCertFromSigner(t *testing.T) {
+	certBytes, keyBytes, err := NewCertAndKeyBytes()
+	require.NoError(t, err)
+	cert, err := LoadTLSCertFromBytes(keyBytes, certBytes)
+	require.NoError(t, err)
+	signer := cert.PrivateKey.(crypto.Signer)
+
+	otherCert, err := NewTLSCert()
+	require.NoError(t, err)
+	otherSigner := otherCert.PrivateKey.(crypto.Signer)
+
+	tests := []struct {
+		name        string
+		certBytes   []byte
+		signer      crypto.Signer
+		expectedErr error
+	}{
+		{
+			name:      "valid",
+			certBytes: certBytes,
+			signer:    signer,
+		},
+		{
+			name:        "invalid PEM",
+			certBytes:   keyBytes,
+			signer:      signer,
+			expectedErr: errInvalidCertificatePEM,
+		},
+		{
+			name:        "wrong signer",
+			certBytes:   certBytes,
+			signer:      otherSigner,
+			expectedErr: errPublicKeyMismatch,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			loadedCert, err := LoadTLSCertFromSigner(test.certBytes, test.signer)
+			require.ErrorIs(err, test.expectedErr)
+			if test.expectedErr != nil {
+				return
+			}
+
+			require.Equal(cert.Certificate, loadedCert.Certificate)
+			require.Equal(cert.Leaf, loadedCert.Leaf)
+			require.Equal(test.signer, loadedCert.PrivateKey)
+		})
+	}
+}
+
 func BenchmarkNewCertAndKeyBytes(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		_, _, err := NewCertAndKeyBytes()
@@ -25,6 +25,9 @@ type Config struct {
 	// ValidatorOnly is enabled.
 	AllowedNodes        set.Set[ids.NodeID] `json:"allowedNodes"        yaml:"allowedNodes"`
 	ConsensusParameters snowball.Parameters `json:"consensusParameters" yaml:"consensusParameters"`
+	// AdaptiveConsensusParameters scales down ConsensusParameters while the
+	// Subnet has fewer than K validators.
+	AdaptiveConsensusParameters bool `json:"adaptiveConsensusParameters" yaml:"adaptiveConsensusParameters"`
 
 	// ProposerMinBlockDelay is the minimum delay this node will enforce when
 	// building a snowman++ block.
@@ -24,14 +24,25 @@ var (
 		TypeNone: func(int64) (Compressor, error) { //nolint:unparam // an error is needed to be returned to compile
 			return NewNoCompressor(), nil
 		},
-		TypeZstd: NewZstdCompressor,
+		TypeZstd:   NewZstdCompressor,
+		TypeSnappy: NewSnappyCompressor,
+		TypeZstdDict: func(maxSize int64) (Compressor, error) {
+			return NewZstdDictCompressor(maxSize, testDictionary)
+		},
 	}
 
+	testDictionary = []byte("a dictionary only needs to contain content that is common across messages")
+
 	//go:embed zstd_zip_bomb.bin
 	zstdZipBomb []byte
 
+	//go:embed snappy_zip_bomb.bin
+	snappyZipBomb []byte
+
 	zipBombs = map[Type][]byte{
-		TypeZstd: zstdZipBomb,
+		TypeZstd:     zstdZipBomb,
+		TypeSnappy:   snappyZipBomb,
+		TypeZstdDict: zstdZipBomb,
 	}
 )
 
@@ -134,6 +145,87 @@ func TestSizeLimiting(t *testing.T) {
 	}
 }
 
+func TestZstdDictCompressor(t *testing.T) {
+	require := require.New(t)
+
+	_, err := NewZstdDictCompressor(maxMessageSize, nil)
+	require.ErrorIs(err, ErrEmptyDictionary)
+
+	dictCompressor, err := NewZstdDictCompressor(maxMessageSize, testDictionary)
+	require.NoError(err)
+	compressor, err := NewZstdCompressor(maxMessageSize)
+	require.NoError(err)
+
+	// Content that is in the dictionary compresses better with it.
+	msg := testDictionary[:len(testDictionary)/2]
+	dictCompressed, err := dictCompressor.Compress(msg)
+	require.NoError(err)
+	compressed, err := compressor.Compress(msg)
+	require.NoError(err)
+	require.Less(len(dictCompressed), len(compressed))
+
+	decompressed, err := dictCompressor.Decompress(dictCompressed)
+	require.NoError(err)
+	require.Equal(msg, decompressed)
+
+	// The dictionary is required to decompress the message.
+	_, err = compressor.Decompress(dictCompressed)
+	require.Error(err) //nolint:forbidigo // the error is returned by the zstd library
+
+	// Messages that compress too well to be decompressed with the digested
+	// dictionary are streamed instead.
+	largeMsg := make([]byte, 3*zstdBulkMinLimit/2)
+	largeCompressed, err := dictCompressor.Compress(largeMsg)
+	require.NoError(err)
+	header, err := parseZstdFrameHeader(largeCompressed)
+	require.NoError(err)
+	require.True(header.hasContentSize)
+	require.Equal(uint64(len(largeMsg)), header.contentSize)
+	decompressed, err = dictCompressor.Decompress(largeCompressed)
+	require.NoError(err)
+	require.Equal(largeMsg, decompressed)
+
+	// Frames that declare a size larger than the max size are rejected before
+	// being decompressed.
+	smallDictCompressor, err := NewZstdDictCompressor(int64(len(largeMsg)-1), testDictionary)
+	require.NoError(err)
+	_, err = smallDictCompressor.Decompress(largeCompressed)
+	require.ErrorIs(err, ErrDecompressedMsgTooLarge)
+}
+
+func TestZstdDictionaryIDs(t *testing.T) {
+	require := require.New(t)
+
+	// Raw content dictionaries don't have a header.
+	_, err := ZstdDictionaryID(testDictionary)
+	require.ErrorIs(err, ErrInvalidDictionary)
+
+	id, err := ZstdDictionaryID([]byte{0x37, 0xa4, 0x30, 0xec, 0x78, 0x56, 0x34, 0x12})
+	require.NoError(err)
+	require.Equal(uint32(0x12345678), id)
+
+	_, err = ZstdFrameDictionaryID([]byte("not a zstd frame"))
+	require.ErrorIs(err, ErrInvalidFrame)
+
+	// Single segment frame with a 2 byte dictionary ID
+	id, err = ZstdFrameDictionaryID([]byte{0x28, 0xb5, 0x2f, 0xfd, 0x22, 0x34, 0x12})
+	require.NoError(err)
+	require.Equal(uint32(0x1234), id)
+
+	// Truncated dictionary ID
+	_, err = ZstdFrameDictionaryID([]byte{0x28, 0xb5, 0x2f, 0xfd, 0x22, 0x34})
+	require.ErrorIs(err, ErrInvalidFrame)
+
+	// Frames compressed without a dictionary don't have a dictionary ID.
+	compressor, err := NewZstdCompressor(maxMessageSize)
+	require.NoError(err)
+	compressed, err := compressor.Compress([]byte("message"))
+	require.NoError(err)
+	id, err = ZstdFrameDictionaryID(compressed)
+	require.NoError(err)
+	require.Zero(id)
+}
+
 // Attempts to create a compressor with math.MaxInt64
 // which leads to undefined decompress behavior due to integer overflow
 // in limit reader creation.
@@ -153,6 +245,14 @@ func FuzzZstdCompressor(f *testing.F) {
 	fuzzHelper(f, TypeZstd)
 }
 
+func FuzzSnappyCompressor(f *testing.F) {
+	fuzzHelper(f, TypeSnappy)
+}
+
+func FuzzZstdDictCompressor(f *testing.F) {
+	fuzzHelper(f, TypeZstdDict)
+}
+
 func fuzzHelper(f *testing.F, compressionType Type) {
 	var (
 		compressor Compressor
@@ -162,6 +262,12 @@ func fuzzHelper(f *testing.F, compressionType Type) {
 	case TypeZstd:
 		compressor, err = NewZstdCompressor(maxMessageSize)
 		require.NoError(f, err)
+	case TypeSnappy:
+		compressor, err = NewSnappyCompressor(maxMessageSize)
+		require.NoError(f, err)
+	case TypeZstdDict:
+		compressor, err = NewZstdDictCompressor(maxMessageSize, testDictionary)
+		require.NoError(f, err)
 	default:
 		require.FailNow(f, "Unknown compression type")
 	}
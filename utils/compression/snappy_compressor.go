@@ -0,0 +1,49 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package compression
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/golang/snappy"
+)
+
+var _ Compressor = (*snappyCompressor)(nil)
+
+// NewSnappyCompressor returns a compressor that trades compression ratio for
+// speed.
+func NewSnappyCompressor(maxSize int64) (Compressor, error) {
+	if maxSize == math.MaxInt64 {
+		return nil, ErrInvalidMaxSizeCompressor
+	}
+
+	return &snappyCompressor{
+		maxSize: maxSize,
+	}, nil
+}
+
+type snappyCompressor struct {
+	maxSize int64
+}
+
+func (s *snappyCompressor) Compress(msg []byte) ([]byte, error) {
+	if int64(len(msg)) > s.maxSize {
+		return nil, fmt.Errorf("%w: (%d) > (%d)", ErrMsgTooLarge, len(msg), s.maxSize)
+	}
+	return snappy.Encode(nil, msg), nil
+}
+
+func (s *snappyCompressor) Decompress(msg []byte) ([]byte, error) {
+	// The decompressed length is encoded in the header, which allows the
+	// message to be rejected before anything is allocated.
+	decompressedLen, err := snappy.DecodedLen(msg)
+	if err != nil {
+		return nil, err
+	}
+	if int64(decompressedLen) > s.maxSize {
+		return nil, fmt.Errorf("%w: (%d) > (%d)", ErrDecompressedMsgTooLarge, decompressedLen, s.maxSize)
+	}
+	return snappy.Decode(nil, msg)
+}
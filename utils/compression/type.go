@@ -15,6 +15,8 @@ type Type byte
 const (
 	TypeNone Type = iota + 1
 	TypeZstd
+	TypeSnappy
+	TypeZstdDict
 )
 
 func (t Type) String() string {
@@ -23,6 +25,10 @@ func (t Type) String() string {
 		return "none"
 	case TypeZstd:
 		return "zstd"
+	case TypeSnappy:
+		return "snappy"
+	case TypeZstdDict:
+		return "zstd-dict"
 	default:
 		return "unknown"
 	}
@@ -34,6 +40,10 @@ func TypeFromString(s string) (Type, error) {
 		return TypeNone, nil
 	case TypeZstd.String():
 		return TypeZstd, nil
+	case TypeSnappy.String():
+		return TypeSnappy, nil
+	case TypeZstdDict.String():
+		return TypeZstdDict, nil
 	default:
 		return TypeNone, errUnknownCompressionType
 	}
@@ -5,10 +5,12 @@ package compression
 
 import (
 	"bytes"
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"io"
 	"math"
+	"runtime"
 
 	"github.com/DataDog/zstd"
 )
@@ -19,6 +21,25 @@ var (
 	ErrInvalidMaxSizeCompressor = errors.New("invalid compressor max size")
 	ErrDecompressedMsgTooLarge  = errors.New("decompressed msg too large")
 	ErrMsgTooLarge              = errors.New("msg too large to be compressed")
+	ErrEmptyDictionary          = errors.New("empty compression dictionary")
+	ErrInvalidDictionary        = errors.New("invalid zstd dictionary")
+	ErrInvalidFrame             = errors.New("invalid zstd frame")
+)
+
+const (
+	zstdDictionaryMagic = 0xEC30A437
+	zstdFrameMagic      = 0xFD2FB528
+
+	// Bits of the zstd frame header descriptor
+	zstdContentSizeFlagShift = 6
+	zstdSingleSegmentFlag    = 1 << 5
+	zstdDictionaryIDFlag     = 0b11
+
+	// The zstd library decompresses a frame with a digested dictionary into a
+	// buffer of at most max(10 * compressed size, 1MB) bytes, so frames that
+	// decompress to more than that are decompressed by streaming.
+	zstdBulkRatio    = 10
+	zstdBulkMinLimit = 1000 * 1000
 )
 
 func NewZstdCompressor(maxSize int64) (Compressor, error) {
@@ -35,19 +56,74 @@ func NewZstdCompressor(maxSize int64) (Compressor, error) {
 	}, nil
 }
 
+// NewZstdDictCompressor returns a zstd compressor that primes both compression
+// and decompression with [dictionary]. Messages compressed with a dictionary
+// can only be decompressed with the same dictionary.
+func NewZstdDictCompressor(maxSize int64, dictionary []byte) (Compressor, error) {
+	if maxSize == math.MaxInt64 {
+		return nil, ErrInvalidMaxSizeCompressor
+	}
+	if len(dictionary) == 0 {
+		return nil, ErrEmptyDictionary
+	}
+
+	// The processor digests the dictionary once so that it doesn't need to be
+	// loaded for every compressed or decompressed message.
+	processor, err := zstd.NewBulkProcessor(dictionary, zstd.DefaultCompression)
+	if err != nil {
+		return nil, err
+	}
+	return &zstdCompressor{
+		maxSize:    maxSize,
+		dictionary: dictionary,
+		processor:  processor,
+	}, nil
+}
+
 type zstdCompressor struct {
 	maxSize int64
+
+	// dictionary and processor are only set if this compressor uses a
+	// dictionary.
+	dictionary []byte
+	processor  *zstd.BulkProcessor
 }
 
 func (z *zstdCompressor) Compress(msg []byte) ([]byte, error) {
 	if int64(len(msg)) > z.maxSize {
 		return nil, fmt.Errorf("%w: (%d) > (%d)", ErrMsgTooLarge, len(msg), z.maxSize)
 	}
+	if z.processor != nil {
+		compressed, err := z.processor.Compress(nil, msg)
+		// The processor's finalizer frees the digested dictionary, so the
+		// processor must be reachable until the cgo call returns.
+		runtime.KeepAlive(z.processor)
+		return compressed, err
+	}
 	return zstd.Compress(nil, msg)
 }
 
 func (z *zstdCompressor) Decompress(msg []byte) ([]byte, error) {
-	reader := zstd.NewReader(bytes.NewReader(msg))
+	if z.processor != nil {
+		// The processor doesn't bound the size of the decompressed message by
+		// itself, so it is only used if the frame header declares a size that
+		// is within the limits.
+		header, err := parseZstdFrameHeader(msg)
+		if err == nil && header.hasContentSize {
+			if header.contentSize > uint64(z.maxSize) {
+				return nil, fmt.Errorf("%w: (%d) > (%d)", ErrDecompressedMsgTooLarge, header.contentSize, z.maxSize)
+			}
+			if header.contentSize > 0 && header.contentSize <= uint64(max(zstdBulkRatio*len(msg), zstdBulkMinLimit)) {
+				decompressed, err := z.processor.Decompress(nil, msg)
+				// The processor's finalizer frees the digested dictionary, so
+				// the processor must be reachable until the cgo call returns.
+				runtime.KeepAlive(z.processor)
+				return decompressed, err
+			}
+		}
+	}
+
+	reader := zstd.NewReaderDict(bytes.NewReader(msg), z.dictionary)
 	defer reader.Close()
 
 	// We allow [io.LimitReader] to read up to [z.maxSize + 1] bytes, so that if
@@ -63,3 +139,73 @@ func (z *zstdCompressor) Decompress(msg []byte) ([]byte, error) {
 	}
 	return decompressed, nil
 }
+
+// ZstdDictionaryID returns the ID written in the header of [dictionary].
+func ZstdDictionaryID(dictionary []byte) (uint32, error) {
+	if len(dictionary) < 8 || binary.LittleEndian.Uint32(dictionary) != zstdDictionaryMagic {
+		return 0, ErrInvalidDictionary
+	}
+	return binary.LittleEndian.Uint32(dictionary[4:]), nil
+}
+
+// ZstdFrameDictionaryID returns the ID of the dictionary that [frame] was
+// compressed with, as written in the zstd frame header. If the frame header
+// doesn't include a dictionary ID, 0 is returned.
+func ZstdFrameDictionaryID(frame []byte) (uint32, error) {
+	header, err := parseZstdFrameHeader(frame)
+	return header.dictionaryID, err
+}
+
+type zstdFrameHeader struct {
+	dictionaryID   uint32
+	hasContentSize bool
+	contentSize    uint64
+}
+
+func parseZstdFrameHeader(frame []byte) (zstdFrameHeader, error) {
+	if len(frame) < 5 || binary.LittleEndian.Uint32(frame) != zstdFrameMagic {
+		return zstdFrameHeader{}, ErrInvalidFrame
+	}
+
+	descriptor := frame[4]
+	offset := 5
+	singleSegment := descriptor&zstdSingleSegmentFlag != 0
+	if !singleSegment {
+		// Skip the window descriptor
+		offset++
+	}
+
+	var header zstdFrameHeader
+	dictionaryIDSize := [4]int{0, 1, 2, 4}[descriptor&zstdDictionaryIDFlag]
+	if len(frame) < offset+dictionaryIDSize {
+		return zstdFrameHeader{}, ErrInvalidFrame
+	}
+	header.dictionaryID = uint32(readLittleEndian(frame[offset : offset+dictionaryIDSize]))
+	offset += dictionaryIDSize
+
+	contentSizeFlag := descriptor >> zstdContentSizeFlagShift
+	contentSizeSize := [4]int{0, 2, 4, 8}[contentSizeFlag]
+	if contentSizeFlag == 0 && singleSegment {
+		contentSizeSize = 1
+	}
+	if contentSizeSize == 0 || len(frame) < offset+contentSizeSize {
+		// Either the size isn't declared or the frame is truncated, in which
+		// case decompressing it fails.
+		return header, nil
+	}
+	header.hasContentSize = true
+	header.contentSize = readLittleEndian(frame[offset : offset+contentSizeSize])
+	if contentSizeSize == 2 {
+		// 2 byte sizes are offset by 256
+		header.contentSize += 256
+	}
+	return header, nil
+}
+
+func readLittleEndian(b []byte) uint64 {
+	var v uint64
+	for i := len(b) - 1; i >= 0; i-- {
+		v = v<<8 | uint64(b[i])
+	}
+	return v
+}
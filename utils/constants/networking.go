@@ -61,6 +61,17 @@ const (
 	// a timeout of 0 should generally not be provided.
 	DefaultNetworkTCPProxyReadTimeout = 3 * time.Second
 
+	// Reputation
+	DefaultNetworkReputationHalfLife            = time.Hour
+	DefaultNetworkReputationSampleThreshold     = 0
+	DefaultNetworkReputationDisconnectThreshold = 0
+	DefaultNetworkReputationUpdateFrequency     = time.Minute
+
+	// Message capture
+	DefaultNetworkCaptureMaxSize         = 256 // megabytes
+	DefaultNetworkCaptureMaxFiles        = 8
+	DefaultNetworkCaptureCompressEnabled = true
+
 	// Benchlist
 	DefaultBenchlistFailThreshold      = 10
 	DefaultBenchlistDuration           = 15 * time.Minute
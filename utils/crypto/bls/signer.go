@@ -0,0 +1,43 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package bls
+
+var _ Signer = (*LocalSigner)(nil)
+
+// Signer signs messages with a BLS key. Implementations are not required to
+// hold the secret key in memory.
+type Signer interface {
+	// PublicKey returns the public key of the signer.
+	PublicKey() *PublicKey
+	// Sign returns a signature over [msg].
+	Sign(msg []byte) (*Signature, error)
+	// SignProofOfPossession returns a proof of possession signature over
+	// [msg].
+	SignProofOfPossession(msg []byte) (*Signature, error)
+}
+
+// LocalSigner signs messages with a secret key held in memory.
+type LocalSigner struct {
+	sk *SecretKey
+	pk *PublicKey
+}
+
+func NewLocalSigner(sk *SecretKey) *LocalSigner {
+	return &LocalSigner{
+		sk: sk,
+		pk: PublicFromSecretKey(sk),
+	}
+}
+
+func (s *LocalSigner) PublicKey() *PublicKey {
+	return s.pk
+}
+
+func (s *LocalSigner) Sign(msg []byte) (*Signature, error) {
+	return Sign(s.sk, msg), nil
+}
+
+func (s *LocalSigner) SignProofOfPossession(msg []byte) (*Signature, error) {
+	return SignProofOfPossession(s.sk, msg), nil
+}
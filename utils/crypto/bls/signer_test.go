@@ -0,0 +1,35 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package bls
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/utils"
+)
+
+func TestLocalSigner(t *testing.T) {
+	require := require.New(t)
+
+	sk, err := NewSecretKey()
+	require.NoError(err)
+
+	signer := NewLocalSigner(sk)
+	pk := signer.PublicKey()
+	require.Equal(PublicFromSecretKey(sk), pk)
+
+	msg := utils.RandomBytes(1234)
+
+	sig, err := signer.Sign(msg)
+	require.NoError(err)
+	require.Equal(Sign(sk, msg), sig)
+	require.True(Verify(pk, sig, msg))
+
+	sig, err = signer.SignProofOfPossession(msg)
+	require.NoError(err)
+	require.Equal(SignProofOfPossession(sk, msg), sig)
+	require.True(VerifyProofOfPossession(pk, sig, msg))
+}
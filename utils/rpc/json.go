@@ -6,13 +6,24 @@ package rpc
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
 
 	rpc "github.com/gorilla/rpc/v2/json2"
 )
 
+// missingMethodPrefix prefixes the error message that the server returns when
+// a request is made to a service or method that it doesn't register.
+const missingMethodPrefix = "rpc: can't find"
+
+// ErrMethodNotFound is returned when the server doesn't support the requested
+// method. This allows clients to fall back to older APIs when talking to nodes
+// running an older version.
+var ErrMethodNotFound = errors.New("method not found")
+
 func SendJSONRequest(
 	ctx context.Context,
 	uri *url.URL,
@@ -57,6 +68,10 @@ func SendJSONRequest(
 	if err := rpc.DecodeClientResponse(resp.Body, reply); err != nil {
 		// Drop any error during close to report the original error
 		_ = resp.Body.Close()
+		var rpcErr *rpc.Error
+		if errors.As(err, &rpcErr) && strings.HasPrefix(rpcErr.Message, missingMethodPrefix) {
+			return fmt.Errorf("%w: %w", ErrMethodNotFound, err)
+		}
 		return fmt.Errorf("failed to decode client response: %w", err)
 	}
 	return resp.Body.Close()
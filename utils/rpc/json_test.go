@@ -0,0 +1,97 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package rpc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/gorilla/rpc/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/utils/json"
+)
+
+var errTest = errors.New("non-nil error")
+
+// TestService is exported so that it can be registered with the rpc server.
+type TestService struct{}
+
+type TestReply struct {
+	Value string `json:"value"`
+}
+
+func (*TestService) Echo(_ *http.Request, args *TestReply, reply *TestReply) error {
+	reply.Value = args.Value
+	return nil
+}
+
+func (*TestService) Fail(_ *http.Request, _ *struct{}, _ *TestReply) error {
+	return errTest
+}
+
+func TestSendJSONRequest(t *testing.T) {
+	server := rpc.NewServer()
+	server.RegisterCodec(json.NewCodec(), "application/json")
+	require.NoError(t, server.RegisterService(&TestService{}, "test"))
+
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+
+	uri, err := url.Parse(httpServer.URL)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		method        string
+		expectedReply string
+		expectedErr   error
+	}{
+		{
+			name:          "success",
+			method:        "test.echo",
+			expectedReply: "hello",
+		},
+		{
+			name:        "missing method",
+			method:      "test.missing",
+			expectedErr: ErrMethodNotFound,
+		},
+		{
+			name:        "missing service",
+			method:      "missing.echo",
+			expectedErr: ErrMethodNotFound,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			reply := &TestReply{}
+			err := SendJSONRequest(
+				context.Background(),
+				uri,
+				test.method,
+				&TestReply{Value: "hello"},
+				reply,
+			)
+			require.ErrorIs(t, err, test.expectedErr)
+			require.Equal(t, test.expectedReply, reply.Value)
+		})
+	}
+
+	t.Run("service error", func(t *testing.T) {
+		err := SendJSONRequest(
+			context.Background(),
+			uri,
+			"test.fail",
+			struct{}{},
+			&TestReply{},
+		)
+		require.Error(t, err) //nolint:forbidigo // the error is not exposed by the client
+		require.NotErrorIs(t, err, ErrMethodNotFound)
+	})
+}
@@ -43,6 +43,11 @@ type Manager interface {
 	// preferred state. This should *not* be used to verify transactions in a block.
 	VerifyTx(tx *txs.Tx) error
 
+	// SimulateTx verifies and executes the transaction on top of the last
+	// accepted state, in the same way as VerifyTx, and reports the changes the
+	// transaction would make. The last accepted state is never modified.
+	SimulateTx(tx *txs.Tx) (*TxSimulation, error)
+
 	// VerifyUniqueInputs returns nil iff no blocks in the inclusive
 	// ancestry of [blkID] consume an input in [inputs].
 	VerifyUniqueInputs(blkID ids.ID, inputs set.Set[ids.ID]) error
@@ -177,6 +182,47 @@ func (m *manager) VerifyTx(tx *txs.Tx) error {
 	return tx.Unsigned.Visit(executor)
 }
 
+func (m *manager) SimulateTx(tx *txs.Tx) (*TxSimulation, error) {
+	if !m.backend.Bootstrapped {
+		return nil, ErrChainNotSynced
+	}
+
+	simulation := &TxSimulation{}
+	simulation.Err = tx.Unsigned.Visit(&executor.SyntacticVerifier{
+		Backend: m.backend,
+		Tx:      tx,
+	})
+	if simulation.Err != nil {
+		return simulation, nil
+	}
+
+	stateDiff, err := state.NewDiff(m.lastAccepted, m)
+	if err != nil {
+		return nil, err
+	}
+
+	simulation.Err = tx.Unsigned.Visit(&executor.SemanticVerifier{
+		Backend: m.backend,
+		State:   stateDiff,
+		Tx:      tx,
+	})
+	if simulation.Err != nil {
+		return simulation, nil
+	}
+
+	txExecutor := &executor.Executor{
+		Codec: m.backend.Codec,
+		State: &simulationChain{
+			Chain:      stateDiff,
+			simulation: simulation,
+		},
+		Tx: tx,
+	}
+	simulation.Err = tx.Unsigned.Visit(txExecutor)
+	simulation.AtomicRequests = txExecutor.AtomicRequests
+	return simulation, nil
+}
+
 func (m *manager) VerifyUniqueInputs(blkID ids.ID, inputs set.Set[ids.ID]) error {
 	if inputs.Len() == 0 {
 		return nil
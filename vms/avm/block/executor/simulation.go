@@ -0,0 +1,48 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package executor
+
+import (
+	"github.com/ava-labs/avalanchego/chains/atomic"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/avm/state"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+)
+
+var _ state.Chain = (*simulationChain)(nil)
+
+// TxSimulation is the result of executing a transaction on top of the last
+// accepted state.
+type TxSimulation struct {
+	// Consumed are the UTXOs of this chain that the transaction spends.
+	Consumed []*avax.UTXO
+	// Produced are the UTXOs that the transaction adds to this chain.
+	Produced []*avax.UTXO
+	// AtomicRequests are the shared memory operations of the transaction,
+	// keyed by the peer chainID. May be nil.
+	AtomicRequests map[ids.ID]*atomic.Requests
+	// Err is the error returned when verifying or executing the transaction.
+	// If Err is non-nil, the transaction can't be issued and the other fields
+	// may be incomplete.
+	Err error
+}
+
+// simulationChain records the UTXO modifications made to a chain into a
+// simulation.
+type simulationChain struct {
+	state.Chain
+	simulation *TxSimulation
+}
+
+func (c *simulationChain) AddUTXO(utxo *avax.UTXO) {
+	c.simulation.Produced = append(c.simulation.Produced, utxo)
+	c.Chain.AddUTXO(utxo)
+}
+
+func (c *simulationChain) DeleteUTXO(utxoID ids.ID) {
+	if utxo, err := c.Chain.GetUTXO(utxoID); err == nil {
+		c.simulation.Consumed = append(c.simulation.Consumed, utxo)
+	}
+	c.Chain.DeleteUTXO(utxoID)
+}
@@ -30,6 +30,9 @@ type Client interface {
 	GetBlockByHeight(ctx context.Context, height uint64, options ...rpc.Option) ([]byte, error)
 	// GetHeight returns the height of the last accepted block.
 	GetHeight(ctx context.Context, options ...rpc.Option) (uint64, error)
+	// SimulateTx executes the transaction on top of the last accepted state
+	// without issuing it and returns the changes it would make
+	SimulateTx(ctx context.Context, tx []byte, options ...rpc.Option) (*SimulateTxReply, error)
 	// GetTxStatus returns the status of [txID]
 	//
 	// Deprecated: GetTxStatus only returns Accepted or Unknown, GetTx should be
@@ -277,6 +280,20 @@ func (c *client) IssueTx(ctx context.Context, txBytes []byte, options ...rpc.Opt
 	return res.TxID, err
 }
 
+func (c *client) SimulateTx(ctx context.Context, txBytes []byte, options ...rpc.Option) (*SimulateTxReply, error) {
+	txStr, err := formatting.Encode(formatting.Hex, txBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &SimulateTxReply{}
+	err = c.requester.SendRequest(ctx, "avm.simulateTx", &api.FormattedTx{
+		Tx:       txStr,
+		Encoding: formatting.Hex,
+	}, res, options...)
+	return res, err
+}
+
 func (c *client) GetTxStatus(ctx context.Context, txID ids.ID, options ...rpc.Option) (choices.Status, error) {
 	res := &GetTxStatusReply{}
 	err := c.requester.SendRequest(ctx, "avm.getTxStatus", &api.JSONTxID{
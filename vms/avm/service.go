@@ -217,6 +217,172 @@ func (s *Service) IssueTx(_ *http.Request, args *api.FormattedTx, reply *api.JSO
 	return err
 }
 
+// SimulationError describes why a simulated transaction would be rejected.
+type SimulationError struct {
+	// Message is the complete verification error.
+	Message string `json:"message"`
+	// Reason is the underlying verification failure, if it is known.
+	Reason string `json:"reason,omitempty"`
+}
+
+// SimulateTxReply is the response from SimulateTx
+type SimulateTxReply struct {
+	TxID ids.ID `json:"txID"`
+	// ConsumedUTXOs are the UTXOs spent by the tx, including imported UTXOs.
+	ConsumedUTXOs []string `json:"consumedUTXOs"`
+	// ProducedUTXOs are the UTXOs created by the tx, including exported UTXOs.
+	ProducedUTXOs []string            `json:"producedUTXOs"`
+	Burned        avajson.Uint64      `json:"burned"`
+	Encoding      formatting.Encoding `json:"encoding"`
+	// Error is set if the tx would be rejected, in which case the other fields
+	// are empty.
+	Error *SimulationError `json:"error,omitempty"`
+}
+
+// simulationErrorReasons are the verification failures reported as the reason
+// of a simulation error. More specific errors are listed first.
+var simulationErrorReasons = []error{
+	secp256k1fx.ErrWrongSig,
+	secp256k1fx.ErrTooFewSigners,
+	secp256k1fx.ErrTooManySigners,
+	secp256k1fx.ErrInputCredentialSignersMismatch,
+	secp256k1fx.ErrTimelocked,
+	secp256k1fx.ErrMismatchedAmounts,
+	avax.ErrInsufficientFunds,
+	database.ErrNotFound,
+}
+
+// SimulateTx verifies and executes a transaction on top of the last accepted
+// state, without issuing it, and returns the changes it would make.
+func (s *Service) SimulateTx(_ *http.Request, args *api.FormattedTx, reply *SimulateTxReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "avm"),
+		zap.String("method", "simulateTx"),
+		logging.UserString("tx", args.Tx),
+	)
+
+	txBytes, err := formatting.Decode(args.Encoding, args.Tx)
+	if err != nil {
+		return fmt.Errorf("problem decoding transaction: %w", err)
+	}
+
+	tx, err := s.vm.parser.ParseTx(txBytes)
+	if err != nil {
+		return fmt.Errorf("couldn't parse tx: %w", err)
+	}
+	reply.TxID = tx.ID()
+	reply.Encoding = args.Encoding
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	if s.vm.chainManager == nil {
+		return errNotLinearized
+	}
+	simulation, err := s.vm.chainManager.SimulateTx(tx)
+	if err != nil {
+		return fmt.Errorf("couldn't simulate tx: %w", err)
+	}
+	if simulation.Err != nil {
+		reply.Error = newSimulationError(simulation.Err)
+		return nil
+	}
+
+	codec := s.vm.parser.Codec()
+	consumed := simulation.Consumed
+	produced := simulation.Produced
+	for peerChainID, requests := range simulation.AtomicRequests {
+		imported, err := s.vm.ctx.SharedMemory.Get(peerChainID, requests.RemoveRequests)
+		if err != nil {
+			return fmt.Errorf("couldn't get imported UTXOs: %w", err)
+		}
+		for _, utxoBytes := range imported {
+			utxo := &avax.UTXO{}
+			if _, err := codec.Unmarshal(utxoBytes, utxo); err != nil {
+				return fmt.Errorf("couldn't parse imported UTXO: %w", err)
+			}
+			consumed = append(consumed, utxo)
+		}
+		for _, element := range requests.PutRequests {
+			utxo := &avax.UTXO{}
+			if _, err := codec.Unmarshal(element.Value, utxo); err != nil {
+				return fmt.Errorf("couldn't parse exported UTXO: %w", err)
+			}
+			produced = append(produced, utxo)
+		}
+	}
+
+	consumedAmount, err := sumAmounts(s.vm.feeAssetID, consumed)
+	if err != nil {
+		return fmt.Errorf("couldn't calculate consumed amount: %w", err)
+	}
+	producedAmount, err := sumAmounts(s.vm.feeAssetID, produced)
+	if err != nil {
+		return fmt.Errorf("couldn't calculate produced amount: %w", err)
+	}
+	burned, err := safemath.Sub(consumedAmount, producedAmount)
+	if err != nil {
+		return fmt.Errorf("couldn't calculate burned amount: %w", err)
+	}
+	reply.Burned = avajson.Uint64(burned)
+
+	reply.ConsumedUTXOs, err = s.encodeUTXOs(args.Encoding, consumed)
+	if err != nil {
+		return err
+	}
+	reply.ProducedUTXOs, err = s.encodeUTXOs(args.Encoding, produced)
+	return err
+}
+
+func newSimulationError(err error) *SimulationError {
+	simulationErr := &SimulationError{
+		Message: err.Error(),
+	}
+	for _, reason := range simulationErrorReasons {
+		if errors.Is(err, reason) {
+			simulationErr.Reason = reason.Error()
+			break
+		}
+	}
+	return simulationErr
+}
+
+// sumAmounts returns the total amount of [assetID] held by [utxos].
+func sumAmounts(assetID ids.ID, utxos []*avax.UTXO) (uint64, error) {
+	var (
+		sum uint64
+		err error
+	)
+	for _, utxo := range utxos {
+		if utxo.AssetID() != assetID {
+			continue
+		}
+		if out, ok := utxo.Out.(avax.Amounter); ok {
+			sum, err = safemath.Add64(sum, out.Amount())
+			if err != nil {
+				return 0, err
+			}
+		}
+	}
+	return sum, nil
+}
+
+func (s *Service) encodeUTXOs(encoding formatting.Encoding, utxos []*avax.UTXO) ([]string, error) {
+	codec := s.vm.parser.Codec()
+	encoded := make([]string, len(utxos))
+	for i, utxo := range utxos {
+		b, err := codec.Marshal(txs.CodecVersion, utxo)
+		if err != nil {
+			return nil, fmt.Errorf("problem marshalling UTXO: %w", err)
+		}
+		encoded[i], err = formatting.Encode(encoding, b)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't encode UTXO %s as string: %w", utxo.InputID(), err)
+		}
+	}
+	return encoded, nil
+}
+
 // GetTxStatusReply defines the GetTxStatus replies returned from the API
 type GetTxStatusReply struct {
 	Status choices.Status `json:"status"`
@@ -70,6 +70,52 @@ func TestServiceIssueTx(t *testing.T) {
 	require.Equal(tx.ID(), txReply.TxID)
 }
 
+func TestServiceSimulateTx(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{
+		fork: latest,
+	})
+	env.vm.ctx.Lock.Unlock()
+
+	defer func() {
+		env.vm.ctx.Lock.Lock()
+		require.NoError(env.vm.Shutdown(context.Background()))
+		env.vm.ctx.Lock.Unlock()
+	}()
+
+	tx := newTx(t, env.genesisBytes, env.vm.ctx.ChainID, env.vm.parser, "AVAX")
+	txStr, err := formatting.Encode(formatting.Hex, tx.Bytes())
+	require.NoError(err)
+	txArgs := &api.FormattedTx{
+		Tx:       txStr,
+		Encoding: formatting.Hex,
+	}
+	reply := &SimulateTxReply{}
+	require.NoError(env.service.SimulateTx(nil, txArgs, reply))
+	require.Nil(reply.Error)
+	require.Equal(tx.ID(), reply.TxID)
+	require.Len(reply.ConsumedUTXOs, 1)
+	require.Empty(reply.ProducedUTXOs)
+	require.Equal(avajson.Uint64(startBalance), reply.Burned)
+
+	// Simulating the tx must not modify the state, so the tx can still be
+	// issued.
+	issueReply := &api.JSONTxID{}
+	require.NoError(env.service.IssueTx(nil, txArgs, issueReply))
+	require.Equal(tx.ID(), issueReply.TxID)
+
+	// Spending a UTXO with the wrong key is reported as a simulation error.
+	tx.Creds = nil
+	require.NoError(tx.SignSECP256K1Fx(env.vm.parser.Codec(), [][]*secp256k1.PrivateKey{{keys[1]}}))
+	txArgs.Tx, err = formatting.Encode(formatting.Hex, tx.Bytes())
+	require.NoError(err)
+	reply = &SimulateTxReply{}
+	require.NoError(env.service.SimulateTx(nil, txArgs, reply))
+	require.NotNil(reply.Error)
+	require.Equal(secp256k1fx.ErrWrongSig.Error(), reply.Error.Reason)
+}
+
 func TestServiceGetTxStatus(t *testing.T) {
 	require := require.New(t)
 
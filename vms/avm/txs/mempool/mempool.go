@@ -40,6 +40,8 @@ func New(
 	}
 	pool := txmempool.New[*txs.Tx](
 		metrics,
+		nil,
+		0,
 	)
 	return &mempool{
 		Mempool:  pool,
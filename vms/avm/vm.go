@@ -26,6 +26,7 @@ import (
 	"github.com/ava-labs/avalanchego/snow/engine/avalanche/vertex"
 	"github.com/ava-labs/avalanchego/snow/engine/common"
 	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/hashing"
 	"github.com/ava-labs/avalanchego/utils/json"
 	"github.com/ava-labs/avalanchego/utils/linked"
 	"github.com/ava-labs/avalanchego/utils/set"
@@ -44,6 +45,7 @@ import (
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 	"github.com/ava-labs/avalanchego/vms/txs/mempool"
 
+	snowmanblock "github.com/ava-labs/avalanchego/snow/engine/snowman/block"
 	blockbuilder "github.com/ava-labs/avalanchego/vms/avm/block/builder"
 	blockexecutor "github.com/ava-labs/avalanchego/vms/avm/block/executor"
 	extensions "github.com/ava-labs/avalanchego/vms/avm/fxs"
@@ -51,7 +53,12 @@ import (
 	xmempool "github.com/ava-labs/avalanchego/vms/avm/txs/mempool"
 )
 
-const assetToFxCacheSize = 1024
+const (
+	assetToFxCacheSize = 1024
+	// preparedBlocksCacheSize is the maximum number of blocks that have been
+	// prepared during bootstrapping, but not yet parsed.
+	preparedBlocksCacheSize = 256
+)
 
 var (
 	errIncompatibleFx            = errors.New("incompatible feature extension")
@@ -59,6 +66,7 @@ var (
 	errGenesisAssetMustHaveState = errors.New("genesis asset must have non-empty state")
 
 	_ vertex.LinearizableVMWithEngine = (*VM)(nil)
+	_ snowmanblock.PipelinedChainVM   = (*VM)(nil)
 )
 
 type VM struct {
@@ -100,6 +108,10 @@ type VM struct {
 	// Asset ID --> Bit set with fx IDs the asset supports
 	assetToFxCache *cache.LRU[ids.ID, set.Bits64]
 
+	// Block ID --> Block
+	// Blocks that were prepared by PrepareBlock, but haven't been parsed yet.
+	preparedBlocks cache.Cacher[ids.ID, block.Block]
+
 	baseDB database.Database
 	db     *versiondb.Database
 
@@ -225,6 +237,9 @@ func (vm *VM) Initialize(
 	if err != nil {
 		return err
 	}
+	vm.preparedBlocks = &cache.LRU[ids.ID, block.Block]{
+		Size: preparedBlocksCacheSize,
+	}
 
 	codec := vm.parser.Codec()
 	vm.Spender = utxo.NewSpender(&vm.clock, codec)
@@ -290,6 +305,9 @@ func (vm *VM) onBootstrapStarted() error {
 }
 
 func (vm *VM) onNormalOperationsStarted() error {
+	// Blocks are only prepared during bootstrapping.
+	vm.preparedBlocks.Flush()
+
 	vm.txBackend.Bootstrapped = true
 	for _, fx := range vm.fxs {
 		if err := fx.Fx.Bootstrapped(); err != nil {
@@ -369,6 +387,14 @@ func (vm *VM) GetBlock(_ context.Context, blkID ids.ID) (snowman.Block, error) {
 }
 
 func (vm *VM) ParseBlock(_ context.Context, blkBytes []byte) (snowman.Block, error) {
+	if vm.preparedBlocks.Len() > 0 {
+		blkID := hashing.ComputeHash256Array(blkBytes)
+		if blk, ok := vm.preparedBlocks.Get(blkID); ok {
+			vm.preparedBlocks.Evict(blkID)
+			return vm.chainManager.NewBlock(blk), nil
+		}
+	}
+
 	blk, err := vm.parser.ParseBlock(blkBytes)
 	if err != nil {
 		return nil, err
@@ -376,6 +402,18 @@ func (vm *VM) ParseBlock(_ context.Context, blkBytes []byte) (snowman.Block, err
 	return vm.chainManager.NewBlock(blk), nil
 }
 
+// PrepareBlock parses [blkBytes], which includes initializing all of its
+// transactions. The prepared block is returned the next time [blkBytes] is
+// parsed.
+func (vm *VM) PrepareBlock(_ context.Context, blkBytes []byte) error {
+	blk, err := vm.parser.ParseBlock(blkBytes)
+	if err != nil {
+		return err
+	}
+	vm.preparedBlocks.Put(blk.ID(), blk)
+	return nil
+}
+
 func (vm *VM) SetPreference(_ context.Context, blkID ids.ID) error {
 	vm.chainManager.SetPreference(blkID)
 	return nil
@@ -15,10 +15,12 @@ import (
 	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/database/memdb"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
 	"github.com/ava-labs/avalanchego/snow/engine/common"
 	"github.com/ava-labs/avalanchego/snow/snowtest"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/vms/avm/block"
 	"github.com/ava-labs/avalanchego/vms/avm/fxs"
 	"github.com/ava-labs/avalanchego/vms/avm/txs"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
@@ -851,3 +853,48 @@ func TestClearForceAcceptedExportTx(t *testing.T) {
 	assertIndexedTX(t, env.vm.db, 0, key.PublicKey().Address(), assetID.AssetID(), tx.ID())
 	assertLatestIdx(t, env.vm.db, key.PublicKey().Address(), assetID.AssetID(), 1)
 }
+
+func TestPrepareBlock(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{
+		fork:            latest,
+		notBootstrapped: true,
+	})
+	defer func() {
+		require.NoError(env.vm.Shutdown(context.Background()))
+		env.vm.ctx.Lock.Unlock()
+	}()
+
+	lastAcceptedID, err := env.vm.LastAccepted(context.Background())
+	require.NoError(err)
+	lastAccepted, err := env.vm.GetBlock(context.Background(), lastAcceptedID)
+	require.NoError(err)
+
+	tx := newTx(t, env.genesisBytes, env.vm.ctx.ChainID, env.vm.parser, "AVAX")
+	blk, err := block.NewStandardBlock(
+		lastAcceptedID,
+		lastAccepted.Height()+1,
+		lastAccepted.Timestamp(),
+		[]*txs.Tx{tx},
+		env.vm.parser.Codec(),
+	)
+	require.NoError(err)
+	blkBytes := blk.Bytes()
+
+	require.NoError(env.vm.PrepareBlock(context.Background(), blkBytes))
+	require.Equal(1, env.vm.preparedBlocks.Len())
+
+	parsedBlk, err := env.vm.ParseBlock(context.Background(), blkBytes)
+	require.NoError(err)
+	require.Equal(blk.ID(), parsedBlk.ID())
+	require.Zero(env.vm.preparedBlocks.Len())
+
+	// Prepared blocks are dropped once the chain is bootstrapped.
+	require.NoError(env.vm.PrepareBlock(context.Background(), blkBytes))
+	require.NoError(env.vm.SetState(context.Background(), snow.NormalOp))
+	require.Zero(env.vm.preparedBlocks.Len())
+
+	err = env.vm.PrepareBlock(context.Background(), []byte{1, 2, 3})
+	require.ErrorIs(err, codec.ErrUnknownVersion)
+}
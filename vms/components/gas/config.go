@@ -0,0 +1,54 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package gas
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	errNoMinPrice                 = errors.New("minimum gas price must be non-zero")
+	errNoExcessConversionConstant = errors.New("excess conversion constant must be non-zero")
+	errTargetExceedsMax           = errors.New("target gas per block exceeds the maximum gas per block")
+)
+
+type Config struct {
+	// Weights to merge fee dimensions into a single gas value.
+	Weights Dimensions `json:"weights"`
+
+	// Maximum amount of gas that a single block is allowed to consume.
+	MaxGasPerBlock Gas `json:"maxGasPerBlock"`
+
+	// Amount of gas per block that the fee market targets. Blocks consuming
+	// more than this amount increase the gas price, blocks consuming less
+	// decrease it.
+	TargetGasPerBlock Gas `json:"targetGasPerBlock"`
+
+	// Minimum price of a unit of gas.
+	MinPrice Price `json:"minPrice"`
+
+	// Constant used to convert excess gas into a gas price. A larger value
+	// results in a slower price response to sustained congestion.
+	ExcessConversionConstant Gas `json:"excessConversionConstant"`
+}
+
+// Verify returns an error if the config would result in an unusable fee
+// market.
+func (c *Config) Verify() error {
+	switch {
+	case c.MinPrice == 0:
+		return errNoMinPrice
+	case c.ExcessConversionConstant == 0:
+		return errNoExcessConversionConstant
+	case c.TargetGasPerBlock > c.MaxGasPerBlock:
+		return fmt.Errorf("%w: %d > %d",
+			errTargetExceedsMax,
+			c.TargetGasPerBlock,
+			c.MaxGasPerBlock,
+		)
+	default:
+		return nil
+	}
+}
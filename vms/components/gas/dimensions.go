@@ -0,0 +1,71 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package gas
+
+import "github.com/ava-labs/avalanchego/utils/math"
+
+const (
+	Bandwidth Dimension = iota
+	DBRead
+	DBWrite // includes deletes
+	Compute
+
+	NumDimensions = iota
+)
+
+// Dimension is a resource that is consumed by processing a transaction.
+type Dimension uint
+
+func (d Dimension) String() string {
+	switch d {
+	case Bandwidth:
+		return "bandwidth"
+	case DBRead:
+		return "dbRead"
+	case DBWrite:
+		return "dbWrite"
+	case Compute:
+		return "compute"
+	default:
+		return "unknown"
+	}
+}
+
+// Dimensions is the amount of each resource that is consumed.
+type Dimensions [NumDimensions]uint64
+
+// Add returns d + sum(os...).
+//
+// If overflow occurs, an error is returned.
+func (d Dimensions) Add(os ...*Dimensions) (Dimensions, error) {
+	var err error
+	for _, o := range os {
+		for i := range o {
+			d[i], err = math.Add64(d[i], o[i])
+			if err != nil {
+				return d, err
+			}
+		}
+	}
+	return d, nil
+}
+
+// ToGas returns the gas consumed by d, where each dimension is weighted by the
+// corresponding entry in [weights].
+//
+// If overflow occurs, an error is returned.
+func (d Dimensions) ToGas(weights Dimensions) (Gas, error) {
+	var res uint64
+	for i := range d {
+		v, err := math.Mul64(d[i], weights[i])
+		if err != nil {
+			return 0, err
+		}
+		res, err = math.Add64(res, v)
+		if err != nil {
+			return 0, err
+		}
+	}
+	return Gas(res), nil
+}
@@ -0,0 +1,43 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package gas
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	safemath "github.com/ava-labs/avalanchego/utils/math"
+)
+
+func TestDimensionsAdd(t *testing.T) {
+	require := require.New(t)
+
+	d := Dimensions{1, 2, 3, 4}
+	sum, err := d.Add(&Dimensions{10, 20, 30, 40}, &Dimensions{100, 200, 300, 400})
+	require.NoError(err)
+	require.Equal(Dimensions{111, 222, 333, 444}, sum)
+
+	_, err = d.Add(&Dimensions{math.MaxUint64})
+	require.ErrorIs(err, safemath.ErrOverflow)
+}
+
+func TestDimensionsToGas(t *testing.T) {
+	require := require.New(t)
+
+	d := Dimensions{1, 2, 3, 4}
+	gas, err := d.ToGas(Dimensions{1000, 100, 10, 1})
+	require.NoError(err)
+	require.Equal(Gas(1234), gas)
+
+	_, err = d.ToGas(Dimensions{math.MaxUint64})
+	require.NoError(err)
+
+	_, err = d.ToGas(Dimensions{0, math.MaxUint64})
+	require.ErrorIs(err, safemath.ErrOverflow)
+
+	_, err = d.ToGas(Dimensions{math.MaxUint64, 1})
+	require.ErrorIs(err, safemath.ErrOverflow)
+}
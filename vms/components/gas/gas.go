@@ -0,0 +1,83 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package gas
+
+import (
+	"math"
+	"math/big"
+
+	safemath "github.com/ava-labs/avalanchego/utils/math"
+)
+
+var maxUint64 = new(big.Int).SetUint64(math.MaxUint64)
+
+type (
+	// Gas is the weighted amount of resources consumed.
+	Gas uint64
+
+	// Price is the amount of nAVAX charged per unit of Gas.
+	Price uint64
+)
+
+// Cost returns the amount of nAVAX required to pay for [g] units of gas at
+// [price].
+//
+// If overflow occurs, an error is returned.
+func (g Gas) Cost(price Price) (uint64, error) {
+	return safemath.Mul64(uint64(g), uint64(price))
+}
+
+// CalculatePrice returns the gas price given the minimum gas price, the
+// excess gas, and the excess conversion constant.
+//
+// It is defined as an approximation of:
+//
+//	minPrice * e^(excess / excessConversionConstant)
+//
+// This implements the EIP-4844 fake exponential formula:
+//
+//	def fake_exponential(factor: int, numerator: int, denominator: int) -> int:
+//		i = 1
+//		output = 0
+//		numerator_accum = factor * denominator
+//		while numerator_accum > 0:
+//			output += numerator_accum
+//			numerator_accum = (numerator_accum * numerator) // (denominator * i)
+//			i += 1
+//		return output // denominator
+//
+// This implementation is optimized with the knowledge that any value greater
+// than MaxUint64 gets returned as MaxUint64. This means that every intermediate
+// value is guaranteed to be at most MaxUint193. So, we can safely use the
+// big.Int type without worrying about unbounded growth.
+//
+// Invariant: excessConversionConstant > 0.
+func CalculatePrice(
+	minPrice Price,
+	excess Gas,
+	excessConversionConstant Gas,
+) Price {
+	var (
+		numerator   = new(big.Int).SetUint64(uint64(excess))
+		denominator = new(big.Int).SetUint64(uint64(excessConversionConstant))
+
+		i              = new(big.Int).SetUint64(1)
+		output         = new(big.Int)
+		numeratorAccum = new(big.Int).SetUint64(uint64(minPrice))
+		maxOutput      = new(big.Int).Mul(denominator, maxUint64)
+	)
+	numeratorAccum.Mul(numeratorAccum, denominator)
+
+	for numeratorAccum.Sign() > 0 {
+		output.Add(output, numeratorAccum)
+		if output.Cmp(maxOutput) >= 0 {
+			return math.MaxUint64
+		}
+		numeratorAccum.Mul(numeratorAccum, numerator)
+		numeratorAccum.Div(numeratorAccum, denominator)
+		numeratorAccum.Div(numeratorAccum, i)
+		i.Add(i, big.NewInt(1))
+	}
+	return Price(output.Div(output, denominator).Uint64())
+}
@@ -0,0 +1,83 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package gas
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	safemath "github.com/ava-labs/avalanchego/utils/math"
+)
+
+func TestCalculatePrice(t *testing.T) {
+	tests := []struct {
+		minPrice                 Price
+		excess                   Gas
+		excessConversionConstant Gas
+		expected                 Price
+	}{
+		{
+			minPrice:                 1,
+			excess:                   0,
+			excessConversionConstant: 1,
+			expected:                 1,
+		},
+		{
+			minPrice:                 1,
+			excess:                   1,
+			excessConversionConstant: 1,
+			expected:                 2,
+		},
+		{
+			minPrice:                 1_000,
+			excess:                   1_000,
+			excessConversionConstant: 1_000,
+			expected:                 2_718,
+		},
+		{
+			minPrice:                 1_000,
+			excess:                   2_000,
+			excessConversionConstant: 1_000,
+			expected:                 7_389,
+		},
+		{
+			minPrice:                 10,
+			excess:                   10_000,
+			excessConversionConstant: 1_000,
+			expected:                 220_264,
+		},
+		{
+			minPrice:                 math.MaxUint64,
+			excess:                   1,
+			excessConversionConstant: 1,
+			expected:                 math.MaxUint64,
+		},
+		{
+			minPrice:                 1,
+			excess:                   math.MaxUint64,
+			excessConversionConstant: 1,
+			expected:                 math.MaxUint64,
+		},
+	}
+	for _, test := range tests {
+		require.Equal(
+			t,
+			test.expected,
+			CalculatePrice(test.minPrice, test.excess, test.excessConversionConstant),
+		)
+	}
+}
+
+func TestGasCost(t *testing.T) {
+	require := require.New(t)
+
+	cost, err := Gas(10).Cost(5)
+	require.NoError(err)
+	require.Equal(uint64(50), cost)
+
+	_, err = Gas(math.MaxUint64).Cost(2)
+	require.ErrorIs(err, safemath.ErrOverflow)
+}
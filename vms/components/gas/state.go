@@ -0,0 +1,45 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package gas
+
+import (
+	"math"
+
+	safemath "github.com/ava-labs/avalanchego/utils/math"
+)
+
+// State tracks the gas market across blocks.
+type State struct {
+	// Excess is the amount of gas consumed above the per-block target that has
+	// not yet been offset by blocks consuming less than the target.
+	Excess Gas `json:"excess"`
+}
+
+// AdvanceBlock returns the state after a block that consumed [consumed] gas
+// has been accepted, given a per-block [target].
+//
+// Excess increases by the amount consumed above the target and decreases by
+// the amount consumed below the target. Excess never underflows below zero
+// and saturates at MaxUint64.
+func (s State) AdvanceBlock(target, consumed Gas) State {
+	excess, err := safemath.Add64(uint64(s.Excess), uint64(consumed))
+	if err != nil {
+		excess = math.MaxUint64
+	}
+	if excess < uint64(target) {
+		return State{}
+	}
+	return State{
+		Excess: Gas(excess - uint64(target)),
+	}
+}
+
+// Price returns the gas price implied by this state under [config].
+func (s State) Price(config Config) Price {
+	return CalculatePrice(
+		config.MinPrice,
+		s.Excess,
+		config.ExcessConversionConstant,
+	)
+}
@@ -0,0 +1,110 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package gas
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStateAdvanceBlock(t *testing.T) {
+	tests := []struct {
+		name     string
+		initial  State
+		target   Gas
+		consumed Gas
+		expected State
+	}{
+		{
+			name:     "consumed at target",
+			initial:  State{Excess: 10},
+			target:   100,
+			consumed: 100,
+			expected: State{Excess: 10},
+		},
+		{
+			name:     "consumed above target",
+			initial:  State{Excess: 10},
+			target:   100,
+			consumed: 150,
+			expected: State{Excess: 60},
+		},
+		{
+			name:     "consumed below target",
+			initial:  State{Excess: 10},
+			target:   100,
+			consumed: 95,
+			expected: State{Excess: 5},
+		},
+		{
+			name:     "excess floors at zero",
+			initial:  State{Excess: 10},
+			target:   100,
+			consumed: 0,
+			expected: State{},
+		},
+		{
+			name:     "excess saturates",
+			initial:  State{Excess: math.MaxUint64},
+			target:   0,
+			consumed: 1,
+			expected: State{Excess: math.MaxUint64},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, test.initial.AdvanceBlock(test.target, test.consumed))
+		})
+	}
+}
+
+func TestConfigVerify(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      Config
+		expectedErr error
+	}{
+		{
+			name: "valid",
+			config: Config{
+				MaxGasPerBlock:           10,
+				TargetGasPerBlock:        5,
+				MinPrice:                 1,
+				ExcessConversionConstant: 1,
+			},
+		},
+		{
+			name: "no min price",
+			config: Config{
+				ExcessConversionConstant: 1,
+			},
+			expectedErr: errNoMinPrice,
+		},
+		{
+			name: "no excess conversion constant",
+			config: Config{
+				MinPrice: 1,
+			},
+			expectedErr: errNoExcessConversionConstant,
+		},
+		{
+			name: "target exceeds max",
+			config: Config{
+				MaxGasPerBlock:           5,
+				TargetGasPerBlock:        10,
+				MinPrice:                 1,
+				ExcessConversionConstant: 1,
+			},
+			expectedErr: errTargetExceedsMax,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.config.Verify()
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
@@ -42,13 +42,17 @@ type blockMetrics struct {
 	parseStateSummary,
 	parseStateSummaryErr,
 	getStateSummary,
-	getStateSummaryErr metric.Averager
+	getStateSummaryErr,
+	// Pipelined metrics
+	prepareBlock,
+	prepareBlockErr metric.Averager
 }
 
 func (m *blockMetrics) Initialize(
 	supportsBlockBuildingWithContext bool,
 	supportsBatchedFetching bool,
 	supportsStateSync bool,
+	supportsPipelining bool,
 	namespace string,
 	reg prometheus.Registerer,
 ) error {
@@ -87,5 +91,9 @@ func (m *blockMetrics) Initialize(
 		m.getStateSummary = newAverager(namespace, "get_state_summary", reg, &errs)
 		m.getStateSummaryErr = newAverager(namespace, "get_state_summary_err", reg, &errs)
 	}
+	if supportsPipelining {
+		m.prepareBlock = newAverager(namespace, "prepare_block", reg, &errs)
+		m.prepareBlockErr = newAverager(namespace, "prepare_block_err", reg, &errs)
+	}
 	return errs.Err
 }
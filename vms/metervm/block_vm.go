@@ -23,6 +23,7 @@ var (
 	_ block.BuildBlockWithContextChainVM = (*blockVM)(nil)
 	_ block.BatchedChainVM               = (*blockVM)(nil)
 	_ block.StateSyncableVM              = (*blockVM)(nil)
+	_ block.PipelinedChainVM             = (*blockVM)(nil)
 )
 
 type blockVM struct {
@@ -30,6 +31,7 @@ type blockVM struct {
 	buildBlockVM block.BuildBlockWithContextChainVM
 	batchedVM    block.BatchedChainVM
 	ssVM         block.StateSyncableVM
+	pipelinedVM  block.PipelinedChainVM
 
 	blockMetrics
 	clock mockable.Clock
@@ -39,11 +41,13 @@ func NewBlockVM(vm block.ChainVM) block.ChainVM {
 	buildBlockVM, _ := vm.(block.BuildBlockWithContextChainVM)
 	batchedVM, _ := vm.(block.BatchedChainVM)
 	ssVM, _ := vm.(block.StateSyncableVM)
+	pipelinedVM, _ := vm.(block.PipelinedChainVM)
 	return &blockVM{
 		ChainVM:      vm,
 		buildBlockVM: buildBlockVM,
 		batchedVM:    batchedVM,
 		ssVM:         ssVM,
+		pipelinedVM:  pipelinedVM,
 	}
 }
 
@@ -63,6 +67,7 @@ func (vm *blockVM) Initialize(
 		vm.buildBlockVM != nil,
 		vm.batchedVM != nil,
 		vm.ssVM != nil,
+		vm.pipelinedVM != nil,
 		"",
 		registerer,
 	)
@@ -0,0 +1,27 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package metervm
+
+import (
+	"context"
+
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/block"
+)
+
+func (vm *blockVM) PrepareBlock(ctx context.Context, blkBytes []byte) error {
+	if vm.pipelinedVM == nil {
+		return block.ErrPipelinedVMNotImplemented
+	}
+
+	start := vm.clock.Time()
+	err := vm.pipelinedVM.PrepareBlock(ctx, blkBytes)
+	end := vm.clock.Time()
+	duration := float64(end.Sub(start))
+	if err != nil {
+		vm.blockMetrics.prepareBlockErr.Observe(duration)
+		return err
+	}
+	vm.blockMetrics.prepareBlock.Observe(duration)
+	return nil
+}
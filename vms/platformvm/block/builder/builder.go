@@ -18,10 +18,12 @@ import (
 	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/utils/timer/mockable"
 	"github.com/ava-labs/avalanchego/utils/units"
+	"github.com/ava-labs/avalanchego/vms/components/gas"
 	"github.com/ava-labs/avalanchego/vms/platformvm/block"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
 	"github.com/ava-labs/avalanchego/vms/platformvm/status"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs/fee"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs/mempool"
 
 	blockexecutor "github.com/ava-labs/avalanchego/vms/platformvm/block/executor"
@@ -332,62 +334,117 @@ func packBlockTxs(
 	}
 
 	var (
-		blockTxs []*txs.Tx
-		inputs   set.Set[ids.ID]
+		feeCfg       = backend.Config.DynamicFeeConfig
+		isEActive    = backend.Config.UpgradeConfig.IsEActivated(timestamp)
+		remainingGas = feeCfg.MaxGasPerBlock
+		blockTxs     []*txs.Tx
+		inputs       set.Set[ids.ID]
 	)
 
-	for {
-		tx, exists := mempool.Peek()
-		if !exists {
-			break
-		}
-		txSize := len(tx.Bytes())
-		if txSize > remainingSize {
-			break
-		}
-		mempool.Remove(tx)
+	// The mempool iterates over the txs paying the highest fee rate first.
+	// Txs that don't fit into the remaining space of the block are left in the
+	// mempool, and lower paying txs that do fit are included instead.
+	//
+	// Txs can't be removed from the mempool while iterating over it, so the
+	// txs are collected in rounds. Each round stops once the collected txs
+	// could fill the remaining space of the block, so the mempool isn't copied
+	// in its entirety.
+	var skipped set.Set[ids.ID]
+	for remainingSize > 0 && (!isEActive || remainingGas > 0) {
+		var (
+			pendingTxs  []*txs.Tx
+			pendingSize int
+			exhausted   = true
+		)
+		mempool.Iterate(func(tx *txs.Tx) bool {
+			if skipped.Contains(tx.ID()) {
+				return true
+			}
+			txSize := len(tx.Bytes())
+			if txSize > remainingSize {
+				return true
+			}
+			pendingTxs = append(pendingTxs, tx)
+			pendingSize += txSize
+			exhausted = pendingSize < remainingSize
+			return exhausted
+		})
+
+		for _, tx := range pendingTxs {
+			txSize := len(tx.Bytes())
+			if txSize > remainingSize {
+				skipped.Add(tx.ID())
+				continue
+			}
 
-		// Invariant: [tx] has already been syntactically verified.
+			var txGas gas.Gas
+			if isEActive {
+				txGas, err = fee.TxGas(tx.Unsigned, feeCfg.Weights)
+				if err != nil {
+					mempool.Remove(tx)
+					mempool.MarkDropped(tx.ID(), err)
+					continue
+				}
+				if txGas > feeCfg.MaxGasPerBlock {
+					// This tx can never be included into a block.
+					mempool.Remove(tx)
+					mempool.MarkDropped(tx.ID(), blockexecutor.ErrBlockGasLimitExceeded)
+					continue
+				}
+				if txGas > remainingGas {
+					skipped.Add(tx.ID())
+					continue
+				}
+			}
+			mempool.Remove(tx)
 
-		txDiff, err := state.NewDiffOn(stateDiff)
-		if err != nil {
-			return nil, err
-		}
+			// Invariant: [tx] has already been syntactically verified.
 
-		executor := &txexecutor.StandardTxExecutor{
-			Backend: backend,
-			State:   txDiff,
-			Tx:      tx,
-		}
+			txDiff, err := state.NewDiffOn(stateDiff)
+			if err != nil {
+				return nil, err
+			}
 
-		err = tx.Unsigned.Visit(executor)
-		if err != nil {
-			txID := tx.ID()
-			mempool.MarkDropped(txID, err)
-			continue
-		}
+			executor := &txexecutor.StandardTxExecutor{
+				Backend: backend,
+				State:   txDiff,
+				Tx:      tx,
+			}
 
-		if inputs.Overlaps(executor.Inputs) {
-			txID := tx.ID()
-			mempool.MarkDropped(txID, blockexecutor.ErrConflictingBlockTxs)
-			continue
-		}
-		err = manager.VerifyUniqueInputs(parentID, executor.Inputs)
-		if err != nil {
-			txID := tx.ID()
-			mempool.MarkDropped(txID, err)
-			continue
-		}
-		inputs.Union(executor.Inputs)
+			err = tx.Unsigned.Visit(executor)
+			if err != nil {
+				txID := tx.ID()
+				mempool.MarkDropped(txID, err)
+				continue
+			}
 
-		txDiff.AddTx(tx, status.Committed)
-		err = txDiff.Apply(stateDiff)
-		if err != nil {
-			return nil, err
+			if inputs.Overlaps(executor.Inputs) {
+				txID := tx.ID()
+				mempool.MarkDropped(txID, blockexecutor.ErrConflictingBlockTxs)
+				continue
+			}
+			err = manager.VerifyUniqueInputs(parentID, executor.Inputs)
+			if err != nil {
+				txID := tx.ID()
+				mempool.MarkDropped(txID, err)
+				continue
+			}
+			inputs.Union(executor.Inputs)
+
+			txDiff.AddTx(tx, status.Committed)
+			err = txDiff.Apply(stateDiff)
+			if err != nil {
+				return nil, err
+			}
+
+			remainingSize -= txSize
+			remainingGas -= txGas
+			blockTxs = append(blockTxs, tx)
 		}
 
-		remainingSize -= txSize
-		blockTxs = append(blockTxs, tx)
+		if exhausted {
+			break
+		}
 	}
 
 	return blockTxs, nil
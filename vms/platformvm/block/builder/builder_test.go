@@ -19,6 +19,7 @@ import (
 	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
 	"github.com/ava-labs/avalanchego/utils/timer/mockable"
 	"github.com/ava-labs/avalanchego/utils/units"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/platformvm/block"
 	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
 	"github.com/ava-labs/avalanchego/vms/platformvm/signer"
@@ -89,6 +90,83 @@ func TestBuildBlockDoesNotBuildWithEmptyMempool(t *testing.T) {
 	require.Nil(blk)
 }
 
+func TestPackBlockTxsSkipsTxsThatDontFit(t *testing.T) {
+	require := require.New(t)
+
+	env := newEnvironment(t, latestFork)
+	env.ctx.Lock.Lock()
+	defer env.ctx.Lock.Unlock()
+
+	// [largeTx] burns more AVAX per byte than [smallTx], so it is ordered
+	// first in the mempool.
+	largeTx, err := env.txBuilder.NewBaseTx(
+		nil,
+		[]*secp256k1.PrivateKey{preFundedKeys[4]},
+		common.WithMemo(make([]byte, avax.MaxMemoSize)),
+	)
+	require.NoError(err)
+	smallTx, err := env.txBuilder.NewCreateChainTx(
+		testSubnet1.ID(),
+		nil,
+		constants.AVMID,
+		nil,
+		"chain name",
+		[]*secp256k1.PrivateKey{testSubnet1ControlKeys[0], testSubnet1ControlKeys[1]},
+	)
+	require.NoError(err)
+	require.Less(len(smallTx.Bytes()), len(largeTx.Bytes()))
+
+	require.NoError(env.mempool.Add(largeTx))
+	require.NoError(env.mempool.Add(smallTx))
+	firstTx, ok := env.mempool.Peek()
+	require.True(ok)
+	require.Equal(largeTx.ID(), firstTx.ID())
+
+	// Only [smallTx] fits, so [largeTx] is skipped rather than preventing
+	// [smallTx] from being included.
+	blockTxs, err := env.Builder.PackBlockTxs(len(smallTx.Bytes()))
+	require.NoError(err)
+	require.Equal([]*txs.Tx{smallTx}, blockTxs)
+
+	_, ok = env.mempool.Get(largeTx.ID())
+	require.True(ok)
+	_, ok = env.mempool.Get(smallTx.ID())
+	require.False(ok)
+}
+
+func TestPackBlockTxsStopsOnceBlockIsFull(t *testing.T) {
+	require := require.New(t)
+
+	env := newEnvironment(t, latestFork)
+	env.ctx.Lock.Lock()
+	defer env.ctx.Lock.Unlock()
+
+	for _, key := range preFundedKeys[:3] {
+		tx, err := env.txBuilder.NewBaseTx(
+			nil,
+			[]*secp256k1.PrivateKey{key},
+		)
+		require.NoError(err)
+		require.NoError(env.mempool.Add(tx))
+	}
+
+	var orderedTxs []*txs.Tx
+	env.mempool.Iterate(func(tx *txs.Tx) bool {
+		orderedTxs = append(orderedTxs, tx)
+		return true
+	})
+	require.Len(orderedTxs, 3)
+
+	// Only the two highest paying txs fit, so the last tx is left in the
+	// mempool.
+	blockTxs, err := env.Builder.PackBlockTxs(len(orderedTxs[0].Bytes()) + len(orderedTxs[1].Bytes()))
+	require.NoError(err)
+	require.Equal(orderedTxs[:2], blockTxs)
+
+	_, ok := env.mempool.Get(orderedTxs[2].ID())
+	require.True(ok)
+}
+
 func TestBuildBlockShouldReward(t *testing.T) {
 	require := require.New(t)
 
@@ -41,6 +41,11 @@ type Manager interface {
 	// preferred state. This should *not* be used to verify transactions in a block.
 	VerifyTx(tx *txs.Tx) error
 
+	// SimulateTx executes the transaction on top of the currently preferred
+	// state, in the same way as VerifyTx, and reports the changes the
+	// transaction would make. The preferred state is never modified.
+	SimulateTx(tx *txs.Tx) (*TxSimulation, error)
+
 	// VerifyUniqueInputs verifies that the inputs are not duplicated in the
 	// provided blk or any of its ancestors pinned in memory.
 	VerifyUniqueInputs(blkID ids.ID, inputs set.Set[ids.ID]) error
@@ -149,6 +154,40 @@ func (m *manager) VerifyTx(tx *txs.Tx) error {
 	})
 }
 
+func (m *manager) SimulateTx(tx *txs.Tx) (*TxSimulation, error) {
+	if !m.txExecutorBackend.Bootstrapped.Get() {
+		return nil, ErrChainNotSynced
+	}
+
+	stateDiff, err := state.NewDiff(m.preferred, m)
+	if err != nil {
+		return nil, err
+	}
+
+	nextBlkTime, _, err := executor.NextBlockTime(stateDiff, m.txExecutorBackend.Clk)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = executor.AdvanceTimeTo(m.txExecutorBackend, stateDiff, nextBlkTime)
+	if err != nil {
+		return nil, err
+	}
+
+	simulation := &TxSimulation{}
+	txExecutor := &executor.StandardTxExecutor{
+		Backend: m.txExecutorBackend,
+		State: &simulationDiff{
+			Diff:       stateDiff,
+			simulation: simulation,
+		},
+		Tx: tx,
+	}
+	simulation.Err = tx.Unsigned.Visit(txExecutor)
+	simulation.AtomicRequests = txExecutor.AtomicRequests
+	return simulation, nil
+}
+
 func (m *manager) VerifyUniqueInputs(blkID ids.ID, inputs set.Set[ids.ID]) error {
 	return m.backend.verifyUniqueInputs(blkID, inputs)
 }
@@ -0,0 +1,101 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package executor
+
+import (
+	"github.com/ava-labs/avalanchego/chains/atomic"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/state"
+)
+
+var _ state.Diff = (*simulationDiff)(nil)
+
+// TxSimulation is the result of executing a transaction on top of the
+// currently preferred state.
+type TxSimulation struct {
+	// Consumed are the UTXOs of this chain that the transaction spends.
+	Consumed []*avax.UTXO
+	// Produced are the UTXOs that the transaction adds to this chain.
+	Produced []*avax.UTXO
+	// AtomicRequests are the shared memory operations of the transaction,
+	// keyed by the peer chainID. May be nil.
+	AtomicRequests map[ids.ID]*atomic.Requests
+	// StakerChanges are the stakers that the transaction adds or removes.
+	StakerChanges []StakerChange
+	// Err is the error returned by the executor. If Err is non-nil, the
+	// transaction can't be issued and the other fields may be incomplete.
+	Err error
+}
+
+type StakerChange struct {
+	*state.Staker
+	Removed bool
+}
+
+// simulationDiff records the UTXO and staker modifications made to a diff
+// into a simulation.
+type simulationDiff struct {
+	state.Diff
+	simulation *TxSimulation
+}
+
+func (d *simulationDiff) AddUTXO(utxo *avax.UTXO) {
+	d.simulation.Produced = append(d.simulation.Produced, utxo)
+	d.Diff.AddUTXO(utxo)
+}
+
+func (d *simulationDiff) DeleteUTXO(utxoID ids.ID) {
+	if utxo, err := d.Diff.GetUTXO(utxoID); err == nil {
+		d.simulation.Consumed = append(d.simulation.Consumed, utxo)
+	}
+	d.Diff.DeleteUTXO(utxoID)
+}
+
+func (d *simulationDiff) PutCurrentValidator(staker *state.Staker) {
+	d.recordStaker(staker, false)
+	d.Diff.PutCurrentValidator(staker)
+}
+
+func (d *simulationDiff) DeleteCurrentValidator(staker *state.Staker) {
+	d.recordStaker(staker, true)
+	d.Diff.DeleteCurrentValidator(staker)
+}
+
+func (d *simulationDiff) PutCurrentDelegator(staker *state.Staker) {
+	d.recordStaker(staker, false)
+	d.Diff.PutCurrentDelegator(staker)
+}
+
+func (d *simulationDiff) DeleteCurrentDelegator(staker *state.Staker) {
+	d.recordStaker(staker, true)
+	d.Diff.DeleteCurrentDelegator(staker)
+}
+
+func (d *simulationDiff) PutPendingValidator(staker *state.Staker) {
+	d.recordStaker(staker, false)
+	d.Diff.PutPendingValidator(staker)
+}
+
+func (d *simulationDiff) DeletePendingValidator(staker *state.Staker) {
+	d.recordStaker(staker, true)
+	d.Diff.DeletePendingValidator(staker)
+}
+
+func (d *simulationDiff) PutPendingDelegator(staker *state.Staker) {
+	d.recordStaker(staker, false)
+	d.Diff.PutPendingDelegator(staker)
+}
+
+func (d *simulationDiff) DeletePendingDelegator(staker *state.Staker) {
+	d.recordStaker(staker, true)
+	d.Diff.DeletePendingDelegator(staker)
+}
+
+func (d *simulationDiff) recordStaker(staker *state.Staker, removed bool) {
+	d.simulation.StakerChanges = append(d.simulation.StakerChanges, StakerChange{
+		Staker:  staker,
+		Removed: removed,
+	})
+}
@@ -9,18 +9,22 @@ import (
 
 	"github.com/ava-labs/avalanchego/chains/atomic"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/math"
 	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/components/gas"
 	"github.com/ava-labs/avalanchego/vms/platformvm/block"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
 	"github.com/ava-labs/avalanchego/vms/platformvm/status"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs/executor"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs/fee"
 )
 
 var (
 	_ block.Visitor = (*verifier)(nil)
 
-	ErrConflictingBlockTxs = errors.New("block contains conflicting transactions")
+	ErrConflictingBlockTxs   = errors.New("block contains conflicting transactions")
+	ErrBlockGasLimitExceeded = errors.New("block gas limit exceeded")
 
 	errApricotBlockIssuedAfterFork           = errors.New("apricot block issued after fork")
 	errBanffStandardBlockWithoutChanges      = errors.New("BanffStandardBlock performs no state changes")
@@ -478,6 +482,10 @@ func (v *verifier) processStandardTxs(txs []*txs.Tx, state state.Diff, parentID
 		return nil, nil, nil, err
 	}
 
+	if err := v.advanceFeeState(txs, state); err != nil {
+		return nil, nil, nil, err
+	}
+
 	if numFuncs := len(funcs); numFuncs == 1 {
 		onAcceptFunc = funcs[0]
 	} else if numFuncs > 1 {
@@ -490,3 +498,41 @@ func (v *verifier) processStandardTxs(txs []*txs.Tx, state state.Diff, parentID
 
 	return inputs, atomicRequests, onAcceptFunc, nil
 }
+
+// advanceFeeState updates the fee state of [state] to account for the gas
+// consumed by [txs]. Prior to the E upgrade, this is a noop.
+func (v *verifier) advanceFeeState(txs []*txs.Tx, state state.Diff) error {
+	var (
+		timestamp = state.GetTimestamp()
+		cfg       = v.txExecutorBackend.Config
+	)
+	if !cfg.UpgradeConfig.IsEActivated(timestamp) {
+		return nil
+	}
+
+	var (
+		feeCfg   = cfg.DynamicFeeConfig
+		consumed uint64
+	)
+	for _, tx := range txs {
+		txGas, err := fee.TxGas(tx.Unsigned, feeCfg.Weights)
+		if err != nil {
+			return err
+		}
+		consumed, err = math.Add64(consumed, uint64(txGas))
+		if err != nil {
+			return err
+		}
+	}
+	if consumed > uint64(feeCfg.MaxGasPerBlock) {
+		return fmt.Errorf("%w: %d > %d",
+			ErrBlockGasLimitExceeded,
+			consumed,
+			feeCfg.MaxGasPerBlock,
+		)
+	}
+
+	feeState := state.GetFeeState()
+	state.SetFeeState(feeState.AdvanceBlock(feeCfg.TargetGasPerBlock, gas.Gas(consumed)))
+	return nil
+}
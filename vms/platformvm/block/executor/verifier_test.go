@@ -18,6 +18,8 @@ import (
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/utils/timer/mockable"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/gas"
 	"github.com/ava-labs/avalanchego/vms/components/verify"
 	"github.com/ava-labs/avalanchego/vms/platformvm/block"
 	"github.com/ava-labs/avalanchego/vms/platformvm/config"
@@ -25,8 +27,10 @@ import (
 	"github.com/ava-labs/avalanchego/vms/platformvm/status"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs/executor"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs/fee"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs/mempool"
 	"github.com/ava-labs/avalanchego/vms/platformvm/upgrade"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 )
 
 func TestVerifierVisitProposalBlock(t *testing.T) {
@@ -41,6 +45,7 @@ func TestVerifierVisitProposalBlock(t *testing.T) {
 	timestamp := time.Now()
 	// One call for each of onCommitState and onAbortState.
 	parentOnAcceptState.EXPECT().GetTimestamp().Return(timestamp).Times(2)
+	parentOnAcceptState.EXPECT().GetFeeState().Return(gas.State{}).Times(2)
 
 	backend := &backend{
 		lastAccepted: parentID,
@@ -237,6 +242,7 @@ func TestVerifierVisitStandardBlock(t *testing.T) {
 				UpgradeConfig: upgrade.Config{
 					ApricotPhase5Time: time.Now().Add(time.Hour),
 					BanffTime:         mockable.MaxTime, // banff is not activated
+					EUpgradeTime:      mockable.MaxTime, // E is not activated
 				},
 			},
 			Clk: &mockable.Clock{},
@@ -291,6 +297,7 @@ func TestVerifierVisitStandardBlock(t *testing.T) {
 	// Set expectations for dependencies.
 	timestamp := time.Now()
 	parentState.EXPECT().GetTimestamp().Return(timestamp).Times(1)
+	parentState.EXPECT().GetFeeState().Return(gas.State{}).Times(1)
 	parentStatelessBlk.EXPECT().Height().Return(uint64(1)).Times(1)
 	mempool.EXPECT().Remove(apricotBlk.Txs()).Times(1)
 
@@ -567,6 +574,7 @@ func TestBanffAbortBlockTimestampChecks(t *testing.T) {
 			parentTime := defaultGenesisTime
 			s.EXPECT().GetLastAccepted().Return(parentID).Times(3)
 			s.EXPECT().GetTimestamp().Return(parentTime).Times(3)
+			s.EXPECT().GetFeeState().Return(gas.State{}).Times(3)
 
 			onDecisionState, err := state.NewDiff(parentID, backend)
 			require.NoError(err)
@@ -665,6 +673,7 @@ func TestBanffCommitBlockTimestampChecks(t *testing.T) {
 			parentTime := defaultGenesisTime
 			s.EXPECT().GetLastAccepted().Return(parentID).Times(3)
 			s.EXPECT().GetTimestamp().Return(parentTime).Times(3)
+			s.EXPECT().GetFeeState().Return(gas.State{}).Times(3)
 
 			onDecisionState, err := state.NewDiff(parentID, backend)
 			require.NoError(err)
@@ -731,6 +740,7 @@ func TestVerifierVisitStandardBlockWithDuplicateInputs(t *testing.T) {
 				UpgradeConfig: upgrade.Config{
 					ApricotPhase5Time: time.Now().Add(time.Hour),
 					BanffTime:         mockable.MaxTime, // banff is not activated
+					EUpgradeTime:      mockable.MaxTime, // E is not activated
 				},
 			},
 			Clk: &mockable.Clock{},
@@ -782,6 +792,7 @@ func TestVerifierVisitStandardBlockWithDuplicateInputs(t *testing.T) {
 	timestamp := time.Now()
 	parentStatelessBlk.EXPECT().Height().Return(uint64(1)).Times(1)
 	parentState.EXPECT().GetTimestamp().Return(timestamp).Times(1)
+	parentState.EXPECT().GetFeeState().Return(gas.State{}).Times(1)
 	parentStatelessBlk.EXPECT().Parent().Return(grandParentID).Times(1)
 
 	err = verifier.ApricotStandardBlock(blk)
@@ -1087,3 +1098,95 @@ func TestVerifierVisitBanffAbortBlockUnexpectedParentState(t *testing.T) {
 	err = verifier.BanffAbortBlock(blk)
 	require.ErrorIs(err, state.ErrMissingParentState)
 }
+
+func TestVerifierAdvanceFeeState(t *testing.T) {
+	baseTx := &txs.Tx{
+		Unsigned: &txs.BaseTx{
+			BaseTx: avax.BaseTx{
+				Ins: []*avax.TransferableInput{
+					{
+						UTXOID: avax.UTXOID{TxID: ids.GenerateTestID()},
+						In: &secp256k1fx.TransferInput{
+							Amt: 1,
+							Input: secp256k1fx.Input{
+								SigIndices: []uint32{0},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	require.NoError(t, baseTx.Initialize(txs.Codec))
+
+	weights := gas.Dimensions{
+		gas.Bandwidth: 1,
+		gas.DBRead:    1,
+		gas.DBWrite:   1,
+		gas.Compute:   1,
+	}
+	txGas, err := fee.TxGas(baseTx.Unsigned, weights)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name             string
+		eUpgradeTime     time.Time
+		maxGasPerBlock   gas.Gas
+		initialFeeState  gas.State
+		expectedFeeState *gas.State
+		expectedErr      error
+	}{
+		{
+			name:            "pre E upgrade",
+			eUpgradeTime:    mockable.MaxTime,
+			maxGasPerBlock:  txGas,
+			initialFeeState: gas.State{Excess: 1},
+		},
+		{
+			name:            "excess increases",
+			maxGasPerBlock:  txGas,
+			initialFeeState: gas.State{Excess: 1},
+			expectedFeeState: &gas.State{
+				Excess: 1 + txGas - 1, // consumed - target
+			},
+		},
+		{
+			name:           "exceeds max gas",
+			maxGasPerBlock: txGas - 1,
+			expectedErr:    ErrBlockGasLimitExceeded,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+			ctrl := gomock.NewController(t)
+
+			verifier := &verifier{
+				txExecutorBackend: &executor.Backend{
+					Config: &config.Config{
+						DynamicFeeConfig: gas.Config{
+							Weights:                  weights,
+							MaxGasPerBlock:           test.maxGasPerBlock,
+							TargetGasPerBlock:        1,
+							MinPrice:                 1,
+							ExcessConversionConstant: 1,
+						},
+						UpgradeConfig: upgrade.Config{
+							EUpgradeTime: test.eUpgradeTime,
+						},
+					},
+				},
+			}
+
+			onAcceptState := state.NewMockDiff(ctrl)
+			onAcceptState.EXPECT().GetTimestamp().Return(time.Unix(1, 0)).Times(1)
+			if test.expectedFeeState != nil {
+				onAcceptState.EXPECT().GetFeeState().Return(test.initialFeeState).Times(1)
+				onAcceptState.EXPECT().SetFeeState(*test.expectedFeeState).Times(1)
+			}
+
+			err := verifier.advanceFeeState([]*txs.Tx{baseTx}, onAcceptState)
+			require.ErrorIs(err, test.expectedErr)
+		})
+	}
+}
@@ -16,7 +16,9 @@ import (
 	"github.com/ava-labs/avalanchego/utils/formatting/address"
 	"github.com/ava-labs/avalanchego/utils/json"
 	"github.com/ava-labs/avalanchego/utils/rpc"
+	"github.com/ava-labs/avalanchego/vms/components/gas"
 	"github.com/ava-labs/avalanchego/vms/platformvm/status"
+	"github.com/ava-labs/avalanchego/vms/platformvm/warp"
 )
 
 var _ Client = (*client)(nil)
@@ -70,6 +72,18 @@ type Client interface {
 	GetCurrentValidators(ctx context.Context, subnetID ids.ID, nodeIDs []ids.NodeID, options ...rpc.Option) ([]ClientPermissionlessValidator, error)
 	// GetCurrentSupply returns an upper bound on the supply of AVAX in the system along with the P-chain height
 	GetCurrentSupply(ctx context.Context, subnetID ids.ID, options ...rpc.Option) (uint64, uint64, error)
+	// EstimateReward returns the reward that staking [amount] for [duration]
+	// on subnet [subnetID] would currently earn. If [delegationFee] is
+	// non-nil, the reward is split as if the stake was delegated to a
+	// validator charging [delegationFee] percent.
+	EstimateReward(
+		ctx context.Context,
+		subnetID ids.ID,
+		amount uint64,
+		duration time.Duration,
+		delegationFee *float32,
+		options ...rpc.Option,
+	) (*EstimateRewardReply, error)
 	// SampleValidators returns the nodeIDs of a sample of [sampleSize] validators from the current validator set for subnet with ID [subnetID]
 	SampleValidators(ctx context.Context, subnetID ids.ID, sampleSize uint16, options ...rpc.Option) ([]ids.NodeID, error)
 	// GetBlockchainStatus returns the current status of blockchain with ID: [blockchainID]
@@ -84,6 +98,9 @@ type Client interface {
 	GetBlockchains(ctx context.Context, options ...rpc.Option) ([]APIBlockchain, error)
 	// IssueTx issues the transaction and returns its txID
 	IssueTx(ctx context.Context, tx []byte, options ...rpc.Option) (ids.ID, error)
+	// SimulateTx executes the transaction on top of the currently preferred
+	// state without issuing it and returns the changes it would make
+	SimulateTx(ctx context.Context, tx []byte, options ...rpc.Option) (*SimulateTxReply, error)
 	// GetTx returns the byte representation of the transaction corresponding to [txID]
 	GetTx(ctx context.Context, txID ids.ID, options ...rpc.Option) ([]byte, error)
 	// GetTxStatus returns the status of the transaction corresponding to [txID]
@@ -117,8 +134,38 @@ type Client interface {
 	//
 	// Deprecated: GetRewardUTXOs should be fetched from a dedicated indexer.
 	GetRewardUTXOs(context.Context, *api.GetTxArgs, ...rpc.Option) ([][]byte, error)
+	// GetStakerRewardProjection returns the reward that the current staker
+	// added by [txID] would receive and whether it currently meets the
+	// uptime requirement
+	GetStakerRewardProjection(ctx context.Context, txID ids.ID, options ...rpc.Option) (*GetStakerRewardProjectionReply, error)
+	// GetUptimeHistory returns the uptime samples of [nodeID] on [subnetID]
+	// recorded by the node between [startTime] and [endTime]
+	GetUptimeHistory(
+		ctx context.Context,
+		nodeID ids.NodeID,
+		subnetID ids.ID,
+		startTime time.Time,
+		endTime time.Time,
+		options ...rpc.Option,
+	) (*GetUptimeHistoryReply, error)
+	// GetAddressTxs returns up to [pageSize] IDs of the accepted txs that
+	// consumed or produced [assetID] outputs owned by [addr], starting at
+	// [cursor]. The returned cursor should be provided to fetch the next page.
+	GetAddressTxs(
+		ctx context.Context,
+		addr ids.ShortID,
+		assetID ids.ID,
+		cursor uint64,
+		pageSize uint64,
+		options ...rpc.Option,
+	) ([]ids.ID, uint64, error)
 	// GetTimestamp returns the current chain timestamp
 	GetTimestamp(ctx context.Context, options ...rpc.Option) (time.Time, error)
+	// GetFeeConfig returns the config used to calculate dynamic fees
+	GetFeeConfig(ctx context.Context, options ...rpc.Option) (*gas.Config, error)
+	// GetFeeState returns the current fee state and the gas price that will
+	// be charged to txs included in the next block
+	GetFeeState(ctx context.Context, options ...rpc.Option) (gas.State, gas.Price, time.Time, error)
 	// GetValidatorsAt returns the weights of the validator set of a provided
 	// subnet at the specified height.
 	GetValidatorsAt(
@@ -131,6 +178,19 @@ type Client interface {
 	GetBlock(ctx context.Context, blockID ids.ID, options ...rpc.Option) ([]byte, error)
 	// GetBlockByHeight returns the block at the given [height].
 	GetBlockByHeight(ctx context.Context, height uint64, options ...rpc.Option) ([]byte, error)
+	// AggregateWarpSignatures returns [message] signed by at least
+	// [quorumNum]/[quorumDen] of the weight of its source subnet's validator
+	// set at [pChainHeight]. If [pChainHeight] is 0, the current validator set
+	// is used. If [quorumDen] is 0, a default quorum is used.
+	AggregateWarpSignatures(
+		ctx context.Context,
+		message *warp.UnsignedMessage,
+		justification []byte,
+		pChainHeight uint64,
+		quorumNum uint64,
+		quorumDen uint64,
+		options ...rpc.Option,
+	) (*warp.Message, error)
 }
 
 // Client implementation for interacting with the P Chain endpoint
@@ -330,6 +390,24 @@ func (c *client) GetCurrentSupply(ctx context.Context, subnetID ids.ID, options
 	return uint64(res.Supply), uint64(res.Height), err
 }
 
+func (c *client) EstimateReward(
+	ctx context.Context,
+	subnetID ids.ID,
+	amount uint64,
+	duration time.Duration,
+	delegationFee *float32,
+	options ...rpc.Option,
+) (*EstimateRewardReply, error) {
+	res := &EstimateRewardReply{}
+	err := c.requester.SendRequest(ctx, "platform.estimateReward", &EstimateRewardArgs{
+		SubnetID:      subnetID,
+		Amount:        json.Uint64(amount),
+		Duration:      json.Uint64(duration / time.Second),
+		DelegationFee: (*json.Float32)(delegationFee),
+	}, res, options...)
+	return res, err
+}
+
 func (c *client) SampleValidators(ctx context.Context, subnetID ids.ID, sampleSize uint16, options ...rpc.Option) ([]ids.NodeID, error) {
 	res := &SampleValidatorsReply{}
 	err := c.requester.SendRequest(ctx, "platform.sampleValidators", &SampleValidatorsArgs{
@@ -383,6 +461,20 @@ func (c *client) IssueTx(ctx context.Context, txBytes []byte, options ...rpc.Opt
 	return res.TxID, err
 }
 
+func (c *client) SimulateTx(ctx context.Context, txBytes []byte, options ...rpc.Option) (*SimulateTxReply, error) {
+	txStr, err := formatting.Encode(formatting.Hex, txBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &SimulateTxReply{}
+	err = c.requester.SendRequest(ctx, "platform.simulateTx", &api.FormattedTx{
+		Tx:       txStr,
+		Encoding: formatting.Hex,
+	}, res, options...)
+	return res, err
+}
+
 func (c *client) GetTx(ctx context.Context, txID ids.ID, options ...rpc.Option) ([]byte, error) {
 	res := &api.FormattedTx{}
 	err := c.requester.SendRequest(ctx, "platform.getTx", &api.GetTxArgs{
@@ -503,12 +595,80 @@ func (c *client) GetRewardUTXOs(ctx context.Context, args *api.GetTxArgs, option
 	return utxos, err
 }
 
+func (c *client) GetStakerRewardProjection(ctx context.Context, txID ids.ID, options ...rpc.Option) (*GetStakerRewardProjectionReply, error) {
+	res := &GetStakerRewardProjectionReply{}
+	err := c.requester.SendRequest(ctx, "platform.getStakerRewardProjection", &api.GetTxArgs{
+		TxID: txID,
+	}, res, options...)
+	return res, err
+}
+
+func (c *client) GetUptimeHistory(
+	ctx context.Context,
+	nodeID ids.NodeID,
+	subnetID ids.ID,
+	startTime time.Time,
+	endTime time.Time,
+	options ...rpc.Option,
+) (*GetUptimeHistoryReply, error) {
+	res := &GetUptimeHistoryReply{}
+	err := c.requester.SendRequest(ctx, "platform.getUptimeHistory", &GetUptimeHistoryArgs{
+		NodeID:    nodeID,
+		SubnetID:  subnetID,
+		StartTime: json.Uint64(startTime.Unix()),
+		EndTime:   json.Uint64(endTime.Unix()),
+	}, res, options...)
+	return res, err
+}
+
+func (c *client) GetAddressTxs(
+	ctx context.Context,
+	addr ids.ShortID,
+	assetID ids.ID,
+	cursor uint64,
+	pageSize uint64,
+	options ...rpc.Option,
+) ([]ids.ID, uint64, error) {
+	res := &GetAddressTxsReply{}
+	err := c.requester.SendRequest(ctx, "platform.getAddressTxs", &GetAddressTxsArgs{
+		JSONAddress: api.JSONAddress{Address: addr.String()},
+		AssetID:     assetID,
+		Cursor:      json.Uint64(cursor),
+		PageSize:    json.Uint64(pageSize),
+	}, res, options...)
+	return res.TxIDs, uint64(res.Cursor), err
+}
+
 func (c *client) GetTimestamp(ctx context.Context, options ...rpc.Option) (time.Time, error) {
 	res := &GetTimestampReply{}
 	err := c.requester.SendRequest(ctx, "platform.getTimestamp", struct{}{}, res, options...)
 	return res.Timestamp, err
 }
 
+func (c *client) GetFeeConfig(ctx context.Context, options ...rpc.Option) (*gas.Config, error) {
+	res := &GetFeeConfigReply{}
+	if err := c.requester.SendRequest(ctx, "platform.getFeeConfig", struct{}{}, res, options...); err != nil {
+		return nil, err
+	}
+
+	config := &gas.Config{
+		MaxGasPerBlock:           gas.Gas(res.MaxGasPerBlock),
+		TargetGasPerBlock:        gas.Gas(res.TargetGasPerBlock),
+		MinPrice:                 gas.Price(res.MinPrice),
+		ExcessConversionConstant: gas.Gas(res.ExcessConversionConstant),
+	}
+	for i, weight := range res.Weights {
+		config.Weights[i] = uint64(weight)
+	}
+	return config, nil
+}
+
+func (c *client) GetFeeState(ctx context.Context, options ...rpc.Option) (gas.State, gas.Price, time.Time, error) {
+	res := &GetFeeStateReply{}
+	err := c.requester.SendRequest(ctx, "platform.getFeeState", struct{}{}, res, options...)
+	return gas.State{Excess: gas.Gas(res.Excess)}, gas.Price(res.Price), res.Time, err
+}
+
 func (c *client) GetValidatorsAt(
 	ctx context.Context,
 	subnetID ids.ID,
@@ -545,3 +705,44 @@ func (c *client) GetBlockByHeight(ctx context.Context, height uint64, options ..
 	}
 	return formatting.Decode(res.Encoding, res.Block)
 }
+
+func (c *client) AggregateWarpSignatures(
+	ctx context.Context,
+	message *warp.UnsignedMessage,
+	justification []byte,
+	pChainHeight uint64,
+	quorumNum uint64,
+	quorumDen uint64,
+	options ...rpc.Option,
+) (*warp.Message, error) {
+	messageStr, err := formatting.Encode(formatting.HexNC, message.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	var justificationStr string
+	if len(justification) > 0 {
+		justificationStr, err = formatting.Encode(formatting.HexNC, justification)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	res := &AggregateWarpSignaturesReply{}
+	err = c.requester.SendRequest(ctx, "platform.aggregateWarpSignatures", &AggregateWarpSignaturesArgs{
+		Message:       messageStr,
+		Justification: justificationStr,
+		PChainHeight:  json.Uint64(pChainHeight),
+		QuorumNum:     json.Uint64(quorumNum),
+		QuorumDen:     json.Uint64(quorumDen),
+		Encoding:      formatting.HexNC,
+	}, res, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	signedMessageBytes, err := formatting.Decode(res.Encoding, res.Message)
+	if err != nil {
+		return nil, err
+	}
+	return warp.ParseMessage(signedMessageBytes)
+}
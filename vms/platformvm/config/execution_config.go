@@ -5,12 +5,19 @@ package config
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/ava-labs/avalanchego/utils/units"
 	"github.com/ava-labs/avalanchego/vms/platformvm/network"
 )
 
+var (
+	errNonPositiveUptimeHistorySampleFrequency = errors.New("uptime history sample frequency must be positive")
+	errNonPositiveUptimeHistoryRetention       = errors.New("uptime history retention must be positive")
+)
+
 var DefaultExecutionConfig = ExecutionConfig{
 	Network:                      network.DefaultConfig,
 	BlockCacheSize:               64 * units.MiB,
@@ -23,6 +30,11 @@ var DefaultExecutionConfig = ExecutionConfig{
 	FxOwnerCacheSize:             4 * units.MiB,
 	ChecksumsEnabled:             false,
 	MempoolPruneFrequency:        30 * time.Minute,
+	ArchivalMode:                 false,
+	IndexAddressTxs:              false,
+	UptimeHistoryEnabled:         false,
+	UptimeHistorySampleFrequency: 15 * time.Minute,
+	UptimeHistoryRetention:       14 * 24 * time.Hour,
 }
 
 // ExecutionConfig provides execution parameters of PlatformVM
@@ -38,6 +50,21 @@ type ExecutionConfig struct {
 	FxOwnerCacheSize             int            `json:"fx-owner-cache-size"`
 	ChecksumsEnabled             bool           `json:"checksums-enabled"`
 	MempoolPruneFrequency        time.Duration  `json:"mempool-prune-frequency"`
+	// ArchivalMode enables recording the state at every height so that it can
+	// be queried historically. It must be enabled before the chain is synced
+	// from genesis.
+	ArchivalMode bool `json:"archival-mode"`
+	// IndexAddressTxs enables indexing the txs that touch each address. If it
+	// is enabled on a node that has already accepted blocks, the accepted
+	// blocks are indexed on startup.
+	IndexAddressTxs bool `json:"index-address-txs"`
+	// UptimeHistoryEnabled enables periodically recording the uptime of the
+	// validators of the Primary Network and of the tracked subnets.
+	UptimeHistoryEnabled bool `json:"uptime-history-enabled"`
+	// UptimeHistorySampleFrequency is the interval between uptime samples.
+	UptimeHistorySampleFrequency time.Duration `json:"uptime-history-sample-frequency"`
+	// UptimeHistoryRetention is the duration uptime samples are kept for.
+	UptimeHistoryRetention time.Duration `json:"uptime-history-retention"`
 }
 
 // GetExecutionConfig returns an ExecutionConfig
@@ -51,5 +78,14 @@ func GetExecutionConfig(b []byte) (*ExecutionConfig, error) {
 		return &ec, nil
 	}
 
-	return &ec, json.Unmarshal(b, &ec)
+	if err := json.Unmarshal(b, &ec); err != nil {
+		return nil, err
+	}
+	if ec.UptimeHistorySampleFrequency <= 0 {
+		return nil, fmt.Errorf("%w: %s", errNonPositiveUptimeHistorySampleFrequency, ec.UptimeHistorySampleFrequency)
+	}
+	if ec.UptimeHistoryRetention <= 0 {
+		return nil, fmt.Errorf("%w: %s", errNonPositiveUptimeHistoryRetention, ec.UptimeHistoryRetention)
+	}
+	return &ec, nil
 }
@@ -39,6 +39,20 @@ func TestExecutionConfigUnmarshal(t *testing.T) {
 		require.Equal(&expected, ec)
 	})
 
+	t.Run("non-positive uptime history sample frequency", func(t *testing.T) {
+		require := require.New(t)
+		b := []byte(`{"uptime-history-sample-frequency":0}`)
+		_, err := GetExecutionConfig(b)
+		require.ErrorIs(err, errNonPositiveUptimeHistorySampleFrequency)
+	})
+
+	t.Run("non-positive uptime history retention", func(t *testing.T) {
+		require := require.New(t)
+		b := []byte(`{"uptime-history-retention":-1}`)
+		_, err := GetExecutionConfig(b)
+		require.ErrorIs(err, errNonPositiveUptimeHistoryRetention)
+	})
+
 	t.Run("all values extracted from json", func(t *testing.T) {
 		require := require.New(t)
 		b := []byte(`{
@@ -59,7 +73,8 @@ func TestExecutionConfigUnmarshal(t *testing.T) {
 				"pull-gossip-throttling-limit": 14,
 				"expected-bloom-filter-elements": 15,
 				"expected-bloom-filter-false-positive-probability": 16,
-				"max-bloom-filter-false-positive-probability": 17
+				"max-bloom-filter-false-positive-probability": 17,
+				"max-warp-signature-attempts": 18
 			},
 			"block-cache-size": 1,
 			"tx-cache-size": 2,
@@ -70,7 +85,12 @@ func TestExecutionConfigUnmarshal(t *testing.T) {
 			"block-id-cache-size": 8,
 			"fx-owner-cache-size": 9,
 			"checksums-enabled": true,
-			"mempool-prune-frequency": 60000000000
+			"mempool-prune-frequency": 60000000000,
+			"archival-mode": true,
+			"index-address-txs": true,
+			"uptime-history-enabled": true,
+			"uptime-history-sample-frequency": 60000000000,
+			"uptime-history-retention": 3600000000000
 		}`)
 		ec, err := GetExecutionConfig(b)
 		require.NoError(err)
@@ -93,6 +113,7 @@ func TestExecutionConfigUnmarshal(t *testing.T) {
 				ExpectedBloomFilterElements:                 15,
 				ExpectedBloomFilterFalsePositiveProbability: 16,
 				MaxBloomFilterFalsePositiveProbability:      17,
+				MaxWarpSignatureAttempts:                    18,
 			},
 			BlockCacheSize:               1,
 			TxCacheSize:                  2,
@@ -104,6 +125,11 @@ func TestExecutionConfigUnmarshal(t *testing.T) {
 			FxOwnerCacheSize:             9,
 			ChecksumsEnabled:             true,
 			MempoolPruneFrequency:        time.Minute,
+			ArchivalMode:                 true,
+			IndexAddressTxs:              true,
+			UptimeHistoryEnabled:         true,
+			UptimeHistorySampleFrequency: time.Minute,
+			UptimeHistoryRetention:       time.Hour,
 		}
 		require.Equal(expected, ec)
 	})
@@ -151,6 +177,7 @@ func TestExecutionConfigUnmarshal(t *testing.T) {
 				ExpectedBloomFilterElements:                 DefaultExecutionConfig.Network.ExpectedBloomFilterElements,
 				ExpectedBloomFilterFalsePositiveProbability: DefaultExecutionConfig.Network.ExpectedBloomFilterFalsePositiveProbability,
 				MaxBloomFilterFalsePositiveProbability:      DefaultExecutionConfig.Network.MaxBloomFilterFalsePositiveProbability,
+				MaxWarpSignatureAttempts:                    DefaultExecutionConfig.Network.MaxWarpSignatureAttempts,
 			},
 			BlockCacheSize:               1,
 			TxCacheSize:                  2,
@@ -162,6 +189,8 @@ func TestExecutionConfigUnmarshal(t *testing.T) {
 			FxOwnerCacheSize:             9,
 			ChecksumsEnabled:             true,
 			MempoolPruneFrequency:        30 * time.Minute,
+			UptimeHistorySampleFrequency: DefaultExecutionConfig.UptimeHistorySampleFrequency,
+			UptimeHistoryRetention:       DefaultExecutionConfig.UptimeHistoryRetention,
 		}
 		require.Equal(expected, ec)
 	})
@@ -38,6 +38,11 @@ type Metrics interface {
 	SetTimeUntilUnstake(time.Duration)
 	// Mark when this node will unstake from a subnet.
 	SetTimeUntilSubnetUnstake(subnetID ids.ID, timeUntilUnstake time.Duration)
+	// Mark the uptime of a validator of a subnet, as observed by this node.
+	SetValidatorUptime(subnetID ids.ID, nodeID ids.NodeID, uptime float64, connected bool)
+	// Remove the uptime of a validator that is no longer a validator of the
+	// subnet.
+	DeleteValidatorUptime(subnetID ids.ID, nodeID ids.NodeID)
 }
 
 func New(
@@ -70,6 +75,22 @@ func New(
 			Name:      "total_staked",
 			Help:      "Amount (in nAVAX) of AVAX staked on the Primary Network",
 		}),
+		validatorUptime: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "validator_uptime",
+				Help:      "Fraction of the time since the validator started validating the subnet that it was observed to be online",
+			},
+			[]string{"subnetID", "nodeID"},
+		),
+		validatorConnected: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "validator_connected",
+				Help:      "1 if the validator of the subnet is connected, 0 otherwise",
+			},
+			[]string{"subnetID", "nodeID"},
+		),
 
 		validatorSetsCached: prometheus.NewCounter(prometheus.CounterOpts{
 			Namespace: namespace,
@@ -102,6 +123,8 @@ func New(
 		registerer.Register(m.timeUntilSubnetUnstake),
 		registerer.Register(m.localStake),
 		registerer.Register(m.totalStake),
+		registerer.Register(m.validatorUptime),
+		registerer.Register(m.validatorConnected),
 
 		registerer.Register(m.validatorSetsCreated),
 		registerer.Register(m.validatorSetsCached),
@@ -121,6 +144,8 @@ type metrics struct {
 	timeUntilSubnetUnstake *prometheus.GaugeVec
 	localStake             prometheus.Gauge
 	totalStake             prometheus.Gauge
+	validatorUptime        *prometheus.GaugeVec
+	validatorConnected     *prometheus.GaugeVec
 
 	validatorSetsCached     prometheus.Counter
 	validatorSetsCreated    prometheus.Counter
@@ -163,3 +188,20 @@ func (m *metrics) SetTimeUntilUnstake(timeUntilUnstake time.Duration) {
 func (m *metrics) SetTimeUntilSubnetUnstake(subnetID ids.ID, timeUntilUnstake time.Duration) {
 	m.timeUntilSubnetUnstake.WithLabelValues(subnetID.String()).Set(float64(timeUntilUnstake))
 }
+
+func (m *metrics) SetValidatorUptime(subnetID ids.ID, nodeID ids.NodeID, uptime float64, connected bool) {
+	labels := []string{subnetID.String(), nodeID.String()}
+	m.validatorUptime.WithLabelValues(labels...).Set(uptime)
+
+	var connectedValue float64
+	if connected {
+		connectedValue = 1
+	}
+	m.validatorConnected.WithLabelValues(labels...).Set(connectedValue)
+}
+
+func (m *metrics) DeleteValidatorUptime(subnetID ids.ID, nodeID ids.NodeID) {
+	labels := []string{subnetID.String(), nodeID.String()}
+	m.validatorUptime.DeleteLabelValues(labels...)
+	m.validatorConnected.DeleteLabelValues(labels...)
+}
@@ -47,6 +47,10 @@ func (noopMetrics) SetTimeUntilUnstake(time.Duration) {}
 
 func (noopMetrics) SetTimeUntilSubnetUnstake(ids.ID, time.Duration) {}
 
+func (noopMetrics) SetValidatorUptime(ids.ID, ids.NodeID, float64, bool) {}
+
+func (noopMetrics) DeleteValidatorUptime(ids.ID, ids.NodeID) {}
+
 func (noopMetrics) SetSubnetPercentConnected(ids.ID, float64) {}
 
 func (noopMetrics) SetPercentConnected(float64) {}
@@ -27,6 +27,7 @@ var DefaultConfig = Config{
 	ExpectedBloomFilterElements:                 8 * 1024,
 	ExpectedBloomFilterFalsePositiveProbability: .01,
 	MaxBloomFilterFalsePositiveProbability:      .05,
+	MaxWarpSignatureAttempts:                    3,
 }
 
 type Config struct {
@@ -90,4 +91,8 @@ type Config struct {
 	// The smaller this number is, the more frequently that the bloom filter
 	// will be regenerated.
 	MaxBloomFilterFalsePositiveProbability float64 `json:"max-bloom-filter-false-positive-probability"`
+	// MaxWarpSignatureAttempts is the number of times a warp signature will
+	// be requested from a validator before giving up on it during signature
+	// aggregation.
+	MaxWarpSignatureAttempts int `json:"max-warp-signature-attempts"`
 }
@@ -13,10 +13,12 @@ import (
 
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
-	"github.com/ava-labs/avalanchego/vms/txs/mempool"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 
 	pmempool "github.com/ava-labs/avalanchego/vms/platformvm/txs/mempool"
+	txmempool "github.com/ava-labs/avalanchego/vms/txs/mempool"
 )
 
 var errFoo = errors.New("foo")
@@ -115,7 +117,7 @@ func TestMempoolDuplicate(t *testing.T) {
 	require.NoError(err)
 
 	err = gossipMempool.Add(tx)
-	require.ErrorIs(err, mempool.ErrDuplicateTx)
+	require.ErrorIs(err, txmempool.ErrDuplicateTx)
 	require.False(gossipMempool.bloom.Has(tx))
 }
 
@@ -152,3 +154,58 @@ func TestGossipAddBloomFilter(t *testing.T) {
 	require.NoError(gossipMempool.Add(tx))
 	require.True(gossipMempool.bloom.Has(tx))
 }
+
+// A tx replaced by a conflicting tx paying a higher fee should not be
+// re-added by gossip
+func TestGossipMempoolReplacement(t *testing.T) {
+	require := require.New(t)
+
+	avaxAssetID := ids.GenerateTestID()
+	utxoID := avax.UTXOID{
+		TxID: ids.GenerateTestID(),
+	}
+	newTx := func(fee uint64) *txs.Tx {
+		memo := ids.GenerateTestID()
+		tx := &txs.Tx{Unsigned: &txs.BaseTx{BaseTx: avax.BaseTx{
+			Memo: memo[:],
+			Ins: []*avax.TransferableInput{{
+				UTXOID: utxoID,
+				Asset:  avax.Asset{ID: avaxAssetID},
+				In: &secp256k1fx.TransferInput{
+					Amt: fee,
+				},
+			}},
+		}}}
+		require.NoError(tx.Initialize(txs.Codec))
+		return tx
+	}
+
+	mempool, err := pmempool.New("", avaxAssetID, 0, prometheus.NewRegistry(), nil)
+	require.NoError(err)
+	gossipMempool, err := newGossipMempool(
+		mempool,
+		prometheus.NewRegistry(),
+		logging.NoLog{},
+		testTxVerifier{},
+		testConfig.ExpectedBloomFilterElements,
+		testConfig.ExpectedBloomFilterFalsePositiveProbability,
+		testConfig.MaxBloomFilterFalsePositiveProbability,
+	)
+	require.NoError(err)
+
+	tx := newTx(100)
+	require.NoError(gossipMempool.Add(tx))
+
+	err = gossipMempool.Add(newTx(109))
+	require.ErrorIs(err, txmempool.ErrConflictsWithOtherTx)
+
+	replacementTx := newTx(110)
+	require.NoError(gossipMempool.Add(replacementTx))
+	require.True(gossipMempool.Has(replacementTx.ID()))
+	require.True(gossipMempool.bloom.Has(replacementTx))
+	require.False(gossipMempool.Has(tx.ID()))
+
+	err = gossipMempool.Add(tx)
+	require.ErrorIs(err, txmempool.ErrReplaced)
+	require.False(gossipMempool.Has(tx.ID()))
+}
@@ -13,12 +13,14 @@ import (
 
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/network/p2p"
+	"github.com/ava-labs/avalanchego/network/p2p/acp118"
 	"github.com/ava-labs/avalanchego/network/p2p/gossip"
 	"github.com/ava-labs/avalanchego/snow/engine/common"
 	"github.com/ava-labs/avalanchego/snow/validators"
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs/mempool"
+	"github.com/ava-labs/avalanchego/vms/platformvm/warp"
 )
 
 const TxGossipHandlerID = 0
@@ -38,6 +40,8 @@ type Network struct {
 	txPushGossipFrequency time.Duration
 	txPullGossiper        gossip.Gossiper
 	txPullGossipFrequency time.Duration
+
+	signatureAggregator *acp118.SignatureAggregator
 }
 
 func New(
@@ -46,6 +50,8 @@ func New(
 	subnetID ids.ID,
 	vdrs validators.State,
 	txVerifier TxVerifier,
+	warpVerifier acp118.Verifier,
+	warpSigner warp.Signer,
 	mempool mempool.Mempool,
 	partialSyncPrimaryNetwork bool,
 	appSender common.AppSender,
@@ -158,6 +164,18 @@ func New(
 		return nil, err
 	}
 
+	signatureHandler := acp118.NewHandler(warpVerifier, warpSigner)
+	if err := p2pNetwork.AddHandler(acp118.HandlerID, signatureHandler); err != nil {
+		return nil, err
+	}
+
+	signatureAggregator := acp118.NewSignatureAggregator(
+		log,
+		p2pNetwork.NewClient(acp118.HandlerID),
+		vdrs,
+		config.MaxWarpSignatureAttempts,
+	)
+
 	return &Network{
 		Network:                   p2pNetwork,
 		log:                       log,
@@ -169,6 +187,7 @@ func New(
 		txPushGossipFrequency:     config.PushGossipFrequency,
 		txPullGossiper:            txPullGossiper,
 		txPullGossipFrequency:     config.PullGossipFrequency,
+		signatureAggregator:       signatureAggregator,
 	}, nil
 }
 
@@ -219,3 +238,24 @@ func (n *Network) IssueTxFromRPC(tx *txs.Tx) error {
 	n.txPushGossiper.Add(tx)
 	return nil
 }
+
+// AggregateSignatures requests signatures of [message] from the validators of
+// its source subnet at [pChainHeight] until [quorumNum]/[quorumDen] of the
+// validator weight has signed it.
+func (n *Network) AggregateSignatures(
+	ctx context.Context,
+	message *warp.UnsignedMessage,
+	justification []byte,
+	pChainHeight uint64,
+	quorumNum uint64,
+	quorumDen uint64,
+) (*warp.Message, error) {
+	return n.signatureAggregator.AggregateSignatures(
+		ctx,
+		message,
+		justification,
+		pChainHeight,
+		quorumNum,
+		quorumDen,
+	)
+}
@@ -42,6 +42,7 @@ var (
 		ExpectedBloomFilterElements:                 10,
 		ExpectedBloomFilterFalsePositiveProbability: .1,
 		MaxBloomFilterFalsePositiveProbability:      .5,
+		MaxWarpSignatureAttempts:                    1,
 	}
 )
 
@@ -170,6 +171,8 @@ func TestNetworkIssueTxFromRPC(t *testing.T) {
 				snowCtx.SubnetID,
 				snowCtx.ValidatorState,
 				tt.txVerifier,
+				NewLockedWarpVerifier(&snowCtx.Lock, nil),
+				snowCtx.WarpSigner,
 				tt.mempoolFunc(ctrl),
 				tt.partialSyncPrimaryNetwork,
 				tt.appSenderFunc(ctrl),
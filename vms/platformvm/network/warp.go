@@ -0,0 +1,65 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/network/p2p/acp118"
+	"github.com/ava-labs/avalanchego/vms/platformvm/block"
+	"github.com/ava-labs/avalanchego/vms/platformvm/warp"
+	"github.com/ava-labs/avalanchego/vms/platformvm/warp/payload"
+)
+
+var (
+	_ acp118.Verifier = (*LockedWarpVerifier)(nil)
+
+	errUnsupportedWarpPayload = errors.New("unsupported warp payload")
+)
+
+type BlockGetter interface {
+	// GetStatelessBlock returns the accepted block with the provided ID.
+	GetStatelessBlock(blockID ids.ID) (block.Block, error)
+}
+
+// LockedWarpVerifier only allows signing warp messages whose payload is the
+// hash of an accepted block.
+type LockedWarpVerifier struct {
+	lock   sync.Locker
+	blocks BlockGetter
+}
+
+func (l *LockedWarpVerifier) Verify(
+	_ context.Context,
+	message *warp.UnsignedMessage,
+	_ []byte,
+) error {
+	parsedPayload, err := payload.Parse(message.Payload)
+	if err != nil {
+		return fmt.Errorf("failed to parse payload: %w", err)
+	}
+	hash, ok := parsedPayload.(*payload.Hash)
+	if !ok {
+		return fmt.Errorf("%w: %T", errUnsupportedWarpPayload, parsedPayload)
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if _, err := l.blocks.GetStatelessBlock(hash.Hash); err != nil {
+		return fmt.Errorf("failed to get accepted block %s: %w", hash.Hash, err)
+	}
+	return nil
+}
+
+func NewLockedWarpVerifier(lock sync.Locker, blocks BlockGetter) *LockedWarpVerifier {
+	return &LockedWarpVerifier{
+		lock:   lock,
+		blocks: blocks,
+	}
+}
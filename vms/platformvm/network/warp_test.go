@@ -0,0 +1,87 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package network
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/vms/platformvm/block"
+	"github.com/ava-labs/avalanchego/vms/platformvm/warp"
+	"github.com/ava-labs/avalanchego/vms/platformvm/warp/payload"
+)
+
+var _ BlockGetter = testBlockGetter{}
+
+type testBlockGetter map[ids.ID]block.Block
+
+func (t testBlockGetter) GetStatelessBlock(blockID ids.ID) (block.Block, error) {
+	blk, ok := t[blockID]
+	if !ok {
+		return nil, database.ErrNotFound
+	}
+	return blk, nil
+}
+
+func TestLockedWarpVerifier(t *testing.T) {
+	acceptedBlockID := ids.GenerateTestID()
+	blocks := testBlockGetter{
+		acceptedBlockID: nil,
+	}
+
+	tests := []struct {
+		name        string
+		payload     func(t *testing.T) []byte
+		expectedErr error
+	}{
+		{
+			name: "accepted block",
+			payload: func(t *testing.T) []byte {
+				hash, err := payload.NewHash(acceptedBlockID)
+				require.NoError(t, err)
+				return hash.Bytes()
+			},
+		},
+		{
+			name: "unknown block",
+			payload: func(t *testing.T) []byte {
+				hash, err := payload.NewHash(ids.GenerateTestID())
+				require.NoError(t, err)
+				return hash.Bytes()
+			},
+			expectedErr: database.ErrNotFound,
+		},
+		{
+			name: "unsupported payload",
+			payload: func(t *testing.T) []byte {
+				addressedCall, err := payload.NewAddressedCall(nil, acceptedBlockID[:])
+				require.NoError(t, err)
+				return addressedCall.Bytes()
+			},
+			expectedErr: errUnsupportedWarpPayload,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+
+			message, err := warp.NewUnsignedMessage(
+				constants.UnitTestID,
+				constants.PlatformChainID,
+				tt.payload(t),
+			)
+			require.NoError(err)
+
+			verifier := NewLockedWarpVerifier(&sync.Mutex{}, blocks)
+			err = verifier.Verify(context.Background(), message, nil)
+			require.ErrorIs(err, tt.expectedErr)
+		})
+	}
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/gas"
 	"github.com/ava-labs/avalanchego/vms/components/keystore"
 	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
 	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
@@ -36,6 +37,9 @@ import (
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
 	"github.com/ava-labs/avalanchego/vms/platformvm/status"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs/executor"
+	"github.com/ava-labs/avalanchego/vms/platformvm/utxo"
+	"github.com/ava-labs/avalanchego/vms/platformvm/warp"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 
 	avajson "github.com/ava-labs/avalanchego/utils/json"
@@ -56,6 +60,11 @@ const (
 	// Note: Staker attributes cache should be large enough so that no evictions
 	// happen when the API loops through all stakers.
 	stakerAttributesCacheSize = 100_000
+
+	// Default fraction of the validator weight that must sign a warp message
+	// when aggregating signatures
+	defaultWarpQuorumNum = 67
+	defaultWarpQuorumDen = 100
 )
 
 var (
@@ -63,6 +72,12 @@ var (
 	errPrimaryNetworkIsNotASubnet = errors.New("the primary network isn't a subnet")
 	errNoAddresses                = errors.New("no addresses provided")
 	errMissingBlockchainID        = errors.New("argument 'blockchainID' not given")
+	errHistoricalAtomicUTXOs      = errors.New("height can't be provided when fetching atomic UTXOs")
+	errMissingStakeAmount         = errors.New("argument 'amount' not given")
+	errMissingStakeDuration       = errors.New("argument 'duration' not given")
+	errInvalidStakeDuration       = errors.New("invalid stake duration")
+	errInvalidDelegationFee       = errors.New("delegation fee must be between 0 and 100")
+	errNotCurrentStaker           = errors.New("not a current staker")
 )
 
 // Service defines the API calls that can be made to the platform chain
@@ -142,6 +157,9 @@ func (s *Service) ExportKey(_ *http.Request, args *ExportKeyArgs, reply *ExportK
 
 type GetBalanceRequest struct {
 	Addresses []string `json:"addresses"`
+	// Height of the accepted block to fetch the balance at. If omitted, the
+	// balance is fetched from the last accepted state.
+	Height *avajson.Uint64 `json:"height"`
 }
 
 // Note: We explicitly duplicate AVAX out of the maps to ensure backwards
@@ -175,13 +193,28 @@ func (s *Service) GetBalance(_ *http.Request, args *GetBalanceRequest, response
 	s.vm.ctx.Lock.Lock()
 	defer s.vm.ctx.Lock.Unlock()
 
-	utxos, err := avax.GetAllUTXOs(s.vm.state, addrs)
+	var (
+		utxoReader  avax.UTXOReader = s.vm.state
+		currentTime                 = s.vm.clock.Unix()
+	)
+	if args.Height != nil {
+		archivedState, err := s.vm.state.GetArchivedState(uint64(*args.Height))
+		if err != nil {
+			return fmt.Errorf("couldn't get state at height %d: %w", *args.Height, err)
+		}
+		timestamp, err := archivedState.GetTimestamp()
+		if err != nil {
+			return fmt.Errorf("couldn't get timestamp at height %d: %w", *args.Height, err)
+		}
+		utxoReader = archivedState
+		currentTime = uint64(timestamp.Unix())
+	}
+
+	utxos, err := avax.GetAllUTXOs(utxoReader, addrs)
 	if err != nil {
 		return fmt.Errorf("couldn't get UTXO set of %v: %w", args.Addresses, err)
 	}
 
-	currentTime := s.vm.clock.Unix()
-
 	unlockeds := map[ids.ID]uint64{}
 	lockedStakeables := map[ids.ID]uint64{}
 	lockedNotStakeables := map[ids.ID]uint64{}
@@ -318,8 +351,17 @@ type Index struct {
 	UTXO    string `json:"utxo"`    // The UTXO ID as a string
 }
 
+// GetUTXOsArgs are the arguments for calling GetUTXOs
+type GetUTXOsArgs struct {
+	api.GetUTXOsArgs
+	// Height of the accepted block to fetch the UTXOs at. If omitted, the UTXOs
+	// are fetched from the last accepted state. Only supported when fetching
+	// UTXOs from the P-chain.
+	Height *avajson.Uint64 `json:"height"`
+}
+
 // GetUTXOs returns the UTXOs controlled by the given addresses
-func (s *Service) GetUTXOs(_ *http.Request, args *api.GetUTXOsArgs, response *api.GetUTXOsReply) error {
+func (s *Service) GetUTXOs(_ *http.Request, args *GetUTXOsArgs, response *api.GetUTXOsReply) error {
 	s.vm.ctx.Log.Debug("API called",
 		zap.String("service", "platform"),
 		zap.String("method", "getUTXOs"),
@@ -342,6 +384,9 @@ func (s *Service) GetUTXOs(_ *http.Request, args *api.GetUTXOsArgs, response *ap
 		}
 		sourceChain = chainID
 	}
+	if args.Height != nil && sourceChain != s.vm.ctx.ChainID {
+		return errHistoricalAtomicUTXOs
+	}
 
 	addrSet, err := avax.ParseServiceAddresses(s.addrManager, args.Addresses)
 	if err != nil {
@@ -375,8 +420,16 @@ func (s *Service) GetUTXOs(_ *http.Request, args *api.GetUTXOsArgs, response *ap
 	defer s.vm.ctx.Lock.Unlock()
 
 	if sourceChain == s.vm.ctx.ChainID {
+		var utxoReader avax.UTXOReader = s.vm.state
+		if args.Height != nil {
+			utxoReader, err = s.vm.state.GetArchivedState(uint64(*args.Height))
+			if err != nil {
+				return fmt.Errorf("couldn't get state at height %d: %w", *args.Height, err)
+			}
+		}
+
 		utxos, endAddr, endUTXOID, err = avax.GetPaginatedUTXOs(
-			s.vm.state,
+			utxoReader,
 			addrSet,
 			startAddr,
 			startUTXO,
@@ -425,6 +478,9 @@ func (s *Service) GetUTXOs(_ *http.Request, args *api.GetUTXOsArgs, response *ap
 type GetSubnetArgs struct {
 	// ID of the subnet to retrieve information about
 	SubnetID ids.ID `json:"subnetID"`
+	// Height of the accepted block to fetch the subnet at. If omitted, the
+	// subnet is fetched from the last accepted state.
+	Height *avajson.Uint64 `json:"height"`
 }
 
 // GetSubnetResponse is the response from calling GetSubnet
@@ -453,7 +509,19 @@ func (s *Service) GetSubnet(_ *http.Request, args *GetSubnetArgs, response *GetS
 	s.vm.ctx.Lock.Lock()
 	defer s.vm.ctx.Lock.Unlock()
 
-	subnetOwner, err := s.vm.state.GetSubnetOwner(args.SubnetID)
+	var subnetGetter interface {
+		GetSubnetOwner(subnetID ids.ID) (fx.Owner, error)
+		GetSubnetTransformation(subnetID ids.ID) (*txs.Tx, error)
+	} = s.vm.state
+	if args.Height != nil {
+		archivedState, err := s.vm.state.GetArchivedState(uint64(*args.Height))
+		if err != nil {
+			return fmt.Errorf("couldn't get state at height %d: %w", *args.Height, err)
+		}
+		subnetGetter = archivedState
+	}
+
+	subnetOwner, err := subnetGetter.GetSubnetOwner(args.SubnetID)
 	if err != nil {
 		return err
 	}
@@ -474,7 +542,7 @@ func (s *Service) GetSubnet(_ *http.Request, args *GetSubnetArgs, response *GetS
 	response.Threshold = avajson.Uint32(owner.Threshold)
 	response.Locktime = avajson.Uint64(owner.Locktime)
 
-	switch subnetTransformationTx, err := s.vm.state.GetSubnetTransformation(args.SubnetID); err {
+	switch subnetTransformationTx, err := subnetGetter.GetSubnetTransformation(args.SubnetID); err {
 	case nil:
 		response.IsPermissioned = false
 		response.SubnetTransformationTxID = subnetTransformationTx.ID()
@@ -683,6 +751,11 @@ type GetCurrentValidatorsArgs struct {
 	// some nodeIDs are not currently validators, they
 	// will be omitted from the response.
 	NodeIDs []ids.NodeID `json:"nodeIDs"`
+	// Height of the accepted block to fetch the validators at. If omitted,
+	// the validators are fetched from the last accepted state. Uptimes,
+	// connectivity and accrued delegatee rewards are not reported for
+	// historical validators.
+	Height *avajson.Uint64 `json:"height"`
 }
 
 // GetCurrentValidatorsReply are the results from calling GetCurrentValidators.
@@ -755,7 +828,21 @@ func (s *Service) GetCurrentValidators(_ *http.Request, args *GetCurrentValidato
 
 	numNodeIDs := nodeIDs.Len()
 	targetStakers := make([]*state.Staker, 0, numNodeIDs)
-	if numNodeIDs == 0 { // Include all nodes
+	if args.Height != nil {
+		archivedState, err := s.vm.state.GetArchivedState(uint64(*args.Height))
+		if err != nil {
+			return fmt.Errorf("couldn't get state at height %d: %w", *args.Height, err)
+		}
+		stakers, err := archivedState.GetCurrentStakers(args.SubnetID)
+		if err != nil {
+			return err
+		}
+		for _, staker := range stakers {
+			if numNodeIDs == 0 || nodeIDs.Contains(staker.NodeID) {
+				targetStakers = append(targetStakers, staker)
+			}
+		}
+	} else if numNodeIDs == 0 { // Include all nodes
 		currentStakerIterator, err := s.vm.state.GetCurrentStakerIterator()
 		if err != nil {
 			return err
@@ -808,11 +895,14 @@ func (s *Service) GetCurrentValidators(_ *http.Request, args *GetCurrentValidato
 		}
 		potentialReward := avajson.Uint64(currentStaker.PotentialReward)
 
-		delegateeReward, err := s.vm.state.GetDelegateeReward(currentStaker.SubnetID, currentStaker.NodeID)
-		if err != nil {
-			return err
+		var jsonDelegateeReward *avajson.Uint64
+		if args.Height == nil {
+			delegateeReward, err := s.vm.state.GetDelegateeReward(currentStaker.SubnetID, currentStaker.NodeID)
+			if err != nil {
+				return err
+			}
+			jsonDelegateeReward = (*avajson.Uint64)(&delegateeReward)
 		}
-		jsonDelegateeReward := avajson.Uint64(delegateeReward)
 
 		switch currentStaker.Priority {
 		case txs.PrimaryNetworkValidatorCurrentPriority, txs.SubnetPermissionlessValidatorCurrentPriority:
@@ -824,12 +914,11 @@ func (s *Service) GetCurrentValidators(_ *http.Request, args *GetCurrentValidato
 			shares := attr.shares
 			delegationFee := avajson.Float32(100 * float32(shares) / float32(reward.PercentDenominator))
 
-			uptime, err := s.getAPIUptime(currentStaker)
+			uptime, connected, err := s.getAPIUptimeAndConnected(currentStaker, args.Height)
 			if err != nil {
 				return err
 			}
 
-			connected := s.vm.uptimeManager.IsConnected(nodeID, args.SubnetID)
 			var (
 				validationRewardOwner *platformapi.Owner
 				delegationRewardOwner *platformapi.Owner
@@ -854,7 +943,7 @@ func (s *Service) GetCurrentValidators(_ *http.Request, args *GetCurrentValidato
 				Uptime:                 uptime,
 				Connected:              connected,
 				PotentialReward:        &potentialReward,
-				AccruedDelegateeReward: &jsonDelegateeReward,
+				AccruedDelegateeReward: jsonDelegateeReward,
 				RewardOwner:            validationRewardOwner,
 				ValidationRewardOwner:  validationRewardOwner,
 				DelegationRewardOwner:  delegationRewardOwner,
@@ -889,11 +978,10 @@ func (s *Service) GetCurrentValidators(_ *http.Request, args *GetCurrentValidato
 			vdrToDelegators[delegator.NodeID] = append(vdrToDelegators[delegator.NodeID], delegator)
 
 		case txs.SubnetPermissionedValidatorCurrentPriority:
-			uptime, err := s.getAPIUptime(currentStaker)
+			uptime, connected, err := s.getAPIUptimeAndConnected(currentStaker, args.Height)
 			if err != nil {
 				return err
 			}
-			connected := s.vm.uptimeManager.IsConnected(nodeID, args.SubnetID)
 			reply.Validators = append(reply.Validators, platformapi.PermissionedValidator{
 				Staker:    apiStaker,
 				Connected: connected,
@@ -939,6 +1027,9 @@ func (s *Service) GetCurrentValidators(_ *http.Request, args *GetCurrentValidato
 // GetCurrentSupplyArgs are the arguments for calling GetCurrentSupply
 type GetCurrentSupplyArgs struct {
 	SubnetID ids.ID `json:"subnetID"`
+	// Height of the accepted block to fetch the supply at. If omitted, the
+	// supply is fetched from the last accepted state.
+	Height *avajson.Uint64 `json:"height"`
 }
 
 // GetCurrentSupplyReply are the results from calling GetCurrentSupply
@@ -957,6 +1048,20 @@ func (s *Service) GetCurrentSupply(r *http.Request, args *GetCurrentSupplyArgs,
 	s.vm.ctx.Lock.Lock()
 	defer s.vm.ctx.Lock.Unlock()
 
+	if args.Height != nil {
+		archivedState, err := s.vm.state.GetArchivedState(uint64(*args.Height))
+		if err != nil {
+			return fmt.Errorf("couldn't get state at height %d: %w", *args.Height, err)
+		}
+		supply, err := archivedState.GetCurrentSupply(args.SubnetID)
+		if err != nil {
+			return fmt.Errorf("fetching supply failed: %w", err)
+		}
+		reply.Supply = avajson.Uint64(supply)
+		reply.Height = *args.Height
+		return nil
+	}
+
 	supply, err := s.vm.state.GetCurrentSupply(args.SubnetID)
 	if err != nil {
 		return fmt.Errorf("fetching current supply failed: %w", err)
@@ -1302,6 +1407,250 @@ func (s *Service) IssueTx(_ *http.Request, args *api.FormattedTx, response *api.
 	return nil
 }
 
+// SimulatedStaker is a staker that a simulated transaction adds or removes.
+type SimulatedStaker struct {
+	TxID            ids.ID         `json:"txID"`
+	NodeID          ids.NodeID     `json:"nodeID"`
+	SubnetID        ids.ID         `json:"subnetID"`
+	Weight          avajson.Uint64 `json:"weight"`
+	StartTime       avajson.Uint64 `json:"startTime"`
+	EndTime         avajson.Uint64 `json:"endTime"`
+	PotentialReward avajson.Uint64 `json:"potentialReward"`
+	Delegator       bool           `json:"delegator"`
+	Pending         bool           `json:"pending"`
+	Removed         bool           `json:"removed"`
+}
+
+// SimulationError describes why a simulated transaction would be rejected.
+type SimulationError struct {
+	// Message is the complete verification error.
+	Message string `json:"message"`
+	// Reason is the underlying verification failure, if it is known.
+	Reason string `json:"reason,omitempty"`
+}
+
+// SimulateTxReply is the response from SimulateTx
+type SimulateTxReply struct {
+	TxID ids.ID `json:"txID"`
+	// ConsumedUTXOs are the UTXOs spent by the tx, including imported UTXOs.
+	ConsumedUTXOs []string `json:"consumedUTXOs"`
+	// ProducedUTXOs are the UTXOs created by the tx, including exported UTXOs.
+	ProducedUTXOs []string            `json:"producedUTXOs"`
+	StakerChanges []SimulatedStaker   `json:"stakerChanges"`
+	Burned        avajson.Uint64      `json:"burned"`
+	Encoding      formatting.Encoding `json:"encoding"`
+	// Error is set if the tx would be rejected, in which case the other fields
+	// are empty.
+	Error *SimulationError `json:"error,omitempty"`
+}
+
+// simulationErrorReasons are the verification failures reported as the reason
+// of a simulation error. More specific errors are listed first.
+var simulationErrorReasons = []error{
+	secp256k1fx.ErrWrongSig,
+	secp256k1fx.ErrTooFewSigners,
+	secp256k1fx.ErrTooManySigners,
+	secp256k1fx.ErrInputCredentialSignersMismatch,
+	secp256k1fx.ErrTimelocked,
+	secp256k1fx.ErrMismatchedAmounts,
+	utxo.ErrInsufficientFunds,
+	utxo.ErrInsufficientUnlockedFunds,
+	utxo.ErrInsufficientLockedFunds,
+	executor.ErrWeightTooSmall,
+	executor.ErrWeightTooLarge,
+	executor.ErrInsufficientDelegationFee,
+	executor.ErrStakeTooShort,
+	executor.ErrStakeTooLong,
+	executor.ErrNotValidator,
+	executor.ErrRemovePermissionlessValidator,
+	executor.ErrStakeOverflow,
+	executor.ErrPeriodMismatch,
+	executor.ErrOverDelegated,
+	executor.ErrIsNotTransformSubnetTx,
+	executor.ErrTimestampNotBeforeStartTime,
+	executor.ErrAlreadyValidator,
+	executor.ErrDuplicateValidator,
+	executor.ErrDelegateToPermissionedValidator,
+	executor.ErrWrongStakedAssetID,
+	executor.ErrDurangoUpgradeNotActive,
+	executor.ErrAddValidatorTxPostDurango,
+	executor.ErrAddDelegatorTxPostDurango,
+	executor.ErrFlowCheckFailed,
+	executor.ErrWrongTxType,
+	database.ErrNotFound,
+}
+
+// SimulateTx executes a transaction on top of the currently preferred state,
+// without issuing it, and returns the changes it would make.
+func (s *Service) SimulateTx(_ *http.Request, args *api.FormattedTx, reply *SimulateTxReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "simulateTx"),
+	)
+
+	txBytes, err := formatting.Decode(args.Encoding, args.Tx)
+	if err != nil {
+		return fmt.Errorf("problem decoding transaction: %w", err)
+	}
+	tx, err := txs.Parse(txs.Codec, txBytes)
+	if err != nil {
+		return fmt.Errorf("couldn't parse tx: %w", err)
+	}
+	reply.TxID = tx.ID()
+	reply.Encoding = args.Encoding
+
+	if err := tx.SyntacticVerify(s.vm.ctx); err != nil {
+		reply.Error = newSimulationError(err)
+		return nil
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	simulation, err := s.vm.manager.SimulateTx(tx)
+	if err != nil {
+		return fmt.Errorf("couldn't simulate tx: %w", err)
+	}
+	if simulation.Err != nil {
+		reply.Error = newSimulationError(simulation.Err)
+		return nil
+	}
+
+	consumed := simulation.Consumed
+	produced := simulation.Produced
+	for peerChainID, requests := range simulation.AtomicRequests {
+		imported, err := s.vm.ctx.SharedMemory.Get(peerChainID, requests.RemoveRequests)
+		if err != nil {
+			return fmt.Errorf("couldn't get imported UTXOs: %w", err)
+		}
+		for _, utxoBytes := range imported {
+			utxo := &avax.UTXO{}
+			if _, err := txs.Codec.Unmarshal(utxoBytes, utxo); err != nil {
+				return fmt.Errorf("couldn't parse imported UTXO: %w", err)
+			}
+			consumed = append(consumed, utxo)
+		}
+		for _, element := range requests.PutRequests {
+			utxo := &avax.UTXO{}
+			if _, err := txs.Codec.Unmarshal(element.Value, utxo); err != nil {
+				return fmt.Errorf("couldn't parse exported UTXO: %w", err)
+			}
+			produced = append(produced, utxo)
+		}
+	}
+
+	var staked []*avax.TransferableOutput
+	if stakerTx, ok := tx.Unsigned.(txs.PermissionlessStaker); ok {
+		staked = stakerTx.Stake()
+	}
+	burned, err := avaxBurned(s.vm.ctx.AVAXAssetID, consumed, produced, staked)
+	if err != nil {
+		return fmt.Errorf("couldn't calculate burned amount: %w", err)
+	}
+	reply.Burned = avajson.Uint64(burned)
+
+	reply.ConsumedUTXOs, err = encodeUTXOs(args.Encoding, consumed)
+	if err != nil {
+		return err
+	}
+	reply.ProducedUTXOs, err = encodeUTXOs(args.Encoding, produced)
+	if err != nil {
+		return err
+	}
+
+	reply.StakerChanges = make([]SimulatedStaker, len(simulation.StakerChanges))
+	for i, change := range simulation.StakerChanges {
+		reply.StakerChanges[i] = SimulatedStaker{
+			TxID:            change.TxID,
+			NodeID:          change.NodeID,
+			SubnetID:        change.SubnetID,
+			Weight:          avajson.Uint64(change.Weight),
+			StartTime:       avajson.Uint64(change.StartTime.Unix()),
+			EndTime:         avajson.Uint64(change.EndTime.Unix()),
+			PotentialReward: avajson.Uint64(change.PotentialReward),
+			Delegator:       change.Priority.IsDelegator(),
+			Pending:         change.Priority.IsPending(),
+			Removed:         change.Removed,
+		}
+	}
+	return nil
+}
+
+func newSimulationError(err error) *SimulationError {
+	simulationErr := &SimulationError{
+		Message: err.Error(),
+	}
+	for _, reason := range simulationErrorReasons {
+		if errors.Is(err, reason) {
+			simulationErr.Reason = reason.Error()
+			break
+		}
+	}
+	return simulationErr
+}
+
+// avaxBurned returns the amount of AVAX consumed that isn't produced or
+// staked.
+func avaxBurned(
+	avaxAssetID ids.ID,
+	consumed []*avax.UTXO,
+	produced []*avax.UTXO,
+	staked []*avax.TransferableOutput,
+) (uint64, error) {
+	var (
+		consumedAmount uint64
+		producedAmount uint64
+		err            error
+	)
+	for _, utxo := range consumed {
+		if utxo.AssetID() != avaxAssetID {
+			continue
+		}
+		if out, ok := utxo.Out.(avax.Amounter); ok {
+			consumedAmount, err = safemath.Add64(consumedAmount, out.Amount())
+			if err != nil {
+				return 0, err
+			}
+		}
+	}
+	for _, utxo := range produced {
+		if utxo.AssetID() != avaxAssetID {
+			continue
+		}
+		if out, ok := utxo.Out.(avax.Amounter); ok {
+			producedAmount, err = safemath.Add64(producedAmount, out.Amount())
+			if err != nil {
+				return 0, err
+			}
+		}
+	}
+	for _, out := range staked {
+		if out.AssetID() != avaxAssetID {
+			continue
+		}
+		producedAmount, err = safemath.Add64(producedAmount, out.Output().Amount())
+		if err != nil {
+			return 0, err
+		}
+	}
+	return safemath.Sub(consumedAmount, producedAmount)
+}
+
+func encodeUTXOs(encoding formatting.Encoding, utxos []*avax.UTXO) ([]string, error) {
+	encoded := make([]string, len(utxos))
+	for i, utxo := range utxos {
+		utxoBytes, err := txs.Codec.Marshal(txs.CodecVersion, utxo)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't serialize UTXO %q: %w", utxo.InputID(), err)
+		}
+		encoded[i], err = formatting.Encode(encoding, utxoBytes)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't encode UTXO %s as %s: %w", utxo.InputID(), encoding, err)
+		}
+	}
+	return encoded, nil
+}
+
 func (s *Service) GetTx(_ *http.Request, args *api.GetTxArgs, response *api.GetTxReply) error {
 	s.vm.ctx.Log.Debug("API called",
 		zap.String("service", "platform"),
@@ -1635,6 +1984,442 @@ func (s *Service) GetRewardUTXOs(_ *http.Request, args *api.GetTxArgs, reply *Ge
 	return nil
 }
 
+// EstimateRewardArgs are the arguments for calling EstimateReward
+type EstimateRewardArgs struct {
+	// Subnet the stake would be added to
+	// If omitted, defaults to the primary network
+	SubnetID ids.ID `json:"subnetID"`
+	// Amount of tokens that would be staked
+	Amount avajson.Uint64 `json:"amount"`
+	// Duration of the stake, in seconds
+	Duration avajson.Uint64 `json:"duration"`
+	// Delegation fee, as a percentage, charged by the validator the stake
+	// would be delegated to. If omitted, the stake is treated as a validator
+	// and the full reward is attributed to the staker.
+	DelegationFee *avajson.Float32 `json:"delegationFee"`
+}
+
+// EstimateRewardReply are the results from calling EstimateReward
+type EstimateRewardReply struct {
+	// PotentialReward is the total reward minted if the stake is rewarded
+	PotentialReward avajson.Uint64 `json:"potentialReward"`
+	// StakerReward is the part of [PotentialReward] paid to the staker
+	StakerReward avajson.Uint64 `json:"stakerReward"`
+	// DelegateeReward is the part of [PotentialReward] paid to the validator
+	// as a delegation fee
+	DelegateeReward avajson.Uint64 `json:"delegateeReward"`
+	// CurrentSupply is the supply the reward was calculated from
+	CurrentSupply avajson.Uint64 `json:"currentSupply"`
+}
+
+// EstimateReward returns the reward that a stake would earn if it was added
+// to the last accepted state and met the uptime requirement. Staking limits,
+// such as the minimum stake amount and duration, are not checked.
+func (s *Service) EstimateReward(_ *http.Request, args *EstimateRewardArgs, reply *EstimateRewardReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "estimateReward"),
+	)
+
+	if args.Amount == 0 {
+		return errMissingStakeAmount
+	}
+	if args.Duration == 0 {
+		return errMissingStakeDuration
+	}
+	var shares uint32
+	if args.DelegationFee != nil {
+		var err error
+		shares, err = delegationFeeToShares(*args.DelegationFee)
+		if err != nil {
+			return err
+		}
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	minStakeDuration := s.vm.MinStakeDuration
+	maxStakeDuration := s.vm.MaxStakeDuration
+	if args.SubnetID != constants.PrimaryNetworkID {
+		transformSubnet, err := executor.GetTransformSubnetTx(s.vm.state, args.SubnetID)
+		if err != nil {
+			return fmt.Errorf("couldn't get staking config of subnet %s: %w", args.SubnetID, err)
+		}
+		minStakeDuration = time.Duration(transformSubnet.MinStakeDuration) * time.Second
+		maxStakeDuration = time.Duration(transformSubnet.MaxStakeDuration) * time.Second
+	}
+	maxStakeDuration = min(maxStakeDuration, s.vm.RewardConfig.MintingPeriod)
+
+	// Compare in seconds so that large durations can't overflow.
+	var (
+		minDurationSeconds = uint64(minStakeDuration / time.Second)
+		maxDurationSeconds = uint64(maxStakeDuration / time.Second)
+	)
+	if uint64(args.Duration) < minDurationSeconds || uint64(args.Duration) > maxDurationSeconds {
+		return fmt.Errorf("%w: %d seconds isn't in [%d, %d]",
+			errInvalidStakeDuration,
+			args.Duration,
+			minDurationSeconds,
+			maxDurationSeconds,
+		)
+	}
+
+	rewards, err := executor.GetRewardsCalculator(s.vm.txExecutorBackend, s.vm.state, args.SubnetID)
+	if err != nil {
+		return fmt.Errorf("couldn't get reward config of subnet %s: %w", args.SubnetID, err)
+	}
+	supply, err := s.vm.state.GetCurrentSupply(args.SubnetID)
+	if err != nil {
+		return fmt.Errorf("fetching current supply failed: %w", err)
+	}
+
+	potentialReward := rewards.Calculate(
+		time.Duration(args.Duration)*time.Second,
+		uint64(args.Amount),
+		supply,
+	)
+	delegateeReward, stakerReward := reward.Split(potentialReward, shares)
+
+	reply.PotentialReward = avajson.Uint64(potentialReward)
+	reply.StakerReward = avajson.Uint64(stakerReward)
+	reply.DelegateeReward = avajson.Uint64(delegateeReward)
+	reply.CurrentSupply = avajson.Uint64(supply)
+	return nil
+}
+
+// GetStakerRewardProjectionReply are the results from calling
+// GetStakerRewardProjection
+type GetStakerRewardProjectionReply struct {
+	TxID      ids.ID         `json:"txID"`
+	NodeID    ids.NodeID     `json:"nodeID"`
+	SubnetID  ids.ID         `json:"subnetID"`
+	StartTime avajson.Uint64 `json:"startTime"`
+	EndTime   avajson.Uint64 `json:"endTime"`
+	Weight    avajson.Uint64 `json:"weight"`
+	// PotentialReward is the total reward minted if the staker is rewarded
+	PotentialReward avajson.Uint64 `json:"potentialReward"`
+	// StakerReward is the amount paid to the staker if it is rewarded. For
+	// validators, this includes the delegation fees accrued so far.
+	StakerReward avajson.Uint64 `json:"stakerReward"`
+	// DelegateeReward is the delegation fee paid to the validator if the
+	// delegator is rewarded. Only reported for delegators.
+	DelegateeReward *avajson.Uint64 `json:"delegateeReward,omitempty"`
+	// AccruedDelegateeReward is the delegation fees accrued so far. Only
+	// reported for validators.
+	AccruedDelegateeReward *avajson.Uint64 `json:"accruedDelegateeReward,omitempty"`
+	// Uptime is the current uptime, as a percentage, of the validator that the
+	// uptime requirement applies to, as observed by this node.
+	Uptime avajson.Float32 `json:"uptime"`
+	// UptimeRequirement is the minimum uptime, as a percentage, required to
+	// be rewarded.
+	UptimeRequirement avajson.Float32 `json:"uptimeRequirement"`
+	// MeetsUptimeRequirement is true if this node would currently vote to
+	// reward the staker.
+	MeetsUptimeRequirement bool `json:"meetsUptimeRequirement"`
+	// ProjectedReward is [StakerReward] if the uptime requirement is currently
+	// met, and 0 otherwise.
+	ProjectedReward avajson.Uint64 `json:"projectedReward"`
+}
+
+// GetStakerRewardProjection returns the reward that a current validator or
+// delegator would receive, and whether it currently meets the uptime
+// requirement to receive it.
+func (s *Service) GetStakerRewardProjection(_ *http.Request, args *api.GetTxArgs, reply *GetStakerRewardProjectionReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getStakerRewardProjection"),
+	)
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	staker, err := s.getCurrentStaker(args.TxID)
+	if err != nil {
+		return err
+	}
+
+	reply.TxID = staker.TxID
+	reply.NodeID = staker.NodeID
+	reply.SubnetID = staker.SubnetID
+	reply.StartTime = avajson.Uint64(staker.StartTime.Unix())
+	reply.EndTime = avajson.Uint64(staker.EndTime.Unix())
+	reply.Weight = avajson.Uint64(staker.Weight)
+	reply.PotentialReward = avajson.Uint64(staker.PotentialReward)
+
+	stakerReward := staker.PotentialReward
+	switch staker.Priority {
+	case txs.PrimaryNetworkDelegatorCurrentPriority, txs.SubnetPermissionlessDelegatorCurrentPriority:
+		validator, err := s.vm.state.GetCurrentValidator(staker.SubnetID, staker.NodeID)
+		if err != nil {
+			return fmt.Errorf("couldn't get validator of delegator %s: %w", staker.TxID, err)
+		}
+		attr, err := s.loadStakerTxAttributes(validator.TxID)
+		if err != nil {
+			return err
+		}
+		delegateeReward, delegatorReward := reward.Split(staker.PotentialReward, attr.shares)
+		stakerReward = delegatorReward
+		reply.DelegateeReward = (*avajson.Uint64)(&delegateeReward)
+	default:
+		accruedDelegateeReward, err := s.vm.state.GetDelegateeReward(staker.SubnetID, staker.NodeID)
+		if err != nil {
+			return err
+		}
+		stakerReward, err = safemath.Add64(stakerReward, accruedDelegateeReward)
+		if err != nil {
+			return err
+		}
+		reply.AccruedDelegateeReward = (*avajson.Uint64)(&accruedDelegateeReward)
+	}
+	reply.StakerReward = avajson.Uint64(stakerReward)
+
+	uptimeRequirement, err := s.getUptimeRequirement(staker.SubnetID)
+	if err != nil {
+		return err
+	}
+
+	// Rewards are voted on using the primary network uptime of the node, in
+	// the same way as when a block rewarding the staker is proposed.
+	primaryNetworkValidator, err := s.vm.state.GetCurrentValidator(constants.PrimaryNetworkID, staker.NodeID)
+	if err != nil {
+		return fmt.Errorf("couldn't get primary network validator %s: %w", staker.NodeID, err)
+	}
+	uptime, err := s.vm.uptimeManager.CalculateUptimePercentFrom(
+		staker.NodeID,
+		constants.PrimaryNetworkID,
+		primaryNetworkValidator.StartTime,
+	)
+	if err != nil {
+		return fmt.Errorf("couldn't calculate uptime: %w", err)
+	}
+
+	// Transform these to percentages (0-100) to make them consistent with
+	// getCurrentValidators
+	reply.Uptime = avajson.Float32(uptime * 100)
+	reply.UptimeRequirement = avajson.Float32(uptimeRequirement * 100)
+	reply.MeetsUptimeRequirement = uptime >= uptimeRequirement
+	if reply.MeetsUptimeRequirement {
+		reply.ProjectedReward = reply.StakerReward
+	}
+	return nil
+}
+
+// getCurrentStaker returns the current staker added by [txID].
+func (s *Service) getCurrentStaker(txID ids.ID) (*state.Staker, error) {
+	tx, _, err := s.vm.state.GetTx(txID)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get tx %s: %w", txID, err)
+	}
+	stakerTx, ok := tx.Unsigned.(txs.Staker)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", errNotCurrentStaker, txID)
+	}
+
+	subnetID := stakerTx.SubnetID()
+	nodeID := stakerTx.NodeID()
+	validator, err := s.vm.state.GetCurrentValidator(subnetID, nodeID)
+	switch {
+	case err == database.ErrNotFound:
+		return nil, fmt.Errorf("%w: %s", errNotCurrentStaker, txID)
+	case err != nil:
+		return nil, err
+	case validator.TxID == txID:
+		return validator, nil
+	}
+
+	delegatorsIt, err := s.vm.state.GetCurrentDelegatorIterator(subnetID, nodeID)
+	if err != nil {
+		return nil, err
+	}
+	defer delegatorsIt.Release()
+
+	for delegatorsIt.Next() {
+		delegator := delegatorsIt.Value()
+		if delegator.TxID == txID {
+			return delegator, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", errNotCurrentStaker, txID)
+}
+
+// getUptimeRequirement returns the minimum uptime required for stakers of
+// [subnetID] to be rewarded.
+func (s *Service) getUptimeRequirement(subnetID ids.ID) (float64, error) {
+	if subnetID == constants.PrimaryNetworkID {
+		return s.vm.UptimePercentage, nil
+	}
+
+	transformSubnet, err := executor.GetTransformSubnetTx(s.vm.state, subnetID)
+	if err != nil {
+		return 0, fmt.Errorf("couldn't get reward config of subnet %s: %w", subnetID, err)
+	}
+	return float64(transformSubnet.UptimeRequirement) / reward.PercentDenominator, nil
+}
+
+// delegationFeeToShares converts a delegation fee percentage into the shares
+// used by validator transactions.
+func delegationFeeToShares(fee avajson.Float32) (uint32, error) {
+	if fee < 0 || fee > 100 {
+		return 0, errInvalidDelegationFee
+	}
+	return uint32(math.Round(float64(fee) * reward.PercentDenominator / 100)), nil
+}
+
+// GetUptimeHistoryArgs are the arguments for calling GetUptimeHistory
+type GetUptimeHistoryArgs struct {
+	NodeID ids.NodeID `json:"nodeID"`
+	// Subnet the node is validating
+	// If omitted, defaults to the primary network
+	SubnetID ids.ID `json:"subnetID"`
+	// Unix time of the first sample to return. If omitted, the samples are
+	// returned from the oldest retained sample.
+	StartTime avajson.Uint64 `json:"startTime"`
+	// Unix time of the last sample to return. If omitted, defaults to now.
+	EndTime avajson.Uint64 `json:"endTime"`
+}
+
+// APIUptimeSample is the uptime of a validator observed by this node at a
+// point in time
+type APIUptimeSample struct {
+	Timestamp avajson.Uint64 `json:"timestamp"`
+	// Duration, in seconds, that the validator was observed to be online
+	// since it started validating
+	UpDuration avajson.Uint64 `json:"upDuration"`
+	// Uptime, as a percentage, since the validator started validating
+	Uptime    avajson.Float32 `json:"uptime"`
+	Connected bool            `json:"connected"`
+	// MeetsUptimeRequirement is only reported if the subnet has an uptime
+	// requirement
+	MeetsUptimeRequirement *bool `json:"meetsUptimeRequirement,omitempty"`
+}
+
+// GetUptimeHistoryReply are the results from calling GetUptimeHistory
+type GetUptimeHistoryReply struct {
+	// UptimeRequirement is the minimum uptime, as a percentage, required to
+	// be rewarded. It is omitted for subnets without staking rewards.
+	UptimeRequirement *avajson.Float32 `json:"uptimeRequirement,omitempty"`
+	// Samples are sorted from oldest to newest
+	Samples []APIUptimeSample `json:"samples"`
+}
+
+// GetUptimeHistory returns the uptime samples of a validator that were
+// recorded by this node.
+func (s *Service) GetUptimeHistory(_ *http.Request, args *GetUptimeHistoryArgs, reply *GetUptimeHistoryReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getUptimeHistory"),
+		zap.Stringer("nodeID", args.NodeID),
+	)
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	endTime := s.vm.clock.UnixTime()
+	if args.EndTime != 0 {
+		endTime = time.Unix(int64(args.EndTime), 0)
+	}
+	samples, err := s.vm.state.GetUptimeHistory(
+		args.SubnetID,
+		args.NodeID,
+		time.Unix(int64(args.StartTime), 0),
+		endTime,
+	)
+	if err != nil {
+		return fmt.Errorf("couldn't get uptime history: %w", err)
+	}
+
+	uptimeRequirement, err := s.getUptimeRequirement(args.SubnetID)
+	hasUptimeRequirement := err == nil
+	switch {
+	case hasUptimeRequirement:
+		jsonUptimeRequirement := avajson.Float32(uptimeRequirement * 100)
+		reply.UptimeRequirement = &jsonUptimeRequirement
+	case !errors.Is(err, database.ErrNotFound):
+		return err
+	}
+
+	reply.Samples = make([]APIUptimeSample, len(samples))
+	for i, sample := range samples {
+		uptime := sample.Uptime()
+		apiSample := APIUptimeSample{
+			Timestamp:  avajson.Uint64(sample.Timestamp.Unix()),
+			UpDuration: avajson.Uint64(sample.UpDuration / time.Second),
+			// Transform this to a percentage (0-100) to make it consistent
+			// with getCurrentValidators
+			Uptime:    avajson.Float32(uptime * 100),
+			Connected: sample.Connected,
+		}
+		if hasUptimeRequirement {
+			meetsUptimeRequirement := uptime >= uptimeRequirement
+			apiSample.MeetsUptimeRequirement = &meetsUptimeRequirement
+		}
+		reply.Samples[i] = apiSample
+	}
+	return nil
+}
+
+// GetAddressTxsArgs are the arguments for GetAddressTxs
+type GetAddressTxsArgs struct {
+	api.JSONAddress
+	// AssetID defaults to AVAX if omitted
+	AssetID ids.ID `json:"assetID"`
+	// Cursor used as a page index / offset
+	Cursor avajson.Uint64 `json:"cursor"`
+	// PageSize num of items per page
+	PageSize avajson.Uint64 `json:"pageSize"`
+}
+
+// GetAddressTxsReply is the response from GetAddressTxs
+type GetAddressTxsReply struct {
+	TxIDs []ids.ID `json:"txIDs"`
+	// Cursor used as a page index / offset
+	Cursor avajson.Uint64 `json:"cursor"`
+}
+
+// GetAddressTxs returns the IDs of the accepted txs that consumed or produced
+// outputs of the provided asset owned by the provided address.
+func (s *Service) GetAddressTxs(_ *http.Request, args *GetAddressTxsArgs, reply *GetAddressTxsReply) error {
+	cursor := uint64(args.Cursor)
+	pageSize := uint64(args.PageSize)
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getAddressTxs"),
+		logging.UserString("address", args.Address),
+		zap.Stringer("assetID", args.AssetID),
+		zap.Uint64("cursor", cursor),
+		zap.Uint64("pageSize", pageSize),
+	)
+
+	if pageSize > maxPageSize {
+		return fmt.Errorf("pageSize > maximum allowed (%d)", maxPageSize)
+	} else if pageSize == 0 {
+		pageSize = maxPageSize
+	}
+
+	address, err := avax.ParseServiceAddress(s.addrManager, args.Address)
+	if err != nil {
+		return fmt.Errorf("couldn't parse argument 'address' to address: %w", err)
+	}
+
+	assetID := args.AssetID
+	if assetID == ids.Empty {
+		assetID = s.vm.ctx.AVAXAssetID
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	reply.TxIDs, err = s.vm.state.GetAddressTxs(address, assetID, cursor, pageSize)
+	if err != nil {
+		return fmt.Errorf("couldn't get address txs: %w", err)
+	}
+
+	// To get the next set of tx IDs, the user should provide this cursor.
+	reply.Cursor = avajson.Uint64(cursor + uint64(len(reply.TxIDs)))
+	return nil
+}
+
 // GetTimestampReply is the response from GetTimestamp
 type GetTimestampReply struct {
 	// Current timestamp
@@ -1655,6 +2440,61 @@ func (s *Service) GetTimestamp(_ *http.Request, _ *struct{}, reply *GetTimestamp
 	return nil
 }
 
+// GetFeeConfigReply is the response from GetFeeConfig
+type GetFeeConfigReply struct {
+	Weights                  [gas.NumDimensions]avajson.Uint64 `json:"weights"`
+	MaxGasPerBlock           avajson.Uint64                    `json:"maxGasPerBlock"`
+	TargetGasPerBlock        avajson.Uint64                    `json:"targetGasPerBlock"`
+	MinPrice                 avajson.Uint64                    `json:"minPrice"`
+	ExcessConversionConstant avajson.Uint64                    `json:"excessConversionConstant"`
+}
+
+// GetFeeConfig returns the config used to calculate dynamic fees.
+func (s *Service) GetFeeConfig(_ *http.Request, _ *struct{}, reply *GetFeeConfigReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getFeeConfig"),
+	)
+
+	config := s.vm.DynamicFeeConfig
+	for i, weight := range config.Weights {
+		reply.Weights[i] = avajson.Uint64(weight)
+	}
+	reply.MaxGasPerBlock = avajson.Uint64(config.MaxGasPerBlock)
+	reply.TargetGasPerBlock = avajson.Uint64(config.TargetGasPerBlock)
+	reply.MinPrice = avajson.Uint64(config.MinPrice)
+	reply.ExcessConversionConstant = avajson.Uint64(config.ExcessConversionConstant)
+	return nil
+}
+
+// GetFeeStateReply is the response from GetFeeState
+type GetFeeStateReply struct {
+	Excess avajson.Uint64 `json:"excess"`
+	// Price is the gas price that will be charged to txs included in the next
+	// block. If dynamic fees are not active, Price is 0.
+	Price avajson.Uint64 `json:"price"`
+	Time  time.Time      `json:"timestamp"`
+}
+
+// GetFeeState returns the current fee state of the chain.
+func (s *Service) GetFeeState(_ *http.Request, _ *struct{}, reply *GetFeeStateReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getFeeState"),
+	)
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	feeState := s.vm.state.GetFeeState()
+	reply.Excess = avajson.Uint64(feeState.Excess)
+	reply.Time = s.vm.state.GetTimestamp()
+	if s.vm.UpgradeConfig.IsEActivated(reply.Time) {
+		reply.Price = avajson.Uint64(feeState.Price(s.vm.DynamicFeeConfig))
+	}
+	return nil
+}
+
 // GetValidatorsAtArgs is the response from GetValidatorsAt
 type GetValidatorsAtArgs struct {
 	Height   avajson.Uint64 `json:"height"`
@@ -1748,6 +2588,94 @@ func (s *Service) GetValidatorsAt(r *http.Request, args *GetValidatorsAtArgs, re
 	return nil
 }
 
+// AggregateWarpSignaturesArgs are the arguments for AggregateWarpSignatures
+type AggregateWarpSignaturesArgs struct {
+	// Message is the unsigned warp message to aggregate signatures for
+	Message string `json:"message"`
+	// Justification is optional data sent to validators to convince them to
+	// sign Message
+	Justification string `json:"justification"`
+	// PChainHeight is the height of the validator set to request signatures
+	// from. If 0, the current P-chain height is used.
+	PChainHeight avajson.Uint64 `json:"pChainHeight"`
+	// QuorumNum and QuorumDen specify the fraction of the validator weight
+	// that must sign Message. If QuorumDen is 0, 67/100 is used.
+	QuorumNum avajson.Uint64      `json:"quorumNum"`
+	QuorumDen avajson.Uint64      `json:"quorumDen"`
+	Encoding  formatting.Encoding `json:"encoding"`
+}
+
+// AggregateWarpSignaturesReply is the response from AggregateWarpSignatures
+type AggregateWarpSignaturesReply struct {
+	// Message is the signed warp message
+	Message  string              `json:"message"`
+	Encoding formatting.Encoding `json:"encoding"`
+}
+
+// AggregateWarpSignatures requests signatures of a warp message from the
+// validators of its source subnet and returns the message signed by the
+// aggregate signature.
+func (s *Service) AggregateWarpSignatures(r *http.Request, args *AggregateWarpSignaturesArgs, reply *AggregateWarpSignaturesReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "aggregateWarpSignatures"),
+	)
+
+	messageBytes, err := formatting.Decode(args.Encoding, args.Message)
+	if err != nil {
+		return fmt.Errorf("problem decoding message: %w", err)
+	}
+	message, err := warp.ParseUnsignedMessage(messageBytes)
+	if err != nil {
+		return fmt.Errorf("couldn't parse message: %w", err)
+	}
+
+	var justification []byte
+	if len(args.Justification) > 0 {
+		justification, err = formatting.Decode(args.Encoding, args.Justification)
+		if err != nil {
+			return fmt.Errorf("problem decoding justification: %w", err)
+		}
+	}
+
+	quorumNum, quorumDen := uint64(args.QuorumNum), uint64(args.QuorumDen)
+	if quorumDen == 0 {
+		quorumNum, quorumDen = defaultWarpQuorumNum, defaultWarpQuorumDen
+	}
+
+	ctx := r.Context()
+	pChainHeight := uint64(args.PChainHeight)
+	if pChainHeight == 0 {
+		s.vm.ctx.Lock.Lock()
+		pChainHeight, err = s.vm.GetCurrentHeight(ctx)
+		s.vm.ctx.Lock.Unlock()
+		if err != nil {
+			return fmt.Errorf("failed to get current height: %w", err)
+		}
+	}
+
+	// The context lock must not be held while waiting for signatures, as
+	// serving signature requests requires grabbing the lock.
+	signedMessage, err := s.vm.Network.AggregateSignatures(
+		ctx,
+		message,
+		justification,
+		pChainHeight,
+		quorumNum,
+		quorumDen,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to aggregate signatures: %w", err)
+	}
+
+	reply.Message, err = formatting.Encode(args.Encoding, signedMessage.Bytes())
+	if err != nil {
+		return fmt.Errorf("couldn't encode message as string: %w", err)
+	}
+	reply.Encoding = args.Encoding
+	return nil
+}
+
 func (s *Service) GetBlock(_ *http.Request, args *api.GetBlockArgs, response *api.GetBlockResponse) error {
 	s.vm.ctx.Log.Debug("API called",
 		zap.String("service", "platform"),
@@ -1838,6 +2766,21 @@ func (s *Service) getAPIUptime(staker *state.Staker) (*avajson.Float32, error) {
 	return &uptime, nil
 }
 
+// getAPIUptimeAndConnected returns the uptime and connectivity of [staker].
+// Neither is tracked historically, so they are only reported when [height] is
+// nil.
+func (s *Service) getAPIUptimeAndConnected(staker *state.Staker, height *avajson.Uint64) (*avajson.Float32, bool, error) {
+	if height != nil {
+		return nil, false, nil
+	}
+
+	uptime, err := s.getAPIUptime(staker)
+	if err != nil {
+		return nil, false, err
+	}
+	return uptime, s.vm.uptimeManager.IsConnected(staker.NodeID, staker.SubnetID), nil
+}
+
 func (s *Service) getAPIOwner(owner *secp256k1fx.OutputOwners) (*platformapi.Owner, error) {
 	apiOwner := &platformapi.Owner{
 		Locktime:  avajson.Uint64(owner.Locktime),
@@ -33,8 +33,11 @@ import (
 	"github.com/ava-labs/avalanchego/utils/formatting"
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/gas"
 	"github.com/ava-labs/avalanchego/vms/platformvm/block"
 	"github.com/ava-labs/avalanchego/vms/platformvm/block/builder"
+	"github.com/ava-labs/avalanchego/vms/platformvm/config"
+	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
 	"github.com/ava-labs/avalanchego/vms/platformvm/signer"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
 	"github.com/ava-labs/avalanchego/vms/platformvm/status"
@@ -368,9 +371,10 @@ func TestGetBalance(t *testing.T) {
 	service, _, _ := defaultService(t)
 
 	var (
-		feeCalc         = fee.NewStaticCalculator(service.vm.Config.StaticFeeConfig, service.vm.Config.UpgradeConfig)
-		createSubnetFee = feeCalc.CalculateFee(&txs.CreateSubnetTx{}, service.vm.clock.Time())
+		feeCalc              = fee.NewStaticCalculator(service.vm.Config.StaticFeeConfig, service.vm.Config.UpgradeConfig, service.vm.clock.Time())
+		createSubnetFee, err = feeCalc.CalculateFee(&txs.CreateSubnetTx{})
 	)
+	require.NoError(err)
 
 	// Ensure GetStake is correct for each of the genesis validators
 	genesis, _ := defaultGenesis(t, service.vm.ctx.AVAXAssetID)
@@ -735,6 +739,56 @@ func TestGetTimestamp(t *testing.T) {
 	require.Equal(newTimestamp, reply.Timestamp)
 }
 
+func TestGetFeeConfig(t *testing.T) {
+	require := require.New(t)
+	service, _, _ := defaultService(t)
+
+	reply := GetFeeConfigReply{}
+	require.NoError(service.GetFeeConfig(nil, nil, &reply))
+
+	config := service.vm.DynamicFeeConfig
+	for i, weight := range config.Weights {
+		require.Equal(avajson.Uint64(weight), reply.Weights[i])
+	}
+	require.Equal(avajson.Uint64(config.MaxGasPerBlock), reply.MaxGasPerBlock)
+	require.Equal(avajson.Uint64(config.TargetGasPerBlock), reply.TargetGasPerBlock)
+	require.Equal(avajson.Uint64(config.MinPrice), reply.MinPrice)
+	require.Equal(avajson.Uint64(config.ExcessConversionConstant), reply.ExcessConversionConstant)
+
+	replyJSON, err := json.Marshal(reply)
+	require.NoError(err)
+	require.Contains(string(replyJSON), fmt.Sprintf(`"minPrice":"%d"`, config.MinPrice))
+}
+
+func TestGetFeeState(t *testing.T) {
+	require := require.New(t)
+	service, _, _ := defaultService(t)
+
+	service.vm.ctx.Lock.Lock()
+
+	expectedFeeState := gas.State{
+		Excess: 2 * service.vm.DynamicFeeConfig.ExcessConversionConstant,
+	}
+	service.vm.state.SetFeeState(expectedFeeState)
+
+	service.vm.ctx.Lock.Unlock()
+
+	// Prior to the E upgrade, the static fees are charged.
+	reply := GetFeeStateReply{}
+	require.NoError(service.GetFeeState(nil, nil, &reply))
+	require.Equal(avajson.Uint64(expectedFeeState.Excess), reply.Excess)
+	require.Zero(reply.Price)
+
+	service.vm.ctx.Lock.Lock()
+	service.vm.UpgradeConfig.EUpgradeTime = reply.Time
+	service.vm.ctx.Lock.Unlock()
+
+	require.NoError(service.GetFeeState(nil, nil, &reply))
+	require.Equal(avajson.Uint64(expectedFeeState.Excess), reply.Excess)
+	require.Equal(avajson.Uint64(expectedFeeState.Price(service.vm.DynamicFeeConfig)), reply.Price)
+	require.Greater(uint64(reply.Price), uint64(service.vm.DynamicFeeConfig.MinPrice))
+}
+
 func TestGetBlock(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -1041,3 +1095,505 @@ func TestServiceGetBlockByHeight(t *testing.T) {
 		})
 	}
 }
+
+func TestServiceGetAddressTxs(t *testing.T) {
+	var (
+		avaxAssetID = ids.GenerateTestID()
+		assetID     = ids.GenerateTestID()
+		addr        = ids.GenerateTestShortID()
+		txIDs       = []ids.ID{ids.GenerateTestID(), ids.GenerateTestID()}
+	)
+
+	tests := []struct {
+		name          string
+		args          *GetAddressTxsArgs
+		setup         func(*state.MockState)
+		expectedReply *GetAddressTxsReply
+		expectedErr   error
+	}{
+		{
+			name: "defaults",
+			args: &GetAddressTxsArgs{
+				JSONAddress: api.JSONAddress{Address: addr.String()},
+			},
+			setup: func(s *state.MockState) {
+				s.EXPECT().GetAddressTxs(addr, avaxAssetID, uint64(0), uint64(maxPageSize)).Return(txIDs, nil)
+			},
+			expectedReply: &GetAddressTxsReply{
+				TxIDs:  txIDs,
+				Cursor: 2,
+			},
+		},
+		{
+			name: "cursor and asset",
+			args: &GetAddressTxsArgs{
+				JSONAddress: api.JSONAddress{Address: addr.String()},
+				AssetID:     assetID,
+				Cursor:      5,
+				PageSize:    1,
+			},
+			setup: func(s *state.MockState) {
+				s.EXPECT().GetAddressTxs(addr, assetID, uint64(5), uint64(1)).Return(txIDs[:1], nil)
+			},
+			expectedReply: &GetAddressTxsReply{
+				TxIDs:  txIDs[:1],
+				Cursor: 6,
+			},
+		},
+		{
+			name: "index disabled",
+			args: &GetAddressTxsArgs{
+				JSONAddress: api.JSONAddress{Address: addr.String()},
+			},
+			setup: func(s *state.MockState) {
+				s.EXPECT().GetAddressTxs(addr, avaxAssetID, uint64(0), uint64(maxPageSize)).Return(nil, state.ErrAddressTxsIndexDisabled)
+			},
+			expectedErr: state.ErrAddressTxsIndexDisabled,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+			ctrl := gomock.NewController(t)
+
+			s := state.NewMockState(ctrl)
+			test.setup(s)
+
+			service := &Service{
+				vm: &VM{
+					state: s,
+					ctx: &snow.Context{
+						Log:         logging.NoLog{},
+						AVAXAssetID: avaxAssetID,
+					},
+				},
+			}
+
+			reply := &GetAddressTxsReply{}
+			err := service.GetAddressTxs(nil, test.args, reply)
+			require.ErrorIs(err, test.expectedErr)
+			if test.expectedErr != nil {
+				return
+			}
+			require.Equal(test.expectedReply, reply)
+		})
+	}
+}
+
+func TestSimulateTx(t *testing.T) {
+	require := require.New(t)
+	service, _, txBuilder := defaultService(t)
+	service.vm.ctx.Lock.Lock()
+
+	sk, err := bls.NewSecretKey()
+	require.NoError(err)
+
+	rewardsOwner := &secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{ids.GenerateTestShortID()},
+	}
+	nodeID := ids.GenerateTestNodeID()
+	startTime := service.vm.clock.Time().Add(txexecutor.SyncBound)
+	tx, err := txBuilder.NewAddPermissionlessValidatorTx(
+		&txs.SubnetValidator{
+			Validator: txs.Validator{
+				NodeID: nodeID,
+				Start:  uint64(startTime.Unix()),
+				End:    uint64(startTime.Add(defaultMinStakingDuration).Unix()),
+				Wght:   service.vm.MinValidatorStake,
+			},
+			Subnet: constants.PrimaryNetworkID,
+		},
+		signer.NewProofOfPossession(sk),
+		service.vm.ctx.AVAXAssetID,
+		rewardsOwner,
+		rewardsOwner,
+		reward.PercentDenominator,
+		[]*secp256k1.PrivateKey{keys[0]},
+		common.WithChangeOwner(&secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{keys[0].PublicKey().Address()},
+		}),
+	)
+	require.NoError(err)
+	service.vm.ctx.Lock.Unlock()
+
+	txStr, err := formatting.Encode(formatting.Hex, tx.Bytes())
+	require.NoError(err)
+	args := &api.FormattedTx{
+		Tx:       txStr,
+		Encoding: formatting.Hex,
+	}
+
+	reply := SimulateTxReply{}
+	require.NoError(service.SimulateTx(nil, args, &reply))
+	require.Nil(reply.Error)
+	require.Equal(tx.ID(), reply.TxID)
+	require.NotEmpty(reply.ConsumedUTXOs)
+	require.Len(reply.ProducedUTXOs, len(tx.Unsigned.Outputs()))
+	require.Equal(
+		[]SimulatedStaker{{
+			TxID:            tx.ID(),
+			NodeID:          nodeID,
+			SubnetID:        constants.PrimaryNetworkID,
+			Weight:          avajson.Uint64(service.vm.MinValidatorStake),
+			StartTime:       reply.StakerChanges[0].StartTime,
+			EndTime:         reply.StakerChanges[0].EndTime,
+			PotentialReward: reply.StakerChanges[0].PotentialReward,
+		}},
+		reply.StakerChanges,
+	)
+
+	// The burned amount is the difference between the AVAX consumed and the
+	// AVAX produced or staked.
+	sumAmounts := func(utxoStrs []string) uint64 {
+		var sum uint64
+		for _, utxoStr := range utxoStrs {
+			utxoBytes, err := formatting.Decode(formatting.Hex, utxoStr)
+			require.NoError(err)
+			utxo := &avax.UTXO{}
+			_, err = txs.Codec.Unmarshal(utxoBytes, utxo)
+			require.NoError(err)
+			sum += utxo.Out.(avax.Amounter).Amount()
+		}
+		return sum
+	}
+	require.Equal(
+		sumAmounts(reply.ConsumedUTXOs),
+		sumAmounts(reply.ProducedUTXOs)+service.vm.MinValidatorStake+uint64(reply.Burned),
+	)
+
+	// Simulating doesn't modify the state, so the tx can still be issued.
+	require.NoError(service.vm.Network.IssueTxFromRPC(tx))
+	service.vm.ctx.Lock.Lock()
+	blk, err := service.vm.BuildBlock(context.Background())
+	require.NoError(err)
+	require.NoError(blk.Verify(context.Background()))
+	require.NoError(blk.Accept(context.Background()))
+	require.NoError(service.vm.SetPreference(context.Background(), blk.ID()))
+	service.vm.ctx.Lock.Unlock()
+
+	// Once accepted, the tx is no longer valid.
+	reply = SimulateTxReply{}
+	require.NoError(service.SimulateTx(nil, args, &reply))
+	require.NotNil(reply.Error)
+	require.Equal(txexecutor.ErrDuplicateValidator.Error(), reply.Error.Reason)
+	require.Empty(reply.ConsumedUTXOs)
+	require.Empty(reply.StakerChanges)
+}
+
+func TestSimulateTxExport(t *testing.T) {
+	require := require.New(t)
+	service, _, txBuilder := defaultService(t)
+	service.vm.ctx.Lock.Lock()
+
+	const exportedAmount = 100
+	tx, err := txBuilder.NewExportTx(
+		service.vm.ctx.XChainID,
+		[]*avax.TransferableOutput{{
+			Asset: avax.Asset{ID: service.vm.ctx.AVAXAssetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: exportedAmount,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{ids.GenerateTestShortID()},
+				},
+			},
+		}},
+		[]*secp256k1.PrivateKey{keys[0]},
+	)
+	require.NoError(err)
+	service.vm.ctx.Lock.Unlock()
+
+	txStr, err := formatting.Encode(formatting.Hex, tx.Bytes())
+	require.NoError(err)
+
+	reply := SimulateTxReply{}
+	require.NoError(service.SimulateTx(nil, &api.FormattedTx{
+		Tx:       txStr,
+		Encoding: formatting.Hex,
+	}, &reply))
+	require.Nil(reply.Error)
+	require.Empty(reply.StakerChanges)
+	// The exported UTXO is produced in addition to the change outputs.
+	require.Len(reply.ProducedUTXOs, len(tx.Unsigned.Outputs())+1)
+	require.Positive(reply.Burned)
+}
+
+func TestEstimateReward(t *testing.T) {
+	require := require.New(t)
+	service, _, _ := defaultService(t)
+
+	service.vm.ctx.Lock.Lock()
+	supply, err := service.vm.state.GetCurrentSupply(constants.PrimaryNetworkID)
+	require.NoError(err)
+	service.vm.ctx.Lock.Unlock()
+
+	var (
+		amount         = service.vm.MinValidatorStake
+		duration       = defaultMinStakingDuration
+		expectedReward = reward.NewCalculator(service.vm.RewardConfig).Calculate(duration, amount, supply)
+		delegationFee  = avajson.Float32(2)
+		expectedFee, _ = reward.Split(expectedReward, 20_000)
+		estimateArgs   = EstimateRewardArgs{
+			SubnetID: constants.PrimaryNetworkID,
+			Amount:   avajson.Uint64(amount),
+			Duration: avajson.Uint64(duration / time.Second),
+		}
+	)
+	require.NotZero(expectedReward)
+
+	reply := EstimateRewardReply{}
+	require.NoError(service.EstimateReward(nil, &estimateArgs, &reply))
+	require.Equal(EstimateRewardReply{
+		PotentialReward: avajson.Uint64(expectedReward),
+		StakerReward:    avajson.Uint64(expectedReward),
+		DelegateeReward: 0,
+		CurrentSupply:   avajson.Uint64(supply),
+	}, reply)
+
+	estimateArgs.DelegationFee = &delegationFee
+	reply = EstimateRewardReply{}
+	require.NoError(service.EstimateReward(nil, &estimateArgs, &reply))
+	require.Equal(EstimateRewardReply{
+		PotentialReward: avajson.Uint64(expectedReward),
+		StakerReward:    avajson.Uint64(expectedReward - expectedFee),
+		DelegateeReward: avajson.Uint64(expectedFee),
+		CurrentSupply:   avajson.Uint64(supply),
+	}, reply)
+
+	invalidDelegationFee := avajson.Float32(101)
+	estimateArgs.DelegationFee = &invalidDelegationFee
+	err = service.EstimateReward(nil, &estimateArgs, &reply)
+	require.ErrorIs(err, errInvalidDelegationFee)
+
+	estimateArgs.DelegationFee = nil
+	estimateArgs.Amount = 0
+	err = service.EstimateReward(nil, &estimateArgs, &reply)
+	require.ErrorIs(err, errMissingStakeAmount)
+
+	estimateArgs.Amount = avajson.Uint64(amount)
+	estimateArgs.Duration = avajson.Uint64(defaultMinStakingDuration/time.Second - 1)
+	err = service.EstimateReward(nil, &estimateArgs, &reply)
+	require.ErrorIs(err, errInvalidStakeDuration)
+
+	estimateArgs.Duration = avajson.Uint64(service.vm.MaxStakeDuration/time.Second + 1)
+	err = service.EstimateReward(nil, &estimateArgs, &reply)
+	require.ErrorIs(err, errInvalidStakeDuration)
+
+	// Durations that would overflow are rejected.
+	estimateArgs.Duration = math.MaxUint64
+	err = service.EstimateReward(nil, &estimateArgs, &reply)
+	require.ErrorIs(err, errInvalidStakeDuration)
+
+	// Subnets that haven't been transformed don't have a reward config.
+	estimateArgs.Duration = avajson.Uint64(duration / time.Second)
+	estimateArgs.SubnetID = ids.GenerateTestID()
+	err = service.EstimateReward(nil, &estimateArgs, &reply)
+	require.ErrorIs(err, database.ErrNotFound)
+}
+
+func TestGetStakerRewardProjection(t *testing.T) {
+	require := require.New(t)
+	service, _, txBuilder := defaultService(t)
+
+	var (
+		validatorNodeID  = genesisNodeIDs[1]
+		delegatorReward  = uint64(1_000_000)
+		delegatorStart   = defaultValidateStartTime
+		delegatorEnd     = delegatorStart.Add(defaultMinStakingDuration)
+		accruedDelegatee = uint64(12345)
+	)
+
+	service.vm.ctx.Lock.Lock()
+
+	delTx, err := txBuilder.NewAddDelegatorTx(
+		&txs.Validator{
+			NodeID: validatorNodeID,
+			Start:  uint64(delegatorStart.Unix()),
+			End:    uint64(delegatorEnd.Unix()),
+			Wght:   service.vm.MinDelegatorStake,
+		},
+		&secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{ids.GenerateTestShortID()},
+		},
+		[]*secp256k1.PrivateKey{keys[0]},
+	)
+	require.NoError(err)
+
+	delegator, err := state.NewCurrentStaker(
+		delTx.ID(),
+		delTx.Unsigned.(*txs.AddDelegatorTx),
+		delegatorStart,
+		delegatorReward,
+	)
+	require.NoError(err)
+
+	service.vm.state.PutCurrentDelegator(delegator)
+	service.vm.state.AddTx(delTx, status.Committed)
+	require.NoError(service.vm.state.SetDelegateeReward(constants.PrimaryNetworkID, validatorNodeID, accruedDelegatee))
+	require.NoError(service.vm.state.Commit())
+
+	validator, err := service.vm.state.GetCurrentValidator(constants.PrimaryNetworkID, validatorNodeID)
+	require.NoError(err)
+	attr, err := service.loadStakerTxAttributes(validator.TxID)
+	require.NoError(err)
+
+	service.vm.ctx.Lock.Unlock()
+
+	// Delegators pay the delegation fee of their validator.
+	reply := GetStakerRewardProjectionReply{}
+	require.NoError(service.GetStakerRewardProjection(nil, &api.GetTxArgs{TxID: delTx.ID()}, &reply))
+
+	expectedDelegateeReward, expectedDelegatorReward := reward.Split(delegatorReward, attr.shares)
+	require.Equal(delTx.ID(), reply.TxID)
+	require.Equal(validatorNodeID, reply.NodeID)
+	require.Equal(constants.PrimaryNetworkID, reply.SubnetID)
+	require.Equal(avajson.Uint64(delegatorReward), reply.PotentialReward)
+	require.Equal(avajson.Uint64(expectedDelegatorReward), reply.StakerReward)
+	require.Equal(avajson.Uint64(expectedDelegateeReward), *reply.DelegateeReward)
+	require.Nil(reply.AccruedDelegateeReward)
+	require.Equal(avajson.Float32(service.vm.UptimePercentage*100), reply.UptimeRequirement)
+	if reply.MeetsUptimeRequirement {
+		require.Equal(reply.StakerReward, reply.ProjectedReward)
+	} else {
+		require.Zero(reply.ProjectedReward)
+	}
+
+	// Validators receive their accrued delegation fees.
+	reply = GetStakerRewardProjectionReply{}
+	require.NoError(service.GetStakerRewardProjection(nil, &api.GetTxArgs{TxID: validator.TxID}, &reply))
+	require.Equal(avajson.Uint64(validator.PotentialReward), reply.PotentialReward)
+	require.Equal(avajson.Uint64(validator.PotentialReward+accruedDelegatee), reply.StakerReward)
+	require.Equal(avajson.Uint64(accruedDelegatee), *reply.AccruedDelegateeReward)
+	require.Nil(reply.DelegateeReward)
+
+	// Txs that don't add a current staker have no projection.
+	err = service.GetStakerRewardProjection(nil, &api.GetTxArgs{TxID: ids.GenerateTestID()}, &reply)
+	require.ErrorIs(err, database.ErrNotFound)
+}
+
+func TestServiceGetUptimeHistory(t *testing.T) {
+	var (
+		nodeID    = ids.GenerateTestNodeID()
+		subnetID  = ids.GenerateTestID()
+		startTime = time.Unix(1000, 0)
+		now       = startTime.Add(10 * time.Hour)
+		samples   = []*state.UptimeSample{
+			{
+				SubnetID:   constants.PrimaryNetworkID,
+				NodeID:     nodeID,
+				Timestamp:  startTime.Add(time.Hour),
+				StartTime:  startTime,
+				UpDuration: time.Hour,
+				Connected:  true,
+			},
+			{
+				SubnetID:   constants.PrimaryNetworkID,
+				NodeID:     nodeID,
+				Timestamp:  startTime.Add(2 * time.Hour),
+				StartTime:  startTime,
+				UpDuration: time.Hour,
+				Connected:  false,
+			},
+		}
+		meets       = true
+		doesNotMeet = false
+		requirement = avajson.Float32(80)
+	)
+
+	tests := []struct {
+		name          string
+		args          *GetUptimeHistoryArgs
+		setup         func(*state.MockState)
+		expectedReply *GetUptimeHistoryReply
+		expectedErr   error
+	}{
+		{
+			name: "primary network",
+			args: &GetUptimeHistoryArgs{
+				NodeID: nodeID,
+			},
+			setup: func(s *state.MockState) {
+				s.EXPECT().GetUptimeHistory(constants.PrimaryNetworkID, nodeID, time.Unix(0, 0), now).Return(samples, nil)
+			},
+			expectedReply: &GetUptimeHistoryReply{
+				UptimeRequirement: &requirement,
+				Samples: []APIUptimeSample{
+					{
+						Timestamp:              avajson.Uint64(samples[0].Timestamp.Unix()),
+						UpDuration:             3600,
+						Uptime:                 100,
+						Connected:              true,
+						MeetsUptimeRequirement: &meets,
+					},
+					{
+						Timestamp:              avajson.Uint64(samples[1].Timestamp.Unix()),
+						UpDuration:             3600,
+						Uptime:                 50,
+						Connected:              false,
+						MeetsUptimeRequirement: &doesNotMeet,
+					},
+				},
+			},
+		},
+		{
+			name: "subnet without uptime requirement",
+			args: &GetUptimeHistoryArgs{
+				NodeID:    nodeID,
+				SubnetID:  subnetID,
+				StartTime: 1,
+				EndTime:   2,
+			},
+			setup: func(s *state.MockState) {
+				s.EXPECT().GetUptimeHistory(subnetID, nodeID, time.Unix(1, 0), time.Unix(2, 0)).Return(nil, nil)
+				s.EXPECT().GetSubnetTransformation(subnetID).Return(nil, database.ErrNotFound)
+			},
+			expectedReply: &GetUptimeHistoryReply{
+				Samples: []APIUptimeSample{},
+			},
+		},
+		{
+			name: "disabled",
+			args: &GetUptimeHistoryArgs{
+				NodeID: nodeID,
+			},
+			setup: func(s *state.MockState) {
+				s.EXPECT().GetUptimeHistory(constants.PrimaryNetworkID, nodeID, time.Unix(0, 0), now).Return(nil, state.ErrUptimeHistoryDisabled)
+			},
+			expectedErr: state.ErrUptimeHistoryDisabled,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+			ctrl := gomock.NewController(t)
+
+			s := state.NewMockState(ctrl)
+			test.setup(s)
+
+			vm := &VM{
+				Config: config.Config{
+					UptimePercentage: .8,
+				},
+				state: s,
+				ctx: &snow.Context{
+					Log: logging.NoLog{},
+				},
+			}
+			vm.clock.Set(now)
+			service := &Service{
+				vm: vm,
+			}
+
+			reply := &GetUptimeHistoryReply{}
+			err := service.GetUptimeHistory(nil, test.args, reply)
+			require.ErrorIs(err, test.expectedErr)
+			if test.expectedErr != nil {
+				return
+			}
+			require.Equal(test.expectedReply, reply)
+		})
+	}
+}
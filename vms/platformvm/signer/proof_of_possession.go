@@ -29,9 +29,20 @@ type ProofOfPossession struct {
 }
 
 func NewProofOfPossession(sk *bls.SecretKey) *ProofOfPossession {
-	pk := bls.PublicFromSecretKey(sk)
+	// Signing with a local signer can't fail.
+	pop, _ := NewProofOfPossessionFromSigner(bls.NewLocalSigner(sk))
+	return pop
+}
+
+// NewProofOfPossessionFromSigner returns the proof of possession of the key
+// held by [signer].
+func NewProofOfPossessionFromSigner(signer bls.Signer) (*ProofOfPossession, error) {
+	pk := signer.PublicKey()
 	pkBytes := bls.PublicKeyToCompressedBytes(pk)
-	sig := bls.SignProofOfPossession(sk, pkBytes)
+	sig, err := signer.SignProofOfPossession(pkBytes)
+	if err != nil {
+		return nil, err
+	}
 	sigBytes := bls.SignatureToBytes(sig)
 
 	pop := &ProofOfPossession{
@@ -39,7 +50,7 @@ func NewProofOfPossession(sk *bls.SecretKey) *ProofOfPossession {
 	}
 	copy(pop.PublicKey[:], pkBytes)
 	copy(pop.ProofOfPossession[:], sigBytes)
-	return pop
+	return pop, nil
 }
 
 func (p *ProofOfPossession) Verify() error {
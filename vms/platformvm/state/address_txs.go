@@ -0,0 +1,353 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/exp/maps"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/timer"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/block"
+	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
+	"github.com/ava-labs/avalanchego/vms/platformvm/genesis"
+	"github.com/ava-labs/avalanchego/vms/platformvm/status"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+const (
+	addressTxsReindexCommitFrequency = 1024
+	addressTxsReindexLogFrequency    = 30 * time.Second
+)
+
+var (
+	ErrAddressTxsIndexDisabled = errors.New("address tx index is disabled")
+
+	addressTxsHeightKey = []byte("indexed height")
+)
+
+// stakeOutputter is implemented by all the txs that lock outputs for staking.
+type stakeOutputter interface {
+	Stake() []*avax.TransferableOutput
+}
+
+func (s *state) GetAddressTxs(address ids.ShortID, assetID ids.ID, cursor, pageSize uint64) ([]ids.ID, error) {
+	if s.addressTxs == nil {
+		return nil, ErrAddressTxsIndexDisabled
+	}
+	return s.addressTxs.Read(address[:], assetID, cursor, pageSize)
+}
+
+// reindexAddressTxs indexes all the accepted blocks that are not yet in the
+// address tx index. This allows the index to be enabled on a node that has
+// already accepted blocks.
+//
+// Invariant: reindexAddressTxs must be called after the state has been loaded.
+func (s *state) reindexAddressTxs(genesisBytes []byte) error {
+	if s.addressTxs == nil {
+		return nil
+	}
+
+	lastAccepted, err := s.GetStatelessBlock(s.lastAccepted)
+	if err != nil {
+		return err
+	}
+	lastAcceptedHeight := lastAccepted.Height()
+
+	// The genesis block doesn't contain any txs, so the index is only ever
+	// missing the genesis block if it has never been populated.
+	startHeight := uint64(1)
+	indexedHeight, err := database.GetUInt64(s.addressTxsDB, addressTxsHeightKey)
+	switch {
+	case err == nil:
+		startHeight = indexedHeight + 1
+	case err != database.ErrNotFound:
+		return err
+	}
+	if startHeight > lastAcceptedHeight {
+		return nil
+	}
+
+	// Genesis UTXOs were not created by a tx, so they can only be found in the
+	// genesis once they have been spent.
+	parsedGenesis, err := genesis.Parse(genesisBytes)
+	if err != nil {
+		return err
+	}
+	s.addressTxsGenesisUTXOs = make(map[ids.ID]*avax.UTXO, len(parsedGenesis.UTXOs))
+	for _, utxo := range parsedGenesis.UTXOs {
+		utxo := utxo.UTXO
+		s.addressTxsGenesisUTXOs[utxo.InputID()] = &utxo
+	}
+	defer func() {
+		s.addressTxsGenesisUTXOs = nil
+	}()
+
+	var (
+		log        = s.ctx.Log
+		startTime  = time.Now()
+		nextUpdate = startTime.Add(addressTxsReindexLogFrequency)
+	)
+	log.Info("reindexing address txs",
+		zap.Uint64("startHeight", startHeight),
+		zap.Uint64("lastAcceptedHeight", lastAcceptedHeight),
+	)
+	for height := startHeight; height <= lastAcceptedHeight; height++ {
+		blkID, err := s.GetBlockIDAtHeight(height)
+		if err != nil {
+			return err
+		}
+		blk, err := s.GetStatelessBlock(blkID)
+		if err != nil {
+			return err
+		}
+		if err := s.indexAddressTxs(blk); err != nil {
+			return err
+		}
+		if err := database.PutUInt64(s.addressTxsDB, addressTxsHeightKey, height); err != nil {
+			return err
+		}
+
+		if height%addressTxsReindexCommitFrequency == 0 || height == lastAcceptedHeight {
+			if err := s.baseDB.Commit(); err != nil {
+				return err
+			}
+		}
+
+		now := time.Now()
+		if now.After(nextUpdate) {
+			nextUpdate = now.Add(addressTxsReindexLogFrequency)
+
+			progress := height - startHeight
+			eta := timer.EstimateETA(
+				startTime,
+				progress,
+				lastAcceptedHeight-startHeight,
+			)
+			log.Info("reindexing address txs",
+				zap.Uint64("height", height),
+				zap.Duration("eta", eta),
+			)
+		}
+	}
+
+	log.Info("finished reindexing address txs",
+		zap.Uint64("numBlocks", lastAcceptedHeight-startHeight+1),
+		zap.Duration("duration", time.Since(startTime)),
+	)
+	return nil
+}
+
+// writeAddressTxs indexes the txs in the blocks that are being committed.
+//
+// Invariant: writeAddressTxs must be called before the pending UTXO changes
+// are written, as the UTXOs consumed by the txs are read from the database.
+func (s *state) writeAddressTxs(height uint64) error {
+	if s.addressTxs == nil {
+		return nil
+	}
+
+	// A proposal block and its option are committed together, so the blocks
+	// are indexed in height order to keep the index ordered by acceptance.
+	blks := maps.Values(s.addedBlocks)
+	slices.SortFunc(blks, func(a, b block.Block) int {
+		return cmp.Compare(a.Height(), b.Height())
+	})
+	for _, blk := range blks {
+		if err := s.indexAddressTxs(blk); err != nil {
+			return fmt.Errorf("failed to index address txs of block %s: %w", blk.ID(), err)
+		}
+	}
+	return database.PutUInt64(s.addressTxsDB, addressTxsHeightKey, height)
+}
+
+// indexAddressTxs records which addresses each of the txs in [blk] touched.
+func (s *state) indexAddressTxs(blk block.Block) error {
+	for _, tx := range blk.Txs() {
+		txID := tx.ID()
+		_, txStatus, err := s.GetTx(txID)
+		if err != nil {
+			return err
+		}
+
+		_, isReward := tx.Unsigned.(*txs.RewardValidatorTx)
+		if txStatus != status.Committed && !isReward {
+			// Aborted txs didn't consume or produce any UTXOs.
+			continue
+		}
+
+		inputs, err := s.addressTxsInputs(tx)
+		if err != nil {
+			return err
+		}
+		outputs, err := s.addressTxsOutputs(tx)
+		if err != nil {
+			return err
+		}
+		if err := s.addressTxs.Accept(txID, inputs, outputs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addressTxsInputs returns the UTXOs consumed by [tx]. Imported UTXOs are not
+// included, as they were owned on the source chain.
+func (s *state) addressTxsInputs(tx *txs.Tx) ([]*avax.UTXO, error) {
+	var utxoIDs []*avax.UTXOID
+	switch utx := tx.Unsigned.(type) {
+	case *txs.ImportTx:
+		utxoIDs = utx.BaseTx.InputUTXOs()
+	case interface{ InputUTXOs() []*avax.UTXOID }:
+		utxoIDs = utx.InputUTXOs()
+	}
+
+	utxos := make([]*avax.UTXO, 0, len(utxoIDs))
+	for _, utxoID := range utxoIDs {
+		utxo, err := s.getAddressTxsUTXO(utxoID)
+		if err == database.ErrNotFound {
+			s.ctx.Log.Debug("skipping unknown UTXO for address tx indexing",
+				zap.Stringer("txID", tx.ID()),
+				zap.Stringer("utxoID", utxoID),
+			)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		utxos = append(utxos, utxo)
+	}
+	return utxos, nil
+}
+
+// addressTxsOutputs returns the outputs produced by [tx], including staked and
+// exported outputs. Addresses that are set as an owner by [tx], such as the
+// owner of a subnet or the owner of staking rewards, are reported as outputs
+// of the AVAX asset.
+func (s *state) addressTxsOutputs(tx *txs.Tx) ([]*avax.UTXO, error) {
+	outputs := tx.UTXOs()
+	addOuts := func(outs []*avax.TransferableOutput) {
+		for _, out := range outs {
+			outputs = append(outputs, &avax.UTXO{
+				Asset: out.Asset,
+				Out:   out.Output(),
+			})
+		}
+	}
+	addOwner := func(owner fx.Owner) {
+		outputOwners, ok := owner.(*secp256k1fx.OutputOwners)
+		if !ok {
+			return
+		}
+		outputs = append(outputs, &avax.UTXO{
+			Asset: avax.Asset{ID: s.ctx.AVAXAssetID},
+			Out: &secp256k1fx.TransferOutput{
+				OutputOwners: *outputOwners,
+			},
+		})
+	}
+
+	switch utx := tx.Unsigned.(type) {
+	case *txs.ExportTx:
+		addOuts(utx.ExportedOutputs)
+	case *txs.CreateSubnetTx:
+		addOwner(utx.Owner)
+	case *txs.TransferSubnetOwnershipTx:
+		addOwner(utx.Owner)
+	case *txs.RewardValidatorTx:
+		stakerTx, _, err := s.GetTx(utx.TxID)
+		if err != nil {
+			return nil, err
+		}
+		// The returned stake and the rewards are recorded as being created by
+		// the staker tx, but they are produced by the reward tx.
+		if staker, ok := stakerTx.Unsigned.(stakeOutputter); ok {
+			numOutputs := len(stakerTx.Unsigned.Outputs())
+			outputs = append(outputs, s.stakeUTXOs(utx.TxID, numOutputs, staker)...)
+		}
+		rewardUTXOs, err := s.GetRewardUTXOs(utx.TxID)
+		if err != nil {
+			return nil, err
+		}
+		outputs = append(outputs, rewardUTXOs...)
+	}
+
+	if staker, ok := tx.Unsigned.(stakeOutputter); ok {
+		addOuts(staker.Stake())
+	}
+	switch utx := tx.Unsigned.(type) {
+	case txs.ValidatorTx:
+		addOwner(utx.ValidationRewardsOwner())
+		addOwner(utx.DelegationRewardsOwner())
+	case txs.DelegatorTx:
+		addOwner(utx.RewardsOwner())
+	}
+	return outputs, nil
+}
+
+// stakeUTXOs returns the UTXOs that are created when the stake of [staker] is
+// returned.
+func (*state) stakeUTXOs(txID ids.ID, numOutputs int, staker stakeOutputter) []*avax.UTXO {
+	stake := staker.Stake()
+	utxos := make([]*avax.UTXO, len(stake))
+	for i, out := range stake {
+		utxos[i] = &avax.UTXO{
+			UTXOID: avax.UTXOID{
+				TxID:        txID,
+				OutputIndex: uint32(numOutputs + i),
+			},
+			Asset: out.Asset,
+			Out:   out.Output(),
+		}
+	}
+	return utxos
+}
+
+// getAddressTxsUTXO returns the UTXO [utxoID], even if it has already been
+// spent, by finding the tx that produced it.
+func (s *state) getAddressTxsUTXO(utxoID *avax.UTXOID) (*avax.UTXO, error) {
+	inputID := utxoID.InputID()
+
+	// UTXOs that haven't been spent yet are in the database. This includes the
+	// UTXOs that are being spent by the changes that are currently being
+	// written.
+	utxo, err := s.utxoState.GetUTXO(inputID)
+	if err != database.ErrNotFound {
+		return utxo, err
+	}
+
+	if utxo, ok := s.addressTxsGenesisUTXOs[inputID]; ok {
+		return utxo, nil
+	}
+
+	tx, _, err := s.GetTx(utxoID.TxID)
+	if err != nil {
+		return nil, err
+	}
+
+	utxos := tx.UTXOs()
+	if staker, ok := tx.Unsigned.(stakeOutputter); ok {
+		utxos = append(utxos, s.stakeUTXOs(tx.ID(), len(utxos), staker)...)
+		rewardUTXOs, err := s.GetRewardUTXOs(tx.ID())
+		if err != nil {
+			return nil, err
+		}
+		utxos = append(utxos, rewardUTXOs...)
+	}
+	for _, utxo := range utxos {
+		if utxo.InputID() == inputID {
+			return utxo, nil
+		}
+	}
+	return nil, database.ErrNotFound
+}
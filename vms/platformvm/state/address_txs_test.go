@@ -0,0 +1,256 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/snow/validators"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/units"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/block"
+	"github.com/ava-labs/avalanchego/vms/platformvm/config"
+	"github.com/ava-labs/avalanchego/vms/platformvm/genesis"
+	"github.com/ava-labs/avalanchego/vms/platformvm/metrics"
+	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
+	"github.com/ava-labs/avalanchego/vms/platformvm/status"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+var addressTxsAVAXAssetID = ids.GenerateTestID()
+
+func newAddressTxsState(require *require.Assertions, db database.Database, indexAddressTxs bool) *state {
+	execCfg, err := config.GetExecutionConfig(nil)
+	require.NoError(err)
+	execCfg.IndexAddressTxs = indexAddressTxs
+
+	s, err := newState(
+		db,
+		metrics.Noop,
+		&config.Config{
+			Validators: validators.NewManager(),
+		},
+		execCfg,
+		&snow.Context{
+			Log:         logging.NoLog{},
+			AVAXAssetID: addressTxsAVAXAssetID,
+		},
+		prometheus.NewRegistry(),
+		reward.NewCalculator(reward.Config{
+			MaxConsumptionRate: .12 * reward.PercentDenominator,
+			MinConsumptionRate: .1 * reward.PercentDenominator,
+			MintingPeriod:      365 * 24 * time.Hour,
+			SupplyCap:          720 * units.MegaAvax,
+		}),
+	)
+	require.NoError(err)
+	return s
+}
+
+func newAddressTxsOwners(addr ids.ShortID) secp256k1fx.OutputOwners {
+	return secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{addr},
+	}
+}
+
+// addressTxsTestChain accepts a block that spends the genesis UTXO owned by
+// [sender] to [recipient], and then a block that creates a subnet owned by
+// [subnetOwner].
+type addressTxsTestChain struct {
+	sender      ids.ShortID
+	recipient   ids.ShortID
+	subnetOwner ids.ShortID
+
+	genesisBytes []byte
+	transferTx   *txs.Tx
+	subnetTx     *txs.Tx
+}
+
+func newAddressTxsTestChain(require *require.Assertions) *addressTxsTestChain {
+	c := &addressTxsTestChain{
+		sender:      ids.GenerateTestShortID(),
+		recipient:   ids.GenerateTestShortID(),
+		subnetOwner: ids.GenerateTestShortID(),
+	}
+
+	genesisUTXO := avax.UTXO{
+		Asset: avax.Asset{ID: addressTxsAVAXAssetID},
+		Out: &secp256k1fx.TransferOutput{
+			Amt:          10 * units.Avax,
+			OutputOwners: newAddressTxsOwners(c.sender),
+		},
+	}
+	var err error
+	c.genesisBytes, err = genesis.Codec.Marshal(block.CodecVersion, &genesis.Genesis{
+		UTXOs: []*genesis.UTXO{{UTXO: genesisUTXO}},
+	})
+	require.NoError(err)
+
+	c.transferTx = &txs.Tx{Unsigned: &txs.BaseTx{BaseTx: avax.BaseTx{
+		NetworkID:    constants.UnitTestID,
+		BlockchainID: constants.PlatformChainID,
+		Ins: []*avax.TransferableInput{{
+			UTXOID: genesisUTXO.UTXOID,
+			Asset:  genesisUTXO.Asset,
+			In: &secp256k1fx.TransferInput{
+				Amt: 10 * units.Avax,
+			},
+		}},
+		Outs: []*avax.TransferableOutput{{
+			Asset: genesisUTXO.Asset,
+			Out: &secp256k1fx.TransferOutput{
+				Amt:          9 * units.Avax,
+				OutputOwners: newAddressTxsOwners(c.recipient),
+			},
+		}},
+	}}}
+	require.NoError(c.transferTx.Initialize(txs.Codec))
+
+	transferUTXO := c.transferTx.UTXOs()[0]
+	subnetOwners := newAddressTxsOwners(c.subnetOwner)
+	c.subnetTx = &txs.Tx{Unsigned: &txs.CreateSubnetTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    constants.UnitTestID,
+			BlockchainID: constants.PlatformChainID,
+			Ins: []*avax.TransferableInput{{
+				UTXOID: transferUTXO.UTXOID,
+				Asset:  transferUTXO.Asset,
+				In: &secp256k1fx.TransferInput{
+					Amt: 9 * units.Avax,
+				},
+			}},
+		}},
+		Owner: &subnetOwners,
+	}}
+	require.NoError(c.subnetTx.Initialize(txs.Codec))
+	return c
+}
+
+// accept writes the genesis and both blocks into [s].
+func (c *addressTxsTestChain) accept(require *require.Assertions, s *state) {
+	genesisState, err := genesis.Parse(c.genesisBytes)
+	require.NoError(err)
+
+	genesisBlk, err := block.NewApricotCommitBlock(ids.GenerateTestID(), 0)
+	require.NoError(err)
+	s.AddStatelessBlock(genesisBlk)
+	s.SetLastAccepted(genesisBlk.ID())
+	s.SetHeight(0)
+	s.SetTimestamp(time.Unix(0, 0))
+	s.SetCurrentSupply(constants.PrimaryNetworkID, 10*units.Avax)
+	s.AddUTXO(&genesisState.UTXOs[0].UTXO)
+	require.NoError(s.Commit())
+
+	parentID := genesisBlk.ID()
+	for i, tx := range []*txs.Tx{c.transferTx, c.subnetTx} {
+		height := uint64(i + 1)
+		blk, err := block.NewBanffStandardBlock(time.Unix(int64(height), 0), parentID, height, []*txs.Tx{tx})
+		require.NoError(err)
+
+		for _, in := range tx.Unsigned.InputIDs().List() {
+			s.DeleteUTXO(in)
+		}
+		for _, utxo := range tx.UTXOs() {
+			s.AddUTXO(utxo)
+		}
+		s.AddTx(tx, status.Committed)
+		s.AddStatelessBlock(blk)
+		s.SetLastAccepted(blk.ID())
+		s.SetHeight(height)
+		require.NoError(s.Commit())
+
+		parentID = blk.ID()
+	}
+}
+
+func (c *addressTxsTestChain) requireIndexed(require *require.Assertions, s *state) {
+	tests := []struct {
+		address  ids.ShortID
+		expected []ids.ID
+	}{
+		{
+			address:  c.sender,
+			expected: []ids.ID{c.transferTx.ID()},
+		},
+		{
+			address:  c.recipient,
+			expected: []ids.ID{c.transferTx.ID(), c.subnetTx.ID()},
+		},
+		{
+			address:  c.subnetOwner,
+			expected: []ids.ID{c.subnetTx.ID()},
+		},
+		{
+			address: ids.GenerateTestShortID(),
+		},
+	}
+	for _, test := range tests {
+		txIDs, err := s.GetAddressTxs(test.address, addressTxsAVAXAssetID, 0, 10)
+		require.NoError(err)
+		require.Equal(test.expected, txIDs)
+	}
+
+	// Txs are paginated by the cursor.
+	txIDs, err := s.GetAddressTxs(c.recipient, addressTxsAVAXAssetID, 1, 10)
+	require.NoError(err)
+	require.Equal([]ids.ID{c.subnetTx.ID()}, txIDs)
+
+	// Other assets weren't touched.
+	txIDs, err = s.GetAddressTxs(c.recipient, ids.GenerateTestID(), 0, 10)
+	require.NoError(err)
+	require.Empty(txIDs)
+}
+
+func TestAddressTxsIndexedOnCommit(t *testing.T) {
+	require := require.New(t)
+
+	c := newAddressTxsTestChain(require)
+	s := newAddressTxsState(require, memdb.New(), true)
+	c.accept(require, s)
+	c.requireIndexed(require, s)
+}
+
+func TestAddressTxsReindex(t *testing.T) {
+	require := require.New(t)
+
+	c := newAddressTxsTestChain(require)
+	db := memdb.New()
+	s := newAddressTxsState(require, db, false)
+	c.accept(require, s)
+
+	// Enabling the index on an existing state indexes the accepted blocks.
+	s = newAddressTxsState(require, db, true)
+	require.NoError(s.load())
+	require.NoError(s.reindexAddressTxs(c.genesisBytes))
+	c.requireIndexed(require, s)
+
+	indexedHeight, err := database.GetUInt64(s.addressTxsDB, addressTxsHeightKey)
+	require.NoError(err)
+	require.Equal(uint64(2), indexedHeight)
+
+	// Reindexing again is a noop.
+	s = newAddressTxsState(require, db, true)
+	require.NoError(s.load())
+	require.NoError(s.reindexAddressTxs(c.genesisBytes))
+	c.requireIndexed(require, s)
+}
+
+func TestAddressTxsIndexDisabled(t *testing.T) {
+	s := newAddressTxsState(require.New(t), memdb.New(), false)
+
+	_, err := s.GetAddressTxs(ids.GenerateTestShortID(), addressTxsAVAXAssetID, 0, 10)
+	require.ErrorIs(t, err, ErrAddressTxsIndexDisabled)
+}
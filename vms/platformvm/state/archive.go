@@ -0,0 +1,435 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/block"
+	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/x/archivedb"
+)
+
+// Keys in the archive are prefixed by a single byte to separate the different
+// kinds of state that are archived.
+const (
+	archiveTimestampPrefix byte = iota
+	archiveSupplyPrefix
+	archiveSubnetOwnerPrefix
+	archiveSubnetTransformationPrefix
+	archiveUTXOPrefix
+	archiveAddressPrefix
+	archiveStakerPrefix
+)
+
+var (
+	_ ArchivedState = (*archivedState)(nil)
+
+	ErrArchivalModeDisabled = errors.New("archival mode is disabled")
+	ErrHeightNotAccepted    = errors.New("height has not been accepted")
+
+	errArchiveMissing   = errors.New("archive is missing, archival mode must be enabled before syncing from genesis")
+	errArchiveOutOfSync = errors.New("archive is out of sync with the last accepted block")
+
+	archiveTimestampKey = []byte{archiveTimestampPrefix}
+)
+
+// ArchivedState is a read-only view of the state as of an accepted height.
+type ArchivedState interface {
+	avax.UTXOReader
+
+	GetTimestamp() (time.Time, error)
+	GetCurrentSupply(subnetID ids.ID) (uint64, error)
+	GetSubnetOwner(subnetID ids.ID) (fx.Owner, error)
+	GetSubnetTransformation(subnetID ids.ID) (*txs.Tx, error)
+
+	// GetCurrentStakers returns the current validators and delegators of
+	// [subnetID].
+	GetCurrentStakers(subnetID ids.ID) ([]*Staker, error)
+}
+
+// archivedStaker is the information about a current staker that can not be
+// derived from its tx.
+type archivedStaker struct {
+	StartTime       uint64 `serialize:"true"`
+	PotentialReward uint64 `serialize:"true"`
+}
+
+type archivedState struct {
+	state  *state
+	reader *archivedb.Reader
+}
+
+func (s *state) GetArchivedState(height uint64) (ArchivedState, error) {
+	if s.archive == nil {
+		return nil, ErrArchivalModeDisabled
+	}
+
+	lastAcceptedHeight, err := s.archive.Height()
+	if err != nil {
+		return nil, err
+	}
+	if height > lastAcceptedHeight {
+		return nil, fmt.Errorf("%w: %d > %d", ErrHeightNotAccepted, height, lastAcceptedHeight)
+	}
+
+	return &archivedState{
+		state:  s,
+		reader: s.archive.Open(height),
+	}, nil
+}
+
+func (a *archivedState) GetTimestamp() (time.Time, error) {
+	timestamp, err := database.GetUInt64(a.reader, archiveTimestampKey)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(int64(timestamp), 0), nil
+}
+
+func (a *archivedState) GetCurrentSupply(subnetID ids.ID) (uint64, error) {
+	return database.GetUInt64(a.reader, archiveSubnetKey(archiveSupplyPrefix, subnetID))
+}
+
+func (a *archivedState) GetSubnetOwner(subnetID ids.ID) (fx.Owner, error) {
+	ownerBytes, err := a.reader.Get(archiveSubnetKey(archiveSubnetOwnerPrefix, subnetID))
+	if err != nil {
+		return nil, err
+	}
+
+	var owner fx.Owner
+	if _, err := block.GenesisCodec.Unmarshal(ownerBytes, &owner); err != nil {
+		return nil, err
+	}
+	return owner, nil
+}
+
+func (a *archivedState) GetSubnetTransformation(subnetID ids.ID) (*txs.Tx, error) {
+	txID, err := database.GetID(a.reader, archiveSubnetKey(archiveSubnetTransformationPrefix, subnetID))
+	if err != nil {
+		return nil, err
+	}
+
+	tx, _, err := a.state.GetTx(txID)
+	return tx, err
+}
+
+func (a *archivedState) GetCurrentStakers(subnetID ids.ID) ([]*Staker, error) {
+	prefix := archiveSubnetKey(archiveStakerPrefix, subnetID)
+	it := a.reader.NewIteratorWithStartAndPrefix(nil, prefix, len(prefix)+ids.IDLen)
+	defer it.Release()
+
+	var stakers []*Staker
+	for it.Next() {
+		txID, err := ids.ToID(it.Key()[len(prefix):])
+		if err != nil {
+			return nil, err
+		}
+
+		metadata := &archivedStaker{}
+		if _, err := block.GenesisCodec.Unmarshal(it.Value(), metadata); err != nil {
+			return nil, err
+		}
+
+		tx, _, err := a.state.GetTx(txID)
+		if err != nil {
+			return nil, err
+		}
+
+		stakerTx, ok := tx.Unsigned.(txs.Staker)
+		if !ok {
+			return nil, fmt.Errorf("expected tx type txs.Staker but got %T", tx.Unsigned)
+		}
+
+		staker, err := NewCurrentStaker(
+			txID,
+			stakerTx,
+			time.Unix(int64(metadata.StartTime), 0),
+			metadata.PotentialReward,
+		)
+		if err != nil {
+			return nil, err
+		}
+		stakers = append(stakers, staker)
+	}
+	return stakers, it.Error()
+}
+
+func (a *archivedState) GetUTXO(utxoID ids.ID) (*avax.UTXO, error) {
+	utxoBytes, err := a.reader.Get(archiveUTXOKey(utxoID))
+	if err != nil {
+		return nil, err
+	}
+
+	utxo := &avax.UTXO{}
+	if _, err := txs.GenesisCodec.Unmarshal(utxoBytes, utxo); err != nil {
+		return nil, err
+	}
+	return utxo, nil
+}
+
+func (a *archivedState) UTXOIDs(addr []byte, previous ids.ID, limit int) ([]ids.ID, error) {
+	prefix := archiveAddressPrefixKey(addr)
+	it := a.reader.NewIteratorWithStartAndPrefix(
+		archiveAddressKey(addr, previous),
+		prefix,
+		len(prefix)+ids.IDLen,
+	)
+	defer it.Release()
+
+	utxoIDs := []ids.ID(nil)
+	for len(utxoIDs) < limit && it.Next() {
+		utxoID, err := ids.ToID(it.Key()[len(prefix):])
+		if err != nil {
+			return nil, err
+		}
+		if utxoID == previous {
+			continue
+		}
+		utxoIDs = append(utxoIDs, utxoID)
+	}
+	return utxoIDs, it.Error()
+}
+
+func (s *state) loadArchive() error {
+	if s.archive == nil {
+		return nil
+	}
+
+	archiveHeight, err := s.archive.Height()
+	if err == database.ErrNotFound {
+		return errArchiveMissing
+	}
+	if err != nil {
+		return err
+	}
+
+	lastAccepted, err := s.GetStatelessBlock(s.lastAccepted)
+	if err != nil {
+		return err
+	}
+	if lastAcceptedHeight := lastAccepted.Height(); archiveHeight != lastAcceptedHeight {
+		return fmt.Errorf(
+			"%w: archive height %d != last accepted height %d",
+			errArchiveOutOfSync,
+			archiveHeight,
+			lastAcceptedHeight,
+		)
+	}
+	return nil
+}
+
+// writeArchive records the pending changes into the archive at [height].
+//
+// Invariant: writeArchive must be called before the pending changes are
+// written, as the prior state of deleted UTXOs is read from the database.
+func (s *state) writeArchive(height uint64) error {
+	if s.archive == nil {
+		return nil
+	}
+
+	batch := s.archive.NewBatch(height)
+	if !s.persistedTimestamp.Equal(s.timestamp) {
+		timestamp := uint64(s.timestamp.Unix())
+		if err := database.PutUInt64(batch, archiveTimestampKey, timestamp); err != nil {
+			return fmt.Errorf("failed to archive timestamp: %w", err)
+		}
+	}
+	if s.persistedCurrentSupply != s.currentSupply {
+		key := archiveSubnetKey(archiveSupplyPrefix, constants.PrimaryNetworkID)
+		if err := database.PutUInt64(batch, key, s.currentSupply); err != nil {
+			return fmt.Errorf("failed to archive current supply: %w", err)
+		}
+	}
+	for subnetID, supply := range s.modifiedSupplies {
+		key := archiveSubnetKey(archiveSupplyPrefix, subnetID)
+		if err := database.PutUInt64(batch, key, supply); err != nil {
+			return fmt.Errorf("failed to archive subnet supply: %w", err)
+		}
+	}
+
+	// Subnets are archived with the owner from their creation tx, which may
+	// be overwritten by an owner set in the same batch.
+	for _, subnet := range s.addedSubnets {
+		createSubnetTx, ok := subnet.Unsigned.(*txs.CreateSubnetTx)
+		if !ok {
+			return fmt.Errorf("%q %w", subnet.ID(), errIsNotSubnet)
+		}
+		if err := archiveSubnetOwner(batch, subnet.ID(), createSubnetTx.Owner); err != nil {
+			return err
+		}
+	}
+	for subnetID, owner := range s.subnetOwners {
+		if err := archiveSubnetOwner(batch, subnetID, owner); err != nil {
+			return err
+		}
+	}
+	for subnetID, tx := range s.transformedSubnets {
+		key := archiveSubnetKey(archiveSubnetTransformationPrefix, subnetID)
+		if err := database.PutID(batch, key, tx.ID()); err != nil {
+			return fmt.Errorf("failed to archive transformed subnet: %w", err)
+		}
+	}
+
+	for utxoID, utxo := range s.modifiedUTXOs {
+		if err := s.archiveUTXO(batch, utxoID, utxo); err != nil {
+			return err
+		}
+	}
+
+	for subnetID, validatorDiffs := range s.currentStakers.validatorDiffs {
+		for _, validatorDiff := range validatorDiffs {
+			switch validatorDiff.validatorStatus {
+			case added:
+				if err := archiveStaker(batch, validatorDiff.validator); err != nil {
+					return err
+				}
+			case deleted:
+				key := archiveStakerKey(subnetID, validatorDiff.validator.TxID)
+				if err := batch.Delete(key); err != nil {
+					return fmt.Errorf("failed to archive deleted staker: %w", err)
+				}
+			}
+
+			addedDelegatorIterator := NewTreeIterator(validatorDiff.addedDelegators)
+			for addedDelegatorIterator.Next() {
+				if err := archiveStaker(batch, addedDelegatorIterator.Value()); err != nil {
+					addedDelegatorIterator.Release()
+					return err
+				}
+			}
+			addedDelegatorIterator.Release()
+
+			for txID := range validatorDiff.deletedDelegators {
+				if err := batch.Delete(archiveStakerKey(subnetID, txID)); err != nil {
+					return fmt.Errorf("failed to archive deleted staker: %w", err)
+				}
+			}
+		}
+	}
+	return batch.Write()
+}
+
+func (s *state) archiveUTXO(batch database.KeyValueWriterDeleter, utxoID ids.ID, utxo *avax.UTXO) error {
+	if utxo != nil {
+		utxoBytes, err := txs.GenesisCodec.Marshal(txs.CodecVersion, utxo)
+		if err != nil {
+			return fmt.Errorf("failed to marshal UTXO: %w", err)
+		}
+		if err := batch.Put(archiveUTXOKey(utxoID), utxoBytes); err != nil {
+			return fmt.Errorf("failed to archive UTXO: %w", err)
+		}
+		return archiveUTXOAddresses(batch, utxoID, utxo, false /*=remove*/)
+	}
+
+	if err := batch.Delete(archiveUTXOKey(utxoID)); err != nil {
+		return fmt.Errorf("failed to archive deleted UTXO: %w", err)
+	}
+
+	// The addresses of the deleted UTXO are only known if the UTXO was
+	// persisted prior to this batch.
+	deletedUTXO, err := s.utxoState.GetUTXO(utxoID)
+	if err == database.ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return archiveUTXOAddresses(batch, utxoID, deletedUTXO, true /*=remove*/)
+}
+
+func archiveUTXOAddresses(
+	batch database.KeyValueWriterDeleter,
+	utxoID ids.ID,
+	utxo *avax.UTXO,
+	remove bool,
+) error {
+	addressable, ok := utxo.Out.(avax.Addressable)
+	if !ok {
+		return nil
+	}
+
+	for _, addr := range addressable.Addresses() {
+		key := archiveAddressKey(addr, utxoID)
+		var err error
+		if remove {
+			err = batch.Delete(key)
+		} else {
+			err = batch.Put(key, nil)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to archive UTXO address: %w", err)
+		}
+	}
+	return nil
+}
+
+func archiveSubnetOwner(batch database.KeyValueWriter, subnetID ids.ID, owner fx.Owner) error {
+	ownerBytes, err := block.GenesisCodec.Marshal(block.CodecVersion, &owner)
+	if err != nil {
+		return fmt.Errorf("failed to marshal subnet owner: %w", err)
+	}
+	if err := batch.Put(archiveSubnetKey(archiveSubnetOwnerPrefix, subnetID), ownerBytes); err != nil {
+		return fmt.Errorf("failed to archive subnet owner: %w", err)
+	}
+	return nil
+}
+
+func archiveStaker(batch database.KeyValueWriter, staker *Staker) error {
+	metadataBytes, err := block.GenesisCodec.Marshal(block.CodecVersion, &archivedStaker{
+		StartTime:       uint64(staker.StartTime.Unix()),
+		PotentialReward: staker.PotentialReward,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal staker: %w", err)
+	}
+	if err := batch.Put(archiveStakerKey(staker.SubnetID, staker.TxID), metadataBytes); err != nil {
+		return fmt.Errorf("failed to archive staker: %w", err)
+	}
+	return nil
+}
+
+func archiveSubnetKey(prefix byte, subnetID ids.ID) []byte {
+	key := make([]byte, 1+ids.IDLen)
+	key[0] = prefix
+	copy(key[1:], subnetID[:])
+	return key
+}
+
+func archiveStakerKey(subnetID ids.ID, txID ids.ID) []byte {
+	key := make([]byte, 1+2*ids.IDLen)
+	key[0] = archiveStakerPrefix
+	copy(key[1:], subnetID[:])
+	copy(key[1+ids.IDLen:], txID[:])
+	return key
+}
+
+func archiveUTXOKey(utxoID ids.ID) []byte {
+	key := make([]byte, 1+ids.IDLen)
+	key[0] = archiveUTXOPrefix
+	copy(key[1:], utxoID[:])
+	return key
+}
+
+func archiveAddressPrefixKey(addr []byte) []byte {
+	key := make([]byte, 1+len(addr))
+	key[0] = archiveAddressPrefix
+	copy(key[1:], addr)
+	return key
+}
+
+func archiveAddressKey(addr []byte, utxoID ids.ID) []byte {
+	key := make([]byte, 1+len(addr)+ids.IDLen)
+	key[0] = archiveAddressPrefix
+	copy(key[1:], addr)
+	copy(key[1+len(addr):], utxoID[:])
+	return key
+}
@@ -0,0 +1,213 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/units"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/block"
+	"github.com/ava-labs/avalanchego/vms/platformvm/config"
+	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
+	"github.com/ava-labs/avalanchego/vms/platformvm/status"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+func TestArchivedState(t *testing.T) {
+	require := require.New(t)
+
+	execCfg, err := config.GetExecutionConfig(nil)
+	require.NoError(err)
+	execCfg.ArchivalMode = true
+
+	s := newStateFromDBWithExecutionConfig(require, memdb.New(), execCfg)
+
+	var (
+		addr      = ids.GenerateTestShortID()
+		assetID   = ids.GenerateTestID()
+		timestamp = time.Unix(1_000, 0)
+		newUTXO   = func(amount uint64) *avax.UTXO {
+			return &avax.UTXO{
+				UTXOID: avax.UTXOID{TxID: ids.GenerateTestID()},
+				Asset:  avax.Asset{ID: assetID},
+				Out: &secp256k1fx.TransferOutput{
+					Amt: amount,
+					OutputOwners: secp256k1fx.OutputOwners{
+						Threshold: 1,
+						Addrs:     []ids.ShortID{addr},
+					},
+				},
+			}
+		}
+		commit = func(height uint64) {
+			blk, err := block.NewApricotCommitBlock(ids.GenerateTestID(), height)
+			require.NoError(err)
+			s.AddStatelessBlock(blk)
+			s.SetLastAccepted(blk.ID())
+			s.SetHeight(height)
+			require.NoError(s.Commit())
+		}
+	)
+
+	// Height 0: a single UTXO exists.
+	utxo0 := newUTXO(units.Avax)
+	s.AddUTXO(utxo0)
+	s.SetTimestamp(timestamp)
+	s.SetCurrentSupply(constants.PrimaryNetworkID, 100)
+	commit(0)
+
+	// Height 1: the UTXO is spent, a subnet is created and a validator is
+	// added.
+	utxo1 := newUTXO(2 * units.Avax)
+	s.DeleteUTXO(utxo0.InputID())
+	s.AddUTXO(utxo1)
+	s.SetTimestamp(timestamp.Add(time.Second))
+	s.SetCurrentSupply(constants.PrimaryNetworkID, 200)
+
+	subnetOwner := &secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{addr},
+	}
+	createSubnetTx := &txs.Tx{Unsigned: &txs.CreateSubnetTx{
+		Owner: subnetOwner,
+	}}
+	require.NoError(createSubnetTx.Initialize(txs.Codec))
+	subnetID := createSubnetTx.ID()
+	s.AddSubnet(createSubnetTx)
+	s.AddTx(createSubnetTx, status.Committed)
+
+	validatorTx := &txs.Tx{Unsigned: &txs.AddValidatorTx{
+		Validator: txs.Validator{
+			NodeID: ids.GenerateTestNodeID(),
+			Start:  uint64(timestamp.Unix()),
+			End:    uint64(timestamp.Add(time.Hour).Unix()),
+			Wght:   units.Avax,
+		},
+		RewardsOwner:     &secp256k1fx.OutputOwners{},
+		DelegationShares: reward.PercentDenominator,
+	}}
+	require.NoError(validatorTx.Initialize(txs.Codec))
+	validator, err := NewCurrentStaker(
+		validatorTx.ID(),
+		validatorTx.Unsigned.(txs.Staker),
+		timestamp,
+		units.MilliAvax,
+	)
+	require.NoError(err)
+	s.PutCurrentValidator(validator)
+	s.AddTx(validatorTx, status.Committed)
+	commit(1)
+
+	// Height 2: the subnet owner is changed and the validator is removed.
+	newSubnetOwner := &secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{ids.GenerateTestShortID()},
+	}
+	s.SetSubnetOwner(subnetID, newSubnetOwner)
+	s.DeleteCurrentValidator(validator)
+	commit(2)
+
+	tests := []struct {
+		height            uint64
+		expectedTimestamp time.Time
+		expectedSupply    uint64
+		expectedUTXOs     []*avax.UTXO
+		expectedOwner     *secp256k1fx.OutputOwners
+		expectedStakers   []*Staker
+	}{
+		{
+			height:            0,
+			expectedTimestamp: timestamp,
+			expectedSupply:    100,
+			expectedUTXOs:     []*avax.UTXO{utxo0},
+		},
+		{
+			height:            1,
+			expectedTimestamp: timestamp.Add(time.Second),
+			expectedSupply:    200,
+			expectedUTXOs:     []*avax.UTXO{utxo1},
+			expectedOwner:     subnetOwner,
+			expectedStakers:   []*Staker{validator},
+		},
+		{
+			height:            2,
+			expectedTimestamp: timestamp.Add(time.Second),
+			expectedSupply:    200,
+			expectedUTXOs:     []*avax.UTXO{utxo1},
+			expectedOwner:     newSubnetOwner,
+		},
+	}
+	for _, test := range tests {
+		archivedState, err := s.GetArchivedState(test.height)
+		require.NoError(err)
+
+		archivedTimestamp, err := archivedState.GetTimestamp()
+		require.NoError(err)
+		require.Equal(test.expectedTimestamp.Unix(), archivedTimestamp.Unix())
+
+		supply, err := archivedState.GetCurrentSupply(constants.PrimaryNetworkID)
+		require.NoError(err)
+		require.Equal(test.expectedSupply, supply)
+
+		utxoIDs, err := archivedState.UTXOIDs(addr.Bytes(), ids.Empty, 10)
+		require.NoError(err)
+		require.Len(utxoIDs, len(test.expectedUTXOs))
+		for i, expectedUTXO := range test.expectedUTXOs {
+			require.Equal(expectedUTXO.InputID(), utxoIDs[i])
+
+			utxo, err := archivedState.GetUTXO(utxoIDs[i])
+			require.NoError(err)
+			require.Equal(expectedUTXO.InputID(), utxo.InputID())
+			require.Equal(expectedUTXO.Out, utxo.Out)
+		}
+
+		owner, err := archivedState.GetSubnetOwner(subnetID)
+		if test.expectedOwner == nil {
+			require.ErrorIs(err, database.ErrNotFound)
+		} else {
+			require.NoError(err)
+			require.Equal(test.expectedOwner, owner)
+		}
+
+		stakers, err := archivedState.GetCurrentStakers(constants.PrimaryNetworkID)
+		require.NoError(err)
+		require.Equal(test.expectedStakers, stakers)
+	}
+
+	_, err = s.GetArchivedState(3)
+	require.ErrorIs(err, ErrHeightNotAccepted)
+}
+
+func TestArchivedStateDisabled(t *testing.T) {
+	require := require.New(t)
+
+	s, _ := newUninitializedState(require)
+	_, err := s.GetArchivedState(0)
+	require.ErrorIs(err, ErrArchivalModeDisabled)
+}
+
+func TestArchivedStateMissing(t *testing.T) {
+	require := require.New(t)
+
+	s, db := newUninitializedState(require)
+	require.NoError(s.doneInit())
+	require.NoError(s.Commit())
+
+	execCfg, err := config.GetExecutionConfig(nil)
+	require.NoError(err)
+	execCfg.ArchivalMode = true
+
+	s = newStateFromDBWithExecutionConfig(require, db, execCfg)
+	err = s.loadArchive()
+	require.ErrorIs(err, errArchiveMissing)
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/gas"
 	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
 	"github.com/ava-labs/avalanchego/vms/platformvm/status"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
@@ -73,6 +74,30 @@ func TestDiffCurrentSupply(t *testing.T) {
 	require.Equal(initialCurrentSupply, returnedBaseCurrentSupply)
 }
 
+func TestDiffFeeState(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+
+	lastAcceptedID := ids.GenerateTestID()
+	state := newInitializedState(require)
+	versions := NewMockVersions(ctrl)
+	versions.EXPECT().GetState(lastAcceptedID).AnyTimes().Return(state, true)
+
+	d, err := NewDiff(lastAcceptedID, versions)
+	require.NoError(err)
+
+	initialFeeState := d.GetFeeState()
+	newFeeState := gas.State{
+		Excess: initialFeeState.Excess + 1,
+	}
+	d.SetFeeState(newFeeState)
+	require.Equal(newFeeState, d.GetFeeState())
+	require.Equal(initialFeeState, state.GetFeeState())
+
+	require.NoError(d.Apply(state))
+	require.Equal(newFeeState, state.GetFeeState())
+}
+
 func TestDiffCurrentValidator(t *testing.T) {
 	require := require.New(t)
 	ctrl := gomock.NewController(t)
@@ -81,6 +106,7 @@ func TestDiffCurrentValidator(t *testing.T) {
 	state := NewMockState(ctrl)
 	// Called in NewDiff
 	state.EXPECT().GetTimestamp().Return(time.Now()).Times(1)
+	state.EXPECT().GetFeeState().Return(gas.State{}).Times(1)
 
 	states := NewMockVersions(ctrl)
 	states.EXPECT().GetState(lastAcceptedID).Return(state, true).AnyTimes()
@@ -118,6 +144,7 @@ func TestDiffPendingValidator(t *testing.T) {
 	state := NewMockState(ctrl)
 	// Called in NewDiff
 	state.EXPECT().GetTimestamp().Return(time.Now()).Times(1)
+	state.EXPECT().GetFeeState().Return(gas.State{}).Times(1)
 
 	states := NewMockVersions(ctrl)
 	states.EXPECT().GetState(lastAcceptedID).Return(state, true).AnyTimes()
@@ -160,6 +187,7 @@ func TestDiffCurrentDelegator(t *testing.T) {
 	state := NewMockState(ctrl)
 	// Called in NewDiff
 	state.EXPECT().GetTimestamp().Return(time.Now()).Times(1)
+	state.EXPECT().GetFeeState().Return(gas.State{}).Times(1)
 
 	states := NewMockVersions(ctrl)
 	lastAcceptedID := ids.GenerateTestID()
@@ -209,6 +237,7 @@ func TestDiffPendingDelegator(t *testing.T) {
 	state := NewMockState(ctrl)
 	// Called in NewDiff
 	state.EXPECT().GetTimestamp().Return(time.Now()).Times(1)
+	state.EXPECT().GetFeeState().Return(gas.State{}).Times(1)
 
 	states := NewMockVersions(ctrl)
 	lastAcceptedID := ids.GenerateTestID()
@@ -349,6 +378,7 @@ func TestDiffTx(t *testing.T) {
 	state := NewMockState(ctrl)
 	// Called in NewDiff
 	state.EXPECT().GetTimestamp().Return(time.Now()).Times(1)
+	state.EXPECT().GetFeeState().Return(gas.State{}).Times(1)
 
 	states := NewMockVersions(ctrl)
 	lastAcceptedID := ids.GenerateTestID()
@@ -445,6 +475,7 @@ func TestDiffUTXO(t *testing.T) {
 	state := NewMockState(ctrl)
 	// Called in NewDiff
 	state.EXPECT().GetTimestamp().Return(time.Now()).Times(1)
+	state.EXPECT().GetFeeState().Return(gas.State{}).Times(1)
 
 	states := NewMockVersions(ctrl)
 	lastAcceptedID := ids.GenerateTestID()
@@ -508,6 +539,7 @@ func assertChainsEqual(t *testing.T, expected, actual Chain) {
 	}
 
 	require.Equal(expected.GetTimestamp(), actual.GetTimestamp())
+	require.Equal(expected.GetFeeState(), actual.GetFeeState())
 
 	expectedCurrentSupply, err := expected.GetCurrentSupply(constants.PrimaryNetworkID)
 	require.NoError(err)
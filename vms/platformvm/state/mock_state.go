@@ -20,6 +20,7 @@ import (
 	validators "github.com/ava-labs/avalanchego/snow/validators"
 	logging "github.com/ava-labs/avalanchego/utils/logging"
 	avax "github.com/ava-labs/avalanchego/vms/components/avax"
+	gas "github.com/ava-labs/avalanchego/vms/components/gas"
 	block "github.com/ava-labs/avalanchego/vms/platformvm/block"
 	fx "github.com/ava-labs/avalanchego/vms/platformvm/fx"
 	status "github.com/ava-labs/avalanchego/vms/platformvm/status"
@@ -257,6 +258,20 @@ func (mr *MockChainMockRecorder) GetDelegateeReward(arg0, arg1 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDelegateeReward", reflect.TypeOf((*MockChain)(nil).GetDelegateeReward), arg0, arg1)
 }
 
+// GetFeeState mocks base method.
+func (m *MockChain) GetFeeState() gas.State {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeeState")
+	ret0, _ := ret[0].(gas.State)
+	return ret0
+}
+
+// GetFeeState indicates an expected call of GetFeeState.
+func (mr *MockChainMockRecorder) GetFeeState() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeeState", reflect.TypeOf((*MockChain)(nil).GetFeeState))
+}
+
 // GetPendingDelegatorIterator mocks base method.
 func (m *MockChain) GetPendingDelegatorIterator(arg0 ids.ID, arg1 ids.NodeID) (StakerIterator, error) {
 	m.ctrl.T.Helper()
@@ -451,6 +466,18 @@ func (mr *MockChainMockRecorder) SetDelegateeReward(arg0, arg1, arg2 any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDelegateeReward", reflect.TypeOf((*MockChain)(nil).SetDelegateeReward), arg0, arg1, arg2)
 }
 
+// SetFeeState mocks base method.
+func (m *MockChain) SetFeeState(arg0 gas.State) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetFeeState", arg0)
+}
+
+// SetFeeState indicates an expected call of SetFeeState.
+func (mr *MockChainMockRecorder) SetFeeState(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFeeState", reflect.TypeOf((*MockChain)(nil).SetFeeState), arg0)
+}
+
 // SetSubnetOwner mocks base method.
 func (m *MockChain) SetSubnetOwner(arg0 ids.ID, arg1 fx.Owner) {
 	m.ctrl.T.Helper()
@@ -719,6 +746,20 @@ func (mr *MockDiffMockRecorder) GetDelegateeReward(arg0, arg1 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDelegateeReward", reflect.TypeOf((*MockDiff)(nil).GetDelegateeReward), arg0, arg1)
 }
 
+// GetFeeState mocks base method.
+func (m *MockDiff) GetFeeState() gas.State {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeeState")
+	ret0, _ := ret[0].(gas.State)
+	return ret0
+}
+
+// GetFeeState indicates an expected call of GetFeeState.
+func (mr *MockDiffMockRecorder) GetFeeState() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeeState", reflect.TypeOf((*MockDiff)(nil).GetFeeState))
+}
+
 // GetPendingDelegatorIterator mocks base method.
 func (m *MockDiff) GetPendingDelegatorIterator(arg0 ids.ID, arg1 ids.NodeID) (StakerIterator, error) {
 	m.ctrl.T.Helper()
@@ -913,6 +954,18 @@ func (mr *MockDiffMockRecorder) SetDelegateeReward(arg0, arg1, arg2 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDelegateeReward", reflect.TypeOf((*MockDiff)(nil).SetDelegateeReward), arg0, arg1, arg2)
 }
 
+// SetFeeState mocks base method.
+func (m *MockDiff) SetFeeState(arg0 gas.State) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetFeeState", arg0)
+}
+
+// SetFeeState indicates an expected call of SetFeeState.
+func (mr *MockDiffMockRecorder) SetFeeState(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFeeState", reflect.TypeOf((*MockDiff)(nil).SetFeeState), arg0)
+}
+
 // SetSubnetOwner mocks base method.
 func (m *MockDiff) SetSubnetOwner(arg0 ids.ID, arg1 fx.Owner) {
 	m.ctrl.T.Helper()
@@ -1201,6 +1254,36 @@ func (mr *MockStateMockRecorder) DeleteUTXO(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUTXO", reflect.TypeOf((*MockState)(nil).DeleteUTXO), arg0)
 }
 
+// GetAddressTxs mocks base method.
+func (m *MockState) GetAddressTxs(arg0 ids.ShortID, arg1 ids.ID, arg2, arg3 uint64) ([]ids.ID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAddressTxs", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]ids.ID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAddressTxs indicates an expected call of GetAddressTxs.
+func (mr *MockStateMockRecorder) GetAddressTxs(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAddressTxs", reflect.TypeOf((*MockState)(nil).GetAddressTxs), arg0, arg1, arg2, arg3)
+}
+
+// GetArchivedState mocks base method.
+func (m *MockState) GetArchivedState(arg0 uint64) (ArchivedState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArchivedState", arg0)
+	ret0, _ := ret[0].(ArchivedState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetArchivedState indicates an expected call of GetArchivedState.
+func (mr *MockStateMockRecorder) GetArchivedState(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArchivedState", reflect.TypeOf((*MockState)(nil).GetArchivedState), arg0)
+}
+
 // GetBlockIDAtHeight mocks base method.
 func (m *MockState) GetBlockIDAtHeight(arg0 uint64) (ids.ID, error) {
 	m.ctrl.T.Helper()
@@ -1306,6 +1389,20 @@ func (mr *MockStateMockRecorder) GetDelegateeReward(arg0, arg1 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDelegateeReward", reflect.TypeOf((*MockState)(nil).GetDelegateeReward), arg0, arg1)
 }
 
+// GetFeeState mocks base method.
+func (m *MockState) GetFeeState() gas.State {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeeState")
+	ret0, _ := ret[0].(gas.State)
+	return ret0
+}
+
+// GetFeeState indicates an expected call of GetFeeState.
+func (mr *MockStateMockRecorder) GetFeeState() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeeState", reflect.TypeOf((*MockState)(nil).GetFeeState))
+}
+
 // GetLastAccepted mocks base method.
 func (m *MockState) GetLastAccepted() ids.ID {
 	m.ctrl.T.Helper()
@@ -1516,6 +1613,21 @@ func (mr *MockStateMockRecorder) GetUptime(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUptime", reflect.TypeOf((*MockState)(nil).GetUptime), arg0, arg1)
 }
 
+// GetUptimeHistory mocks base method.
+func (m *MockState) GetUptimeHistory(arg0 ids.ID, arg1 ids.NodeID, arg2, arg3 time.Time) ([]*UptimeSample, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUptimeHistory", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*UptimeSample)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUptimeHistory indicates an expected call of GetUptimeHistory.
+func (mr *MockStateMockRecorder) GetUptimeHistory(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUptimeHistory", reflect.TypeOf((*MockState)(nil).GetUptimeHistory), arg0, arg1, arg2, arg3)
+}
+
 // PutCurrentDelegator mocks base method.
 func (m *MockState) PutCurrentDelegator(arg0 *Staker) {
 	m.ctrl.T.Helper()
@@ -1564,6 +1676,20 @@ func (mr *MockStateMockRecorder) PutPendingValidator(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutPendingValidator", reflect.TypeOf((*MockState)(nil).PutPendingValidator), arg0)
 }
 
+// PutUptimeSamples mocks base method.
+func (m *MockState) PutUptimeSamples(arg0 []*UptimeSample) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutUptimeSamples", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutUptimeSamples indicates an expected call of PutUptimeSamples.
+func (mr *MockStateMockRecorder) PutUptimeSamples(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutUptimeSamples", reflect.TypeOf((*MockState)(nil).PutUptimeSamples), arg0)
+}
+
 // ReindexBlocks mocks base method.
 func (m *MockState) ReindexBlocks(arg0 sync.Locker, arg1 logging.Logger) error {
 	m.ctrl.T.Helper()
@@ -1604,6 +1730,18 @@ func (mr *MockStateMockRecorder) SetDelegateeReward(arg0, arg1, arg2 any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDelegateeReward", reflect.TypeOf((*MockState)(nil).SetDelegateeReward), arg0, arg1, arg2)
 }
 
+// SetFeeState mocks base method.
+func (m *MockState) SetFeeState(arg0 gas.State) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetFeeState", arg0)
+}
+
+// SetFeeState indicates an expected call of SetFeeState.
+func (mr *MockStateMockRecorder) SetFeeState(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFeeState", reflect.TypeOf((*MockState)(nil).SetFeeState), arg0)
+}
+
 // SetHeight mocks base method.
 func (m *MockState) SetHeight(arg0 uint64) {
 	m.ctrl.T.Helper()
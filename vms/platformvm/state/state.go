@@ -34,6 +34,8 @@ import (
 	"github.com/ava-labs/avalanchego/utils/timer"
 	"github.com/ava-labs/avalanchego/utils/wrappers"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/gas"
+	"github.com/ava-labs/avalanchego/vms/components/index"
 	"github.com/ava-labs/avalanchego/vms/platformvm/block"
 	"github.com/ava-labs/avalanchego/vms/platformvm/config"
 	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
@@ -42,6 +44,7 @@ import (
 	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
 	"github.com/ava-labs/avalanchego/vms/platformvm/status"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/x/archivedb"
 
 	safemath "github.com/ava-labs/avalanchego/utils/math"
 )
@@ -79,8 +82,12 @@ var (
 	SupplyPrefix                  = []byte("supply")
 	ChainPrefix                   = []byte("chain")
 	SingletonPrefix               = []byte("singleton")
+	ArchivePrefix                 = []byte("archive")
+	AddressTxsPrefix              = []byte("addressTxs")
+	UptimeHistoryPrefix           = []byte("uptimeHistory")
 
 	TimestampKey       = []byte("timestamp")
+	FeeStateKey        = []byte("fee state")
 	CurrentSupplyKey   = []byte("current supply")
 	LastAcceptedKey    = []byte("last accepted")
 	HeightsIndexedKey  = []byte("heights indexed")
@@ -99,6 +106,9 @@ type Chain interface {
 	GetTimestamp() time.Time
 	SetTimestamp(tm time.Time)
 
+	GetFeeState() gas.State
+	SetFeeState(f gas.State)
+
 	GetCurrentSupply(subnetID ids.ID) (uint64, error)
 	SetCurrentSupply(subnetID ids.ID, cs uint64)
 
@@ -137,6 +147,27 @@ type State interface {
 	GetSubnets() ([]*txs.Tx, error)
 	GetChains(subnetID ids.ID) ([]*txs.Tx, error)
 
+	// GetArchivedState returns the state as of the accepted [height]. Returns
+	// ErrArchivalModeDisabled if the state is not being archived.
+	GetArchivedState(height uint64) (ArchivedState, error)
+
+	// GetAddressTxs returns up to [pageSize] IDs of the accepted txs that
+	// consumed or produced [assetID] outputs owned by [address], starting at
+	// [cursor]. Returns ErrAddressTxsIndexDisabled if the index is disabled.
+	GetAddressTxs(address ids.ShortID, assetID ids.ID, cursor, pageSize uint64) ([]ids.ID, error)
+
+	// PutUptimeSamples persists [samples] and deletes the samples that are
+	// older than the retention period. Samples are persisted immediately,
+	// rather than on Commit, as they aren't part of the chain state, so this
+	// may be called without holding the context lock. Returns
+	// ErrUptimeHistoryDisabled if the uptime history is disabled.
+	PutUptimeSamples(samples []*UptimeSample) error
+
+	// GetUptimeHistory returns the samples of [nodeID] on [subnetID] taken
+	// between [start] and [end], inclusive, sorted by time. Returns
+	// ErrUptimeHistoryDisabled if the uptime history is disabled.
+	GetUptimeHistory(subnetID ids.ID, nodeID ids.NodeID, start, end time.Time) ([]*UptimeSample, error)
+
 	// ApplyValidatorWeightDiffs iterates from [startHeight] towards the genesis
 	// block until it has applied all of the diffs up to and including
 	// [endHeight]. Applying the diffs modifies [validators].
@@ -263,13 +294,21 @@ type stateBlk struct {
  * | '-. subnetID
  * |   '-. list
  * |     '-- txID -> nil
- * '-. singletons
- *   |-- initializedKey -> nil
- *   |-- blocksReindexedKey -> nil
- *   |-- timestampKey -> timestamp
- *   |-- currentSupplyKey -> currentSupply
- *   |-- lastAcceptedKey -> lastAccepted
- *   '-- heightsIndexKey -> startIndexHeight + endIndexHeight
+ * |-. singletons
+ * | |-- initializedKey -> nil
+ * | |-- blocksReindexedKey -> nil
+ * | |-- timestampKey -> timestamp
+ * | |-- feeStateKey -> feeState
+ * | |-- currentSupplyKey -> currentSupply
+ * | |-- lastAcceptedKey -> lastAccepted
+ * | '-- heightsIndexKey -> startIndexHeight + endIndexHeight
+ * |-. archive (only if archival mode is enabled)
+ * | '-- archivedb of key -> value at each height
+ * |-. addressTxs (only if the address tx index is enabled)
+ * | |-- indexedHeightKey -> height
+ * | '-- address tx index of address -> assetID -> index -> txID
+ * '-. uptimeHistory (only if the uptime history is enabled)
+ *   '-- subnetID + nodeID + timestamp -> uptime sample
  */
 type state struct {
 	validatorState
@@ -355,12 +394,26 @@ type state struct {
 
 	// The persisted fields represent the current database value
 	timestamp, persistedTimestamp         time.Time
+	feeState, persistedFeeState           gas.State
 	currentSupply, persistedCurrentSupply uint64
 	// [lastAccepted] is the most recently accepted block.
 	lastAccepted, persistedLastAccepted ids.ID
 	// TODO: Remove indexedHeights once v1.11.3 has been released.
 	indexedHeights *heightRange
 	singletonDB    database.Database
+
+	// archive is nil if archival mode is disabled
+	archive *archivedb.Database
+
+	// addressTxs is nil if the address tx index is disabled
+	addressTxs   index.AddressTxsIndexer
+	addressTxsDB database.Database
+	// addressTxsGenesisUTXOs is only populated while reindexing
+	addressTxsGenesisUTXOs map[ids.ID]*avax.UTXO
+
+	// uptimeHistoryDB is nil if the uptime history is disabled
+	uptimeHistoryDB        database.Database
+	uptimeHistoryRetention time.Duration
 }
 
 // heightRange is used to track which heights are safe to use the native DB
@@ -584,6 +637,30 @@ func newState(
 		return nil, err
 	}
 
+	var archive *archivedb.Database
+	if execCfg.ArchivalMode {
+		archive = archivedb.New(prefixdb.New(ArchivePrefix, baseDB))
+	}
+
+	var (
+		addressTxs   index.AddressTxsIndexer
+		addressTxsDB database.Database
+	)
+	if execCfg.IndexAddressTxs {
+		addressTxsDB = prefixdb.New(AddressTxsPrefix, baseDB)
+		addressTxs, err = index.NewIndexer(addressTxsDB, ctx.Log, "", metricsReg, true)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// The uptime history isn't part of the chain state, so it is written
+	// directly to [db] rather than through [baseDB].
+	var uptimeHistoryDB database.Database
+	if execCfg.UptimeHistoryEnabled {
+		uptimeHistoryDB = prefixdb.New(UptimeHistoryPrefix, db)
+	}
+
 	return &state{
 		validatorState: newValidatorState(),
 
@@ -660,6 +737,14 @@ func newState(
 		chainDBCache: chainDBCache,
 
 		singletonDB: prefixdb.New(SingletonPrefix, baseDB),
+
+		archive: archive,
+
+		addressTxs:   addressTxs,
+		addressTxsDB: addressTxsDB,
+
+		uptimeHistoryDB:        uptimeHistoryDB,
+		uptimeHistoryRetention: execCfg.UptimeHistoryRetention,
 	}, nil
 }
 
@@ -1019,6 +1104,14 @@ func (s *state) SetLastAccepted(lastAccepted ids.ID) {
 	s.lastAccepted = lastAccepted
 }
 
+func (s *state) GetFeeState() gas.State {
+	return s.feeState
+}
+
+func (s *state) SetFeeState(feeState gas.State) {
+	s.feeState = feeState
+}
+
 func (s *state) GetCurrentSupply(subnetID ids.ID) (uint64, error) {
 	if subnetID == constants.PrimaryNetworkID {
 		return s.currentSupply, nil
@@ -1279,6 +1372,7 @@ func (s *state) syncGenesis(genesisBlk block.Block, genesis *genesis.Genesis) er
 func (s *state) load() error {
 	return utils.Err(
 		s.loadMetadata(),
+		s.loadArchive(), // Must be called after loadMetadata
 		s.loadCurrentValidators(),
 		s.loadPendingValidators(),
 		s.initValidatorSets(),
@@ -1293,6 +1387,13 @@ func (s *state) loadMetadata() error {
 	s.persistedTimestamp = timestamp
 	s.SetTimestamp(timestamp)
 
+	feeState, err := getFeeState(s.singletonDB)
+	if err != nil {
+		return err
+	}
+	s.persistedFeeState = feeState
+	s.SetFeeState(feeState)
+
 	currentSupply, err := database.GetUInt64(s.singletonDB, CurrentSupplyKey)
 	if err != nil {
 		return err
@@ -1633,6 +1734,8 @@ func (s *state) write(updateValidators bool, height uint64) error {
 	}
 
 	return utils.Err(
+		s.writeArchive(height),    // Must be called before any other writes
+		s.writeAddressTxs(height), // Must be called before any other writes
 		s.writeBlocks(),
 		s.writeCurrentStakers(updateValidators, height, codecVersion),
 		s.writePendingStakers(),
@@ -1650,7 +1753,12 @@ func (s *state) write(updateValidators bool, height uint64) error {
 }
 
 func (s *state) Close() error {
+	var archiveErr error
+	if s.archive != nil {
+		archiveErr = s.archive.Close()
+	}
 	return utils.Err(
+		archiveErr,
 		s.pendingSubnetValidatorBaseDB.Close(),
 		s.pendingSubnetDelegatorBaseDB.Close(),
 		s.pendingDelegatorBaseDB.Close(),
@@ -1701,6 +1809,13 @@ func (s *state) sync(genesis []byte) error {
 			err,
 		)
 	}
+
+	if err := s.reindexAddressTxs(genesis); err != nil {
+		return fmt.Errorf(
+			"failed to reindex the address txs: %w",
+			err,
+		)
+	}
 	return nil
 }
 
@@ -2269,6 +2384,12 @@ func (s *state) writeMetadata() error {
 		}
 		s.persistedTimestamp = s.timestamp
 	}
+	if s.persistedFeeState != s.feeState {
+		if err := database.PutUInt64(s.singletonDB, FeeStateKey, uint64(s.feeState.Excess)); err != nil {
+			return fmt.Errorf("failed to write fee state: %w", err)
+		}
+		s.persistedFeeState = s.feeState
+	}
 	if s.persistedCurrentSupply != s.currentSupply {
 		if err := database.PutUInt64(s.singletonDB, CurrentSupplyKey, s.currentSupply); err != nil {
 			return fmt.Errorf("failed to write current supply: %w", err)
@@ -2293,6 +2414,21 @@ func (s *state) writeMetadata() error {
 	return nil
 }
 
+// getFeeState returns the persisted fee state. If the fee state has never been
+// written, the zero fee state is returned.
+func getFeeState(db database.KeyValueReader) (gas.State, error) {
+	excess, err := database.GetUInt64(db, FeeStateKey)
+	if err == database.ErrNotFound {
+		return gas.State{}, nil
+	}
+	if err != nil {
+		return gas.State{}, err
+	}
+	return gas.State{
+		Excess: gas.Gas(excess),
+	}, nil
+}
+
 // Returns the block and whether it is a [stateBlk].
 // Invariant: blkBytes is safe to parse with blocks.GenesisCodec
 //
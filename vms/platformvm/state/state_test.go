@@ -27,6 +27,7 @@ import (
 	"github.com/ava-labs/avalanchego/utils/units"
 	"github.com/ava-labs/avalanchego/utils/wrappers"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/gas"
 	"github.com/ava-labs/avalanchego/vms/platformvm/block"
 	"github.com/ava-labs/avalanchego/vms/platformvm/config"
 	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
@@ -775,6 +776,10 @@ func newUninitializedState(require *require.Assertions) (*state, database.Databa
 
 func newStateFromDB(require *require.Assertions, db database.Database) *state {
 	execCfg, _ := config.GetExecutionConfig(nil)
+	return newStateFromDBWithExecutionConfig(require, db, execCfg)
+}
+
+func newStateFromDBWithExecutionConfig(require *require.Assertions, db database.Database, execCfg *config.ExecutionConfig) *state {
 	state, err := newState(
 		db,
 		metrics.Noop,
@@ -1401,6 +1406,26 @@ func TestStateSubnetOwner(t *testing.T) {
 	require.Equal(owner2, owner)
 }
 
+func TestStateFeeState(t *testing.T) {
+	var (
+		require = require.New(t)
+		s       = newInitializedState(require).(*state)
+	)
+
+	require.Equal(gas.State{}, s.GetFeeState())
+
+	expectedFeeState := gas.State{
+		Excess: 12345,
+	}
+	s.SetFeeState(expectedFeeState)
+	require.Equal(expectedFeeState, s.GetFeeState())
+	require.NoError(s.Commit())
+
+	feeState, err := getFeeState(s.singletonDB)
+	require.NoError(err)
+	require.Equal(expectedFeeState, feeState)
+}
+
 func makeBlocks(require *require.Assertions) []block.Block {
 	var blks []block.Block
 	{
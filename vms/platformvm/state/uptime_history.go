@@ -0,0 +1,212 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"errors"
+	"time"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+)
+
+const (
+	uptimeHistoryValidatorKeyLen = ids.IDLen + ids.NodeIDLen
+	uptimeHistoryKeyLen          = uptimeHistoryValidatorKeyLen + database.Uint64Size
+)
+
+var (
+	ErrUptimeHistoryDisabled = errors.New("uptime history is disabled")
+
+	errUnexpectedUptimeHistoryKeyLen = errors.New("unexpected uptime history key length")
+)
+
+// UptimeSample is the uptime of a validator of a subnet as observed by this
+// node at a point in time.
+type UptimeSample struct {
+	SubnetID ids.ID
+	NodeID   ids.NodeID
+	// Timestamp is the time the sample was taken, truncated to the second.
+	Timestamp time.Time
+	// StartTime is the time the validator started validating the subnet.
+	StartTime time.Time
+	// UpDuration is the duration the validator was observed to be online
+	// since [StartTime].
+	UpDuration time.Duration
+	// Connected is true if the validator was connected to this node when the
+	// sample was taken.
+	Connected bool
+}
+
+// Uptime returns the fraction of the time since [StartTime] that the validator
+// was observed to be online.
+func (s *UptimeSample) Uptime() float64 {
+	bestPossibleUpDuration := s.Timestamp.Sub(s.StartTime)
+	if bestPossibleUpDuration <= 0 {
+		return 1
+	}
+	return float64(s.UpDuration) / float64(bestPossibleUpDuration)
+}
+
+type uptimeSampleValue struct {
+	StartTime  uint64 `v0:"true"`
+	UpDuration uint64 `v0:"true"`
+	Connected  bool   `v0:"true"`
+}
+
+// The uptime history is keyed by subnetID + nodeID + timestamp so that the
+// history of a validator is contiguous and sorted by time.
+func uptimeHistoryKey(subnetID ids.ID, nodeID ids.NodeID, timestamp time.Time) []byte {
+	key := make([]byte, 0, uptimeHistoryKeyLen)
+	key = append(key, subnetID[:]...)
+	key = append(key, nodeID.Bytes()...)
+	return append(key, database.PackUInt64(uint64(timestamp.Unix()))...)
+}
+
+func (s *state) PutUptimeSamples(samples []*UptimeSample) error {
+	if s.uptimeHistoryDB == nil {
+		return ErrUptimeHistoryDisabled
+	}
+	if len(samples) == 0 {
+		return nil
+	}
+
+	batch := s.uptimeHistoryDB.NewBatch()
+	latest := samples[0].Timestamp
+	for _, sample := range samples {
+		valueBytes, err := MetadataCodec.Marshal(CodecVersion0, &uptimeSampleValue{
+			StartTime:  uint64(sample.StartTime.Unix()),
+			UpDuration: uint64(sample.UpDuration),
+			Connected:  sample.Connected,
+		})
+		if err != nil {
+			return err
+		}
+
+		key := uptimeHistoryKey(sample.SubnetID, sample.NodeID, sample.Timestamp)
+		if err := batch.Put(key, valueBytes); err != nil {
+			return err
+		}
+		if sample.Timestamp.After(latest) {
+			latest = sample.Timestamp
+		}
+	}
+	if err := batch.Write(); err != nil {
+		return err
+	}
+	return s.pruneUptimeHistory(latest.Add(-s.uptimeHistoryRetention))
+}
+
+func (s *state) GetUptimeHistory(
+	subnetID ids.ID,
+	nodeID ids.NodeID,
+	start time.Time,
+	end time.Time,
+) ([]*UptimeSample, error) {
+	if s.uptimeHistoryDB == nil {
+		return nil, ErrUptimeHistoryDisabled
+	}
+
+	startKey := uptimeHistoryKey(subnetID, nodeID, start)
+	it := s.uptimeHistoryDB.NewIteratorWithStartAndPrefix(
+		startKey,
+		startKey[:uptimeHistoryValidatorKeyLen],
+	)
+	defer it.Release()
+
+	var samples []*UptimeSample
+	for it.Next() {
+		key := it.Key()
+		if len(key) != uptimeHistoryKeyLen {
+			return nil, errUnexpectedUptimeHistoryKeyLen
+		}
+		timestamp, err := database.ParseUInt64(key[uptimeHistoryValidatorKeyLen:])
+		if err != nil {
+			return nil, err
+		}
+		if timestamp > uint64(end.Unix()) {
+			break
+		}
+
+		var value uptimeSampleValue
+		if _, err := MetadataCodec.Unmarshal(it.Value(), &value); err != nil {
+			return nil, err
+		}
+		samples = append(samples, &UptimeSample{
+			SubnetID:   subnetID,
+			NodeID:     nodeID,
+			Timestamp:  time.Unix(int64(timestamp), 0),
+			StartTime:  time.Unix(int64(value.StartTime), 0),
+			UpDuration: time.Duration(value.UpDuration),
+			Connected:  value.Connected,
+		})
+	}
+	return samples, it.Error()
+}
+
+// pruneUptimeHistory deletes the samples taken before [cutoff], including the
+// samples of validators that have since been removed.
+func (s *state) pruneUptimeHistory(cutoff time.Time) error {
+	// Timestamps are stored as unsigned integers, so there is nothing to prune
+	// before the unix epoch.
+	if cutoff.Unix() <= 0 {
+		return nil
+	}
+
+	var (
+		batch        = s.uptimeHistoryDB.NewBatch()
+		cutoffUnix   = uint64(cutoff.Unix())
+		validatorKey []byte
+	)
+	for {
+		it := s.uptimeHistoryDB.NewIteratorWithStart(validatorKey)
+		validatorKey = nil
+		for it.Next() {
+			key := it.Key()
+			if len(key) != uptimeHistoryKeyLen {
+				it.Release()
+				return errUnexpectedUptimeHistoryKeyLen
+			}
+			timestamp, err := database.ParseUInt64(key[uptimeHistoryValidatorKeyLen:])
+			if err != nil {
+				it.Release()
+				return err
+			}
+			if timestamp < cutoffUnix {
+				if err := batch.Delete(key); err != nil {
+					it.Release()
+					return err
+				}
+				continue
+			}
+
+			// The remaining samples of this validator are all after [cutoff],
+			// so skip to the next validator.
+			validatorKey = nextValidatorKey(key[:uptimeHistoryValidatorKeyLen])
+			break
+		}
+		err := it.Error()
+		it.Release()
+		if err != nil {
+			return err
+		}
+		if validatorKey == nil {
+			return batch.Write()
+		}
+	}
+}
+
+// nextValidatorKey returns the smallest key that is greater than every key
+// prefixed with [validatorKey]. Returns nil if there is no such key.
+func nextValidatorKey(validatorKey []byte) []byte {
+	next := make([]byte, len(validatorKey))
+	copy(next, validatorKey)
+	for i := len(next) - 1; i >= 0; i-- {
+		next[i]++
+		if next[i] != 0 {
+			return next
+		}
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/vms/platformvm/config"
+)
+
+func newUptimeHistoryState(require *require.Assertions, enabled bool, retention time.Duration) *state {
+	execCfg, err := config.GetExecutionConfig(nil)
+	require.NoError(err)
+	execCfg.UptimeHistoryEnabled = enabled
+	execCfg.UptimeHistoryRetention = retention
+	return newStateFromDBWithExecutionConfig(require, memdb.New(), execCfg)
+}
+
+func TestUptimeSampleUptime(t *testing.T) {
+	startTime := time.Unix(1000, 0)
+	tests := []struct {
+		name     string
+		sample   UptimeSample
+		expected float64
+	}{
+		{
+			name: "fully online",
+			sample: UptimeSample{
+				Timestamp:  startTime.Add(time.Hour),
+				StartTime:  startTime,
+				UpDuration: time.Hour,
+			},
+			expected: 1,
+		},
+		{
+			name: "partially online",
+			sample: UptimeSample{
+				Timestamp:  startTime.Add(4 * time.Hour),
+				StartTime:  startTime,
+				UpDuration: 3 * time.Hour,
+			},
+			expected: .75,
+		},
+		{
+			name: "sampled at start time",
+			sample: UptimeSample{
+				Timestamp: startTime,
+				StartTime: startTime,
+			},
+			expected: 1,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, test.sample.Uptime())
+		})
+	}
+}
+
+func TestUptimeHistory(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		s         = newUptimeHistoryState(require, true, 90*time.Minute)
+		subnetID  = ids.GenerateTestID()
+		nodeID0   = ids.GenerateTestNodeID()
+		nodeID1   = ids.GenerateTestNodeID()
+		startTime = time.Unix(1000, 0)
+	)
+	newSample := func(subnetID ids.ID, nodeID ids.NodeID, elapsed time.Duration, connected bool) *UptimeSample {
+		return &UptimeSample{
+			SubnetID:   subnetID,
+			NodeID:     nodeID,
+			Timestamp:  startTime.Add(elapsed),
+			StartTime:  startTime,
+			UpDuration: elapsed / 2,
+			Connected:  connected,
+		}
+	}
+
+	var (
+		node0Samples = []*UptimeSample{
+			newSample(constants.PrimaryNetworkID, nodeID0, time.Hour, true),
+			newSample(constants.PrimaryNetworkID, nodeID0, 2*time.Hour, false),
+			newSample(constants.PrimaryNetworkID, nodeID0, 3*time.Hour, true),
+		}
+		node1Sample       = newSample(constants.PrimaryNetworkID, nodeID1, time.Hour, true)
+		subnetNode0Sample = newSample(subnetID, nodeID0, time.Hour, true)
+	)
+	require.NoError(s.PutUptimeSamples([]*UptimeSample{
+		node0Samples[0],
+		node1Sample,
+		subnetNode0Sample,
+	}))
+	require.NoError(s.PutUptimeSamples(node0Samples[1:2]))
+
+	samples, err := s.GetUptimeHistory(constants.PrimaryNetworkID, nodeID0, startTime, startTime.Add(24*time.Hour))
+	require.NoError(err)
+	require.Equal(node0Samples[:2], samples)
+
+	samples, err = s.GetUptimeHistory(subnetID, nodeID0, startTime, startTime.Add(24*time.Hour))
+	require.NoError(err)
+	require.Equal([]*UptimeSample{subnetNode0Sample}, samples)
+
+	// The range is inclusive.
+	samples, err = s.GetUptimeHistory(constants.PrimaryNetworkID, nodeID0, startTime.Add(2*time.Hour), startTime.Add(2*time.Hour))
+	require.NoError(err)
+	require.Equal(node0Samples[1:2], samples)
+
+	// Adding a sample more than 90 minutes after the first samples prunes them,
+	// including the samples of validators that weren't sampled again.
+	require.NoError(s.PutUptimeSamples(node0Samples[2:]))
+
+	samples, err = s.GetUptimeHistory(constants.PrimaryNetworkID, nodeID0, startTime, startTime.Add(24*time.Hour))
+	require.NoError(err)
+	require.Equal(node0Samples[1:], samples)
+
+	samples, err = s.GetUptimeHistory(constants.PrimaryNetworkID, nodeID1, startTime, startTime.Add(24*time.Hour))
+	require.NoError(err)
+	require.Empty(samples)
+
+	samples, err = s.GetUptimeHistory(subnetID, nodeID0, startTime, startTime.Add(24*time.Hour))
+	require.NoError(err)
+	require.Empty(samples)
+}
+
+func TestUptimeHistoryDisabled(t *testing.T) {
+	require := require.New(t)
+
+	s := newUptimeHistoryState(require, false, time.Hour)
+
+	err := s.PutUptimeSamples([]*UptimeSample{{}})
+	require.ErrorIs(err, ErrUptimeHistoryDisabled)
+
+	_, err = s.GetUptimeHistory(constants.PrimaryNetworkID, ids.GenerateTestNodeID(), time.Time{}, time.Now())
+	require.ErrorIs(err, ErrUptimeHistoryDisabled)
+}
+
+func TestNextValidatorKey(t *testing.T) {
+	require := require.New(t)
+
+	require.Equal([]byte{0x00, 0x02}, nextValidatorKey([]byte{0x00, 0x01}))
+	require.Equal([]byte{0x01, 0x00}, nextValidatorKey([]byte{0x00, 0xff}))
+	require.Nil(nextValidatorKey([]byte{0xff, 0xff}))
+}
@@ -0,0 +1,30 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package executor
+
+import (
+	"time"
+
+	"github.com/ava-labs/avalanchego/vms/platformvm/config"
+	"github.com/ava-labs/avalanchego/vms/platformvm/state"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs/fee"
+)
+
+// NewFeeCalculator returns the fee calculator that should be used to verify
+// txs that are executed at [chainTime] on top of [chainState].
+//
+// Prior to the E upgrade, fees are fixed per transaction type. After the E
+// upgrade, fees are priced by the transaction's complexity at the gas price
+// implied by the fee state of [chainState].
+func NewFeeCalculator(cfg *config.Config, chainTime time.Time, chainState state.Chain) fee.Calculator {
+	if !cfg.UpgradeConfig.IsEActivated(chainTime) {
+		return fee.NewStaticCalculator(cfg.StaticFeeConfig, cfg.UpgradeConfig, chainTime)
+	}
+
+	feeState := chainState.GetFeeState()
+	return fee.NewDynamicCalculator(
+		cfg.DynamicFeeConfig.Weights,
+		feeState.Price(cfg.DynamicFeeConfig),
+	)
+}
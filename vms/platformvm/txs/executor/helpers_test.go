@@ -33,6 +33,7 @@ import (
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/utils/timer/mockable"
 	"github.com/ava-labs/avalanchego/utils/units"
+	"github.com/ava-labs/avalanchego/vms/components/gas"
 	"github.com/ava-labs/avalanchego/vms/platformvm/api"
 	"github.com/ava-labs/avalanchego/vms/platformvm/config"
 	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
@@ -285,6 +286,18 @@ func defaultConfig(t *testing.T, f fork) *config.Config {
 			CreateSubnetTxFee:     100 * defaultTxFee,
 			CreateBlockchainTxFee: 100 * defaultTxFee,
 		},
+		DynamicFeeConfig: gas.Config{
+			Weights: gas.Dimensions{
+				gas.Bandwidth: 1,
+				gas.DBRead:    1,
+				gas.DBWrite:   1,
+				gas.Compute:   1,
+			},
+			MaxGasPerBlock:           1_000_000,
+			TargetGasPerBlock:        250_000,
+			MinPrice:                 1,
+			ExcessConversionConstant: 1_000_000,
+		},
 		MinValidatorStake: 5 * units.MilliAvax,
 		MaxValidatorStake: 500 * units.MilliAvax,
 		MinDelegatorStake: 1 * units.MilliAvax,
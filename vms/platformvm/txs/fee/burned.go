@@ -0,0 +1,137 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package fee
+
+import (
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+
+	safemath "github.com/ava-labs/avalanchego/utils/math"
+)
+
+var _ txs.Visitor = (*burnedVisitor)(nil)
+
+// TxBurned returns the amount of [avaxAssetID] that is consumed by [tx] but
+// isn't produced or staked by it, which is the fee paid by [tx].
+//
+// The amounts of the inputs are assumed to match the UTXOs they consume, which
+// is only guaranteed once [tx] has been verified.
+func TxBurned(tx txs.UnsignedTx, avaxAssetID ids.ID) (uint64, error) {
+	v := burnedVisitor{}
+	if err := tx.Visit(&v); err != nil {
+		return 0, err
+	}
+
+	var (
+		consumed uint64
+		produced uint64
+		err      error
+	)
+	for _, in := range v.ins {
+		if in.AssetID() != avaxAssetID {
+			continue
+		}
+		consumed, err = safemath.Add64(consumed, in.Input().Amount())
+		if err != nil {
+			return 0, err
+		}
+	}
+	for _, out := range v.outs {
+		if out.AssetID() != avaxAssetID {
+			continue
+		}
+		produced, err = safemath.Add64(produced, out.Output().Amount())
+		if err != nil {
+			return 0, err
+		}
+	}
+	return safemath.Sub(consumed, produced)
+}
+
+type burnedVisitor struct {
+	// outputs of visitor execution
+	ins  []*avax.TransferableInput
+	outs []*avax.TransferableOutput
+}
+
+func (*burnedVisitor) AdvanceTimeTx(*txs.AdvanceTimeTx) error {
+	return ErrUnsupportedTx
+}
+
+func (*burnedVisitor) RewardValidatorTx(*txs.RewardValidatorTx) error {
+	return ErrUnsupportedTx
+}
+
+func (v *burnedVisitor) AddValidatorTx(tx *txs.AddValidatorTx) error {
+	v.ins = tx.Ins
+	v.outs = append(v.outs, tx.Outs...)
+	v.outs = append(v.outs, tx.StakeOuts...)
+	return nil
+}
+
+func (v *burnedVisitor) AddSubnetValidatorTx(tx *txs.AddSubnetValidatorTx) error {
+	return v.BaseTx(&tx.BaseTx)
+}
+
+func (v *burnedVisitor) AddDelegatorTx(tx *txs.AddDelegatorTx) error {
+	v.ins = tx.Ins
+	v.outs = append(v.outs, tx.Outs...)
+	v.outs = append(v.outs, tx.StakeOuts...)
+	return nil
+}
+
+func (v *burnedVisitor) CreateChainTx(tx *txs.CreateChainTx) error {
+	return v.BaseTx(&tx.BaseTx)
+}
+
+func (v *burnedVisitor) CreateSubnetTx(tx *txs.CreateSubnetTx) error {
+	return v.BaseTx(&tx.BaseTx)
+}
+
+func (v *burnedVisitor) ImportTx(tx *txs.ImportTx) error {
+	v.ins = append(v.ins, tx.Ins...)
+	v.ins = append(v.ins, tx.ImportedInputs...)
+	v.outs = tx.Outs
+	return nil
+}
+
+func (v *burnedVisitor) ExportTx(tx *txs.ExportTx) error {
+	v.ins = tx.Ins
+	v.outs = append(v.outs, tx.Outs...)
+	v.outs = append(v.outs, tx.ExportedOutputs...)
+	return nil
+}
+
+func (v *burnedVisitor) RemoveSubnetValidatorTx(tx *txs.RemoveSubnetValidatorTx) error {
+	return v.BaseTx(&tx.BaseTx)
+}
+
+func (v *burnedVisitor) TransformSubnetTx(tx *txs.TransformSubnetTx) error {
+	return v.BaseTx(&tx.BaseTx)
+}
+
+func (v *burnedVisitor) TransferSubnetOwnershipTx(tx *txs.TransferSubnetOwnershipTx) error {
+	return v.BaseTx(&tx.BaseTx)
+}
+
+func (v *burnedVisitor) AddPermissionlessValidatorTx(tx *txs.AddPermissionlessValidatorTx) error {
+	v.ins = tx.Ins
+	v.outs = append(v.outs, tx.Outs...)
+	v.outs = append(v.outs, tx.StakeOuts...)
+	return nil
+}
+
+func (v *burnedVisitor) AddPermissionlessDelegatorTx(tx *txs.AddPermissionlessDelegatorTx) error {
+	v.ins = tx.Ins
+	v.outs = append(v.outs, tx.Outs...)
+	v.outs = append(v.outs, tx.StakeOuts...)
+	return nil
+}
+
+func (v *burnedVisitor) BaseTx(tx *txs.BaseTx) error {
+	v.ins = tx.Ins
+	v.outs = tx.Outs
+	return nil
+}
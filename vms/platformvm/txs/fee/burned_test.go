@@ -0,0 +1,117 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package fee
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+
+	safemath "github.com/ava-labs/avalanchego/utils/math"
+)
+
+func TestTxBurned(t *testing.T) {
+	var (
+		avaxAssetID  = ids.GenerateTestID()
+		otherAssetID = ids.GenerateTestID()
+	)
+	in := func(assetID ids.ID, amount uint64) *avax.TransferableInput {
+		return &avax.TransferableInput{
+			Asset: avax.Asset{ID: assetID},
+			In: &secp256k1fx.TransferInput{
+				Amt: amount,
+			},
+		}
+	}
+	out := func(assetID ids.ID, amount uint64) *avax.TransferableOutput {
+		return &avax.TransferableOutput{
+			Asset: avax.Asset{ID: assetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: amount,
+			},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		unsignedTx  txs.UnsignedTx
+		expected    uint64
+		expectedErr error
+	}{
+		{
+			name: "BaseTx",
+			unsignedTx: &txs.BaseTx{BaseTx: avax.BaseTx{
+				Ins: []*avax.TransferableInput{
+					in(avaxAssetID, 100),
+					in(otherAssetID, 1000),
+				},
+				Outs: []*avax.TransferableOutput{
+					out(avaxAssetID, 60),
+					out(otherAssetID, 10),
+				},
+			}},
+			expected: 40,
+		},
+		{
+			name: "ImportTx",
+			unsignedTx: &txs.ImportTx{
+				BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+					Ins:  []*avax.TransferableInput{in(avaxAssetID, 10)},
+					Outs: []*avax.TransferableOutput{out(avaxAssetID, 100)},
+				}},
+				ImportedInputs: []*avax.TransferableInput{in(avaxAssetID, 95)},
+			},
+			expected: 5,
+		},
+		{
+			name: "ExportTx",
+			unsignedTx: &txs.ExportTx{
+				BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+					Ins:  []*avax.TransferableInput{in(avaxAssetID, 100)},
+					Outs: []*avax.TransferableOutput{out(avaxAssetID, 10)},
+				}},
+				ExportedOutputs: []*avax.TransferableOutput{out(avaxAssetID, 80)},
+			},
+			expected: 10,
+		},
+		{
+			name: "AddPermissionlessValidatorTx",
+			unsignedTx: &txs.AddPermissionlessValidatorTx{
+				BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+					Ins:  []*avax.TransferableInput{in(avaxAssetID, 100)},
+					Outs: []*avax.TransferableOutput{out(avaxAssetID, 10)},
+				}},
+				StakeOuts: []*avax.TransferableOutput{out(avaxAssetID, 89)},
+			},
+			expected: 1,
+		},
+		{
+			name: "produces more than consumed",
+			unsignedTx: &txs.BaseTx{BaseTx: avax.BaseTx{
+				Ins:  []*avax.TransferableInput{in(avaxAssetID, 10)},
+				Outs: []*avax.TransferableOutput{out(avaxAssetID, 11)},
+			}},
+			expectedErr: safemath.ErrUnderflow,
+		},
+		{
+			name:        "AdvanceTimeTx",
+			unsignedTx:  &txs.AdvanceTimeTx{},
+			expectedErr: ErrUnsupportedTx,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			burned, err := TxBurned(test.unsignedTx, avaxAssetID)
+			require.ErrorIs(err, test.expectedErr)
+			require.Equal(test.expected, burned)
+		})
+	}
+}
@@ -0,0 +1,301 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package fee
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/gas"
+	"github.com/ava-labs/avalanchego/vms/components/verify"
+	"github.com/ava-labs/avalanchego/vms/platformvm/signer"
+	"github.com/ava-labs/avalanchego/vms/platformvm/stakeable"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+const (
+	// Size of the credentials slice length prefix of a signed tx.
+	credentialsLenSize = wrappers.IntLen
+	// Size of a secp256k1fx.Credential, excluding its signatures: the typeID
+	// and the signatures slice length prefix.
+	credentialOverhead = wrappers.IntLen + wrappers.IntLen
+
+	// Compute units charged to verify a single secp256k1 signature.
+	secp256k1VerifyCompute = 1
+	// Compute units charged to verify a BLS proof of possession.
+	blsPoPVerifyCompute = 20
+)
+
+var (
+	_ txs.Visitor = (*complexityVisitor)(nil)
+
+	ErrUnsupportedTx = errors.New("unsupported transaction type")
+
+	errUnsupportedInput      = errors.New("unsupported input type")
+	errUnsupportedSubnetAuth = errors.New("unsupported subnet authorization type")
+
+	// Complexity, beyond consuming inputs and producing outputs, of the state
+	// modifications performed by each tx type.
+	stakerComplexity = gas.Dimensions{
+		gas.DBRead:  1, // lookup the existing staker
+		gas.DBWrite: 1, // write the new staker
+	}
+	subnetStakerComplexity = gas.Dimensions{
+		gas.DBRead:  2, // lookup the existing staker and the primary network validator
+		gas.DBWrite: 1, // write the new staker
+	}
+	removeSubnetValidatorComplexity = gas.Dimensions{
+		gas.DBRead:  1, // lookup the staker
+		gas.DBWrite: 1, // remove the staker
+	}
+	createSubnetComplexity = gas.Dimensions{
+		gas.DBWrite: 2, // write the subnet and its owner
+	}
+	createChainComplexity = gas.Dimensions{
+		gas.DBWrite: 1, // write the chain
+	}
+	transformSubnetComplexity = gas.Dimensions{
+		gas.DBRead:  1, // lookup the existing transformation
+		gas.DBWrite: 2, // write the transformation and the supply
+	}
+	transferSubnetOwnershipComplexity = gas.Dimensions{
+		gas.DBWrite: 1, // write the new owner
+	}
+	popComplexity = gas.Dimensions{
+		gas.Compute: blsPoPVerifyCompute,
+	}
+)
+
+// TxComplexity returns the amount of each resource that is consumed by
+// processing [tx].
+func TxComplexity(tx txs.UnsignedTx) (gas.Dimensions, error) {
+	c := complexityVisitor{
+		tx: tx,
+	}
+	err := tx.Visit(&c)
+	return c.output, err
+}
+
+// TxGas returns the gas consumed by processing [tx] when each resource is
+// weighted by [weights].
+func TxGas(tx txs.UnsignedTx, weights gas.Dimensions) (gas.Gas, error) {
+	complexity, err := TxComplexity(tx)
+	if err != nil {
+		return 0, err
+	}
+	return complexity.ToGas(weights)
+}
+
+type complexityVisitor struct {
+	tx txs.UnsignedTx
+
+	// outputs of visitor execution
+	output gas.Dimensions
+}
+
+func (*complexityVisitor) AdvanceTimeTx(*txs.AdvanceTimeTx) error {
+	return ErrUnsupportedTx
+}
+
+func (*complexityVisitor) RewardValidatorTx(*txs.RewardValidatorTx) error {
+	return ErrUnsupportedTx
+}
+
+func (c *complexityVisitor) AddValidatorTx(tx *txs.AddValidatorTx) error {
+	return c.calculate(
+		tx.Ins,
+		len(tx.Outs)+len(tx.StakeOuts),
+		nil,
+		stakerComplexity,
+	)
+}
+
+func (c *complexityVisitor) AddSubnetValidatorTx(tx *txs.AddSubnetValidatorTx) error {
+	return c.calculate(
+		tx.Ins,
+		len(tx.Outs),
+		tx.SubnetAuth,
+		subnetStakerComplexity,
+	)
+}
+
+func (c *complexityVisitor) AddDelegatorTx(tx *txs.AddDelegatorTx) error {
+	return c.calculate(
+		tx.Ins,
+		len(tx.Outs)+len(tx.StakeOuts),
+		nil,
+		stakerComplexity,
+	)
+}
+
+func (c *complexityVisitor) CreateChainTx(tx *txs.CreateChainTx) error {
+	return c.calculate(
+		tx.Ins,
+		len(tx.Outs),
+		tx.SubnetAuth,
+		createChainComplexity,
+	)
+}
+
+func (c *complexityVisitor) CreateSubnetTx(tx *txs.CreateSubnetTx) error {
+	return c.calculate(
+		tx.Ins,
+		len(tx.Outs),
+		nil,
+		createSubnetComplexity,
+	)
+}
+
+func (c *complexityVisitor) ImportTx(tx *txs.ImportTx) error {
+	ins := make([]*avax.TransferableInput, 0, len(tx.Ins)+len(tx.ImportedInputs))
+	ins = append(ins, tx.Ins...)
+	ins = append(ins, tx.ImportedInputs...)
+	return c.calculate(
+		ins,
+		len(tx.Outs),
+		nil,
+		gas.Dimensions{},
+	)
+}
+
+func (c *complexityVisitor) ExportTx(tx *txs.ExportTx) error {
+	return c.calculate(
+		tx.Ins,
+		len(tx.Outs)+len(tx.ExportedOutputs),
+		nil,
+		gas.Dimensions{},
+	)
+}
+
+func (c *complexityVisitor) RemoveSubnetValidatorTx(tx *txs.RemoveSubnetValidatorTx) error {
+	return c.calculate(
+		tx.Ins,
+		len(tx.Outs),
+		tx.SubnetAuth,
+		removeSubnetValidatorComplexity,
+	)
+}
+
+func (c *complexityVisitor) TransformSubnetTx(tx *txs.TransformSubnetTx) error {
+	return c.calculate(
+		tx.Ins,
+		len(tx.Outs),
+		tx.SubnetAuth,
+		transformSubnetComplexity,
+	)
+}
+
+func (c *complexityVisitor) TransferSubnetOwnershipTx(tx *txs.TransferSubnetOwnershipTx) error {
+	return c.calculate(
+		tx.Ins,
+		len(tx.Outs),
+		tx.SubnetAuth,
+		transferSubnetOwnershipComplexity,
+	)
+}
+
+func (c *complexityVisitor) AddPermissionlessValidatorTx(tx *txs.AddPermissionlessValidatorTx) error {
+	complexity := subnetStakerComplexity
+	if _, ok := tx.Signer.(*signer.ProofOfPossession); ok {
+		var err error
+		complexity, err = complexity.Add(&popComplexity)
+		if err != nil {
+			return err
+		}
+	}
+	return c.calculate(
+		tx.Ins,
+		len(tx.Outs)+len(tx.StakeOuts),
+		nil,
+		complexity,
+	)
+}
+
+func (c *complexityVisitor) AddPermissionlessDelegatorTx(tx *txs.AddPermissionlessDelegatorTx) error {
+	return c.calculate(
+		tx.Ins,
+		len(tx.Outs)+len(tx.StakeOuts),
+		nil,
+		subnetStakerComplexity,
+	)
+}
+
+func (c *complexityVisitor) BaseTx(tx *txs.BaseTx) error {
+	return c.calculate(
+		tx.Ins,
+		len(tx.Outs),
+		nil,
+		gas.Dimensions{},
+	)
+}
+
+// calculate sets the complexity of a tx that consumes [ins], produces
+// [numOutputs] outputs, is optionally authorized by [subnetAuth], and performs
+// [extra] additional work.
+//
+// Every consumed input requires a read and a delete, every produced output
+// requires a write, and the tx itself is written. Every input, and the subnet
+// authorization, requires a credential whose size is included in the
+// bandwidth.
+func (c *complexityVisitor) calculate(
+	ins []*avax.TransferableInput,
+	numOutputs int,
+	subnetAuth verify.Verifiable,
+	extra gas.Dimensions,
+) error {
+	var (
+		numInputs = len(ins)
+		numCreds  = numInputs
+		numSigs   int
+	)
+	for _, in := range ins {
+		inSigs, err := inputNumSignatures(in.In)
+		if err != nil {
+			return err
+		}
+		numSigs += inSigs
+	}
+	if subnetAuth != nil {
+		auth, ok := subnetAuth.(*secp256k1fx.Input)
+		if !ok {
+			return fmt.Errorf("%w: %T", errUnsupportedSubnetAuth, subnetAuth)
+		}
+		numCreds++
+		numSigs += len(auth.SigIndices)
+	}
+
+	unsignedSize, err := txs.Codec.Size(txs.CodecVersion, &c.tx)
+	if err != nil {
+		return err
+	}
+
+	bandwidth := unsignedSize +
+		credentialsLenSize +
+		numCreds*credentialOverhead +
+		numSigs*secp256k1.SignatureLen
+
+	complexity := gas.Dimensions{
+		gas.Bandwidth: uint64(bandwidth),
+		gas.DBRead:    uint64(numInputs),
+		gas.DBWrite:   uint64(numInputs + numOutputs + 1),
+		gas.Compute:   uint64(numSigs) * secp256k1VerifyCompute,
+	}
+	c.output, err = complexity.Add(&extra)
+	return err
+}
+
+func inputNumSignatures(in avax.TransferableIn) (int, error) {
+	if lockIn, ok := in.(*stakeable.LockIn); ok {
+		in = lockIn.TransferableIn
+	}
+	transferIn, ok := in.(*secp256k1fx.TransferInput)
+	if !ok {
+		return 0, fmt.Errorf("%w: %T", errUnsupportedInput, in)
+	}
+	return len(transferIn.SigIndices), nil
+}
@@ -0,0 +1,209 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package fee
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/gas"
+	"github.com/ava-labs/avalanchego/vms/platformvm/stakeable"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+func TestTxComplexity(t *testing.T) {
+	tests := []struct {
+		name        string
+		unsignedTx  func() txs.UnsignedTx
+		expected    gas.Dimensions
+		expectedErr error
+	}{
+		{
+			name:       "empty BaseTx",
+			unsignedTx: baseTx,
+			expected: gas.Dimensions{
+				gas.Bandwidth: 0,
+				gas.DBRead:    0,
+				gas.DBWrite:   1,
+				gas.Compute:   0,
+			},
+		},
+		{
+			name: "BaseTx",
+			unsignedTx: func() txs.UnsignedTx {
+				return &txs.BaseTx{
+					BaseTx: complexityTestBaseTx(),
+				}
+			},
+			expected: gas.Dimensions{
+				gas.Bandwidth: 0,
+				gas.DBRead:    2,
+				gas.DBWrite:   4,
+				gas.Compute:   3,
+			},
+		},
+		{
+			name: "CreateSubnetTx",
+			unsignedTx: func() txs.UnsignedTx {
+				return &txs.CreateSubnetTx{
+					Owner: &secp256k1fx.OutputOwners{},
+				}
+			},
+			expected: gas.Dimensions{
+				gas.Bandwidth: 0,
+				gas.DBRead:    0,
+				gas.DBWrite:   3,
+				gas.Compute:   0,
+			},
+		},
+		{
+			name: "AddSubnetValidatorTx",
+			unsignedTx: func() txs.UnsignedTx {
+				return &txs.AddSubnetValidatorTx{
+					BaseTx: txs.BaseTx{
+						BaseTx: complexityTestBaseTx(),
+					},
+					SubnetAuth: &secp256k1fx.Input{
+						SigIndices: []uint32{0, 1},
+					},
+				}
+			},
+			expected: gas.Dimensions{
+				gas.Bandwidth: 0,
+				gas.DBRead:    4,
+				gas.DBWrite:   5,
+				gas.Compute:   5,
+			},
+		},
+		{
+			name:        "AdvanceTimeTx",
+			unsignedTx:  func() txs.UnsignedTx { return &txs.AdvanceTimeTx{} },
+			expectedErr: ErrUnsupportedTx,
+		},
+		{
+			name:        "RewardValidatorTx",
+			unsignedTx:  func() txs.UnsignedTx { return &txs.RewardValidatorTx{} },
+			expectedErr: ErrUnsupportedTx,
+		},
+		{
+			name: "unsupported input",
+			unsignedTx: func() txs.UnsignedTx {
+				return &txs.BaseTx{
+					BaseTx: avax.BaseTx{
+						Ins: []*avax.TransferableInput{
+							{
+								In: &avax.TestTransferable{},
+							},
+						},
+					},
+				}
+			},
+			expectedErr: errUnsupportedInput,
+		},
+		{
+			name: "unsupported subnet auth",
+			unsignedTx: func() txs.UnsignedTx {
+				return &txs.CreateChainTx{
+					SubnetAuth: &secp256k1fx.OutputOwners{},
+				}
+			},
+			expectedErr: errUnsupportedSubnetAuth,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+
+			uTx := tt.unsignedTx()
+			complexity, err := TxComplexity(uTx)
+			require.ErrorIs(err, tt.expectedErr)
+			if tt.expectedErr != nil {
+				return
+			}
+
+			// The bandwidth depends on the serialized size of the tx, so it
+			// is verified independently of the other dimensions.
+			size, err := txs.Codec.Size(txs.CodecVersion, &uTx)
+			require.NoError(err)
+			require.Greater(complexity[gas.Bandwidth], uint64(size))
+
+			complexity[gas.Bandwidth] = 0
+			require.Equal(tt.expected, complexity)
+		})
+	}
+}
+
+func TestDynamicCalculator(t *testing.T) {
+	require := require.New(t)
+
+	weights := gas.Dimensions{
+		gas.Bandwidth: 1,
+		gas.DBRead:    10,
+		gas.DBWrite:   100,
+		gas.Compute:   1000,
+	}
+	uTx := &txs.BaseTx{
+		BaseTx: complexityTestBaseTx(),
+	}
+
+	txGas, err := TxGas(uTx, weights)
+	require.NoError(err)
+
+	fc := NewDynamicCalculator(weights, 7)
+	fee, err := fc.CalculateFee(uTx)
+	require.NoError(err)
+	require.Equal(uint64(txGas)*7, fee)
+
+	_, err = fc.CalculateFee(&txs.AdvanceTimeTx{})
+	require.ErrorIs(err, ErrUnsupportedTx)
+}
+
+// complexityTestBaseTx returns a tx consuming a 2-of-n input and a locked
+// 1-of-n input, and producing a single output.
+func complexityTestBaseTx() avax.BaseTx {
+	avaxAssetID := ids.GenerateTestID()
+	return avax.BaseTx{
+		NetworkID:    constants.UnitTestID,
+		BlockchainID: constants.PlatformChainID,
+		Ins: []*avax.TransferableInput{
+			{
+				UTXOID: avax.UTXOID{TxID: ids.GenerateTestID()},
+				Asset:  avax.Asset{ID: avaxAssetID},
+				In: &secp256k1fx.TransferInput{
+					Amt: 1,
+					Input: secp256k1fx.Input{
+						SigIndices: []uint32{0, 1},
+					},
+				},
+			},
+			{
+				UTXOID: avax.UTXOID{TxID: ids.GenerateTestID()},
+				Asset:  avax.Asset{ID: avaxAssetID},
+				In: &stakeable.LockIn{
+					Locktime: 1,
+					TransferableIn: &secp256k1fx.TransferInput{
+						Amt: 1,
+						Input: secp256k1fx.Input{
+							SigIndices: []uint32{0},
+						},
+					},
+				},
+			},
+		},
+		Outs: []*avax.TransferableOutput{
+			{
+				Asset: avax.Asset{ID: avaxAssetID},
+				Out: &secp256k1fx.TransferOutput{
+					Amt: 1,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,36 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package fee
+
+import (
+	"github.com/ava-labs/avalanchego/vms/components/gas"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+)
+
+var _ Calculator = (*dynamicCalculator)(nil)
+
+// NewDynamicCalculator returns a calculator that charges a tx for its
+// complexity, weighted by [weights], at the gas price [price].
+func NewDynamicCalculator(
+	weights gas.Dimensions,
+	price gas.Price,
+) Calculator {
+	return &dynamicCalculator{
+		weights: weights,
+		price:   price,
+	}
+}
+
+type dynamicCalculator struct {
+	weights gas.Dimensions
+	price   gas.Price
+}
+
+func (c *dynamicCalculator) CalculateFee(tx txs.UnsignedTx) (uint64, error) {
+	txGas, err := TxGas(tx, c.weights)
+	if err != nil {
+		return 0, err
+	}
+	return txGas.Cost(c.price)
+}
@@ -16,7 +16,7 @@ import (
 	"github.com/ava-labs/avalanchego/vms/platformvm/upgrade"
 )
 
-func TestTxFees(t *testing.T) {
+func TestStaticCalculator(t *testing.T) {
 	feeTestsDefaultCfg := StaticConfig{
 		TxFee:                         1 * units.Avax,
 		CreateAssetTxFee:              2 * units.Avax,
@@ -188,8 +188,10 @@ func TestTxFees(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			uTx := tt.unsignedTx()
-			fc := NewStaticCalculator(feeTestsDefaultCfg, upgrades)
-			require.Equal(t, tt.expected, fc.CalculateFee(uTx, tt.chainTime))
+			fc := NewStaticCalculator(feeTestsDefaultCfg, upgrades, tt.chainTime)
+			fee, err := fc.CalculateFee(uTx)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, fee)
 		})
 	}
 }
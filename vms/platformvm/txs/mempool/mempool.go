@@ -8,8 +8,10 @@ import (
 
 	"github.com/prometheus/client_golang/prometheus"
 
+	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/snow/engine/common"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs/fee"
 
 	txmempool "github.com/ava-labs/avalanchego/vms/txs/mempool"
 )
@@ -38,8 +40,13 @@ type mempool struct {
 	toEngine chan<- common.Message
 }
 
+// New returns a mempool that orders txs by the amount of AVAX they burn per
+// byte. A tx replacing conflicting txs must burn at least [minFeeRate] more
+// AVAX per byte than they do.
 func New(
 	namespace string,
+	avaxAssetID ids.ID,
+	minFeeRate uint64,
 	registerer prometheus.Registerer,
 	toEngine chan<- common.Message,
 ) (Mempool, error) {
@@ -49,6 +56,10 @@ func New(
 	}
 	pool := txmempool.New[*txs.Tx](
 		metrics,
+		func(tx *txs.Tx) (uint64, error) {
+			return fee.TxBurned(tx.Unsigned, avaxAssetID)
+		},
+		minFeeRate,
 	)
 	return &mempool{
 		Mempool:  pool,
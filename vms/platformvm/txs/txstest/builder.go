@@ -342,7 +342,7 @@ func (b *Builder) builders(keys []*secp256k1.PrivateKey) (builder.Builder, walle
 		kc      = secp256k1fx.NewKeychain(keys...)
 		addrs   = kc.Addresses()
 		backend = newBackend(addrs, b.state, b.ctx.SharedMemory)
-		context = newContext(b.ctx, b.cfg, b.state.GetTimestamp())
+		context = newContext(b.ctx, b.cfg, b.state.GetTimestamp(), b.state.GetFeeState())
 		builder = builder.New(addrs, context, backend)
 		signer  = walletsigner.New(kc, backend)
 	)
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/vms/components/gas"
 	"github.com/ava-labs/avalanchego/vms/platformvm/config"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs/fee"
@@ -17,14 +18,15 @@ func newContext(
 	ctx *snow.Context,
 	cfg *config.Config,
 	timestamp time.Time,
+	feeState gas.State,
 ) *builder.Context {
 	var (
-		feeCalc         = fee.NewStaticCalculator(cfg.StaticFeeConfig, cfg.UpgradeConfig)
-		createSubnetFee = feeCalc.CalculateFee(&txs.CreateSubnetTx{}, timestamp)
-		createChainFee  = feeCalc.CalculateFee(&txs.CreateChainTx{}, timestamp)
+		feeCalc            = fee.NewStaticCalculator(cfg.StaticFeeConfig, cfg.UpgradeConfig, timestamp)
+		createSubnetFee, _ = feeCalc.CalculateFee(&txs.CreateSubnetTx{})
+		createChainFee, _  = feeCalc.CalculateFee(&txs.CreateChainTx{})
 	)
 
-	return &builder.Context{
+	context := &builder.Context{
 		NetworkID:                     ctx.NetworkID,
 		AVAXAssetID:                   ctx.AVAXAssetID,
 		BaseTxFee:                     cfg.StaticFeeConfig.TxFee,
@@ -36,4 +38,9 @@ func newContext(
 		AddSubnetValidatorFee:         cfg.StaticFeeConfig.AddSubnetValidatorFee,
 		AddSubnetDelegatorFee:         cfg.StaticFeeConfig.AddSubnetDelegatorFee,
 	}
+	if cfg.UpgradeConfig.IsEActivated(timestamp) {
+		context.ComplexityWeights = cfg.DynamicFeeConfig.Weights
+		context.GasPrice = feeState.Price(cfg.DynamicFeeConfig)
+	}
+	return context
 }
@@ -27,11 +27,14 @@ import (
 	"github.com/ava-labs/avalanchego/snow/validators"
 	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/hashing"
 	"github.com/ava-labs/avalanchego/utils/json"
 	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/utils/timer/mockable"
 	"github.com/ava-labs/avalanchego/version"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/gas"
 	"github.com/ava-labs/avalanchego/vms/platformvm/block"
 	"github.com/ava-labs/avalanchego/vms/platformvm/config"
 	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
@@ -52,11 +55,16 @@ import (
 	pvalidators "github.com/ava-labs/avalanchego/vms/platformvm/validators"
 )
 
+// preparedBlocksCacheSize is the maximum number of blocks that have been
+// prepared during bootstrapping, but not yet parsed.
+const preparedBlocksCacheSize = 256
+
 var (
-	_ snowmanblock.ChainVM       = (*VM)(nil)
-	_ secp256k1fx.VM             = (*VM)(nil)
-	_ validators.State           = (*VM)(nil)
-	_ validators.SubnetConnector = (*VM)(nil)
+	_ snowmanblock.ChainVM          = (*VM)(nil)
+	_ snowmanblock.PipelinedChainVM = (*VM)(nil)
+	_ secp256k1fx.VM                = (*VM)(nil)
+	_ validators.State              = (*VM)(nil)
+	_ validators.SubnetConnector    = (*VM)(nil)
 )
 
 type VM struct {
@@ -72,6 +80,8 @@ type VM struct {
 
 	uptimeManager uptime.Manager
 
+	txExecutorBackend *txexecutor.Backend
+
 	// The context of this vm
 	ctx *snow.Context
 	db  database.Database
@@ -86,10 +96,18 @@ type VM struct {
 
 	manager blockexecutor.Manager
 
+	// Block ID --> Block
+	// Blocks that were prepared by PrepareBlock, but haven't been parsed yet.
+	preparedBlocks cache.Cacher[ids.ID, block.Block]
+
 	// Cancelled on shutdown
 	onShutdownCtx context.Context
 	// Call [onShutdownCtxCancel] to cancel [onShutdownCtx] during Shutdown()
 	onShutdownCtxCancel context.CancelFunc
+
+	// Subnet ID --> Validators whose uptimes are reported in the metrics. Only
+	// accessed by the uptime sampling goroutine.
+	sampledValidators map[ids.ID]set.Set[ids.NodeID]
 }
 
 // Initialize this blockchain.
@@ -166,8 +184,15 @@ func (vm *VM) Initialize(
 		Rewards:      rewards,
 		Bootstrapped: &vm.bootstrapped,
 	}
+	vm.txExecutorBackend = txExecutorBackend
 
-	mempool, err := pmempool.New("mempool", registerer, toEngine)
+	// The minimum relay fee of a byte is the cost of its bandwidth at the
+	// minimum gas price.
+	minFeeRate, err := gas.Gas(vm.DynamicFeeConfig.Weights[gas.Bandwidth]).Cost(vm.DynamicFeeConfig.MinPrice)
+	if err != nil {
+		return err
+	}
+	mempool, err := pmempool.New("mempool", vm.ctx.AVAXAssetID, minFeeRate, registerer, toEngine)
 	if err != nil {
 		return fmt.Errorf("failed to create mempool: %w", err)
 	}
@@ -179,6 +204,9 @@ func (vm *VM) Initialize(
 		txExecutorBackend,
 		validatorManager,
 	)
+	vm.preparedBlocks = &cache.LRU[ids.ID, block.Block]{
+		Size: preparedBlocksCacheSize,
+	}
 
 	txVerifier := network.NewLockedTxVerifier(&txExecutorBackend.Ctx.Lock, vm.manager)
 	vm.Network, err = network.New(
@@ -190,6 +218,8 @@ func (vm *VM) Initialize(
 			validatorManager,
 		),
 		txVerifier,
+		network.NewLockedWarpVerifier(&chainCtx.Lock, vm.state),
+		chainCtx.WarpSigner,
 		mempool,
 		txExecutorBackend.Config.PartialSyncPrimaryNetwork,
 		appSender,
@@ -232,6 +262,10 @@ func (vm *VM) Initialize(
 	// [periodicallyPruneMempool] grabs the context lock.
 	go vm.periodicallyPruneMempool(execConfig.MempoolPruneFrequency)
 
+	if execConfig.UptimeHistoryEnabled {
+		go vm.periodicallySampleUptimes(execConfig.UptimeHistorySampleFrequency)
+	}
+
 	go func() {
 		err := vm.state.ReindexBlocks(&vm.ctx.Lock, vm.ctx.Log)
 		if err != nil {
@@ -287,6 +321,99 @@ func (vm *VM) pruneMempool() error {
 	return nil
 }
 
+func (vm *VM) periodicallySampleUptimes(frequency time.Duration) {
+	ticker := time.NewTicker(frequency)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-vm.onShutdownCtx.Done():
+			return
+		case <-ticker.C:
+			if err := vm.sampleUptimes(); err != nil {
+				vm.ctx.Log.Warn("sampling uptimes failed",
+					zap.Error(err),
+				)
+			}
+		}
+	}
+}
+
+// sampleUptimes records the current uptime of every validator of the Primary
+// Network and of the tracked subnets.
+func (vm *VM) sampleUptimes() error {
+	samples, err := vm.getUptimeSamples()
+	if err != nil || samples == nil {
+		return err
+	}
+
+	sampledValidators := make(map[ids.ID]set.Set[ids.NodeID])
+	for _, sample := range samples {
+		nodeIDs := sampledValidators[sample.SubnetID]
+		nodeIDs.Add(sample.NodeID)
+		sampledValidators[sample.SubnetID] = nodeIDs
+
+		vm.metrics.SetValidatorUptime(sample.SubnetID, sample.NodeID, sample.Uptime(), sample.Connected)
+	}
+	for subnetID, nodeIDs := range vm.sampledValidators {
+		stillSampled := sampledValidators[subnetID]
+		for nodeID := range nodeIDs {
+			if !stillSampled.Contains(nodeID) {
+				vm.metrics.DeleteValidatorUptime(subnetID, nodeID)
+			}
+		}
+	}
+	vm.sampledValidators = sampledValidators
+
+	// The uptime history isn't part of the chain state, so it is written and
+	// pruned without holding the context lock.
+	return vm.state.PutUptimeSamples(samples)
+}
+
+// getUptimeSamples returns the current uptime of every validator of the
+// Primary Network and of the tracked subnets. Returns nil if the chain isn't
+// bootstrapped.
+func (vm *VM) getUptimeSamples() ([]*state.UptimeSample, error) {
+	vm.ctx.Lock.Lock()
+	defer vm.ctx.Lock.Unlock()
+
+	// Uptimes are only tracked once the chain is bootstrapped.
+	if !vm.bootstrapped.Get() {
+		return nil, nil
+	}
+
+	stakerIterator, err := vm.state.GetCurrentStakerIterator()
+	if err != nil {
+		return nil, err
+	}
+	defer stakerIterator.Release()
+
+	samples := []*state.UptimeSample{}
+	for stakerIterator.Next() {
+		staker := stakerIterator.Value()
+		if !staker.Priority.IsCurrentValidator() {
+			continue
+		}
+		if staker.SubnetID != constants.PrimaryNetworkID && !vm.TrackedSubnets.Contains(staker.SubnetID) {
+			continue
+		}
+
+		upDuration, now, err := vm.uptimeManager.CalculateUptime(staker.NodeID, staker.SubnetID)
+		if err != nil {
+			return nil, err
+		}
+		samples = append(samples, &state.UptimeSample{
+			SubnetID:   staker.SubnetID,
+			NodeID:     staker.NodeID,
+			Timestamp:  now,
+			StartTime:  staker.StartTime,
+			UpDuration: upDuration,
+			Connected:  vm.uptimeManager.IsConnected(staker.NodeID, staker.SubnetID),
+		})
+	}
+	return samples, nil
+}
+
 // Create all chains that exist that this node validates.
 func (vm *VM) initBlockchains() error {
 	if vm.Config.PartialSyncPrimaryNetwork {
@@ -344,6 +471,9 @@ func (vm *VM) onNormalOperationsStarted() error {
 	}
 	vm.bootstrapped.Set(true)
 
+	// Blocks are only prepared during bootstrapping.
+	vm.preparedBlocks.Flush()
+
 	if err := vm.fx.Bootstrapped(); err != nil {
 		return err
 	}
@@ -420,6 +550,14 @@ func (vm *VM) Shutdown(context.Context) error {
 }
 
 func (vm *VM) ParseBlock(_ context.Context, b []byte) (snowman.Block, error) {
+	if vm.preparedBlocks.Len() > 0 {
+		blkID := hashing.ComputeHash256Array(b)
+		if statelessBlk, ok := vm.preparedBlocks.Get(blkID); ok {
+			vm.preparedBlocks.Evict(blkID)
+			return vm.manager.NewBlock(statelessBlk), nil
+		}
+	}
+
 	// Note: blocks to be parsed are not verified, so we must used blocks.Codec
 	// rather than blocks.GenesisCodec
 	statelessBlk, err := block.Parse(block.Codec, b)
@@ -429,6 +567,23 @@ func (vm *VM) ParseBlock(_ context.Context, b []byte) (snowman.Block, error) {
 	return vm.manager.NewBlock(statelessBlk), nil
 }
 
+// PrepareBlock parses [blkBytes] and syntactically verifies its transactions,
+// which includes verifying any BLS proofs of possession. The prepared block is
+// returned the next time [blkBytes] is parsed.
+func (vm *VM) PrepareBlock(_ context.Context, blkBytes []byte) error {
+	statelessBlk, err := block.Parse(block.Codec, blkBytes)
+	if err != nil {
+		return err
+	}
+	for _, tx := range statelessBlk.Txs() {
+		if err := tx.SyntacticVerify(vm.ctx); err != nil {
+			return err
+		}
+	}
+	vm.preparedBlocks.Put(statelessBlk.ID(), statelessBlk)
+	return nil
+}
+
 func (vm *VM) GetBlock(_ context.Context, blkID ids.ID) (snowman.Block, error) {
 	return vm.manager.GetBlock(blkID)
 }
@@ -14,12 +14,14 @@ import (
 
 	"github.com/ava-labs/avalanchego/chains"
 	"github.com/ava-labs/avalanchego/chains/atomic"
+	"github.com/ava-labs/avalanchego/codec"
 	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/database/memdb"
 	"github.com/ava-labs/avalanchego/database/prefixdb"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/message"
 	"github.com/ava-labs/avalanchego/network/p2p"
+	"github.com/ava-labs/avalanchego/network/reputation"
 	"github.com/ava-labs/avalanchego/snow"
 	"github.com/ava-labs/avalanchego/snow/choices"
 	"github.com/ava-labs/avalanchego/snow/consensus/snowball"
@@ -50,11 +52,13 @@ import (
 	"github.com/ava-labs/avalanchego/utils/units"
 	"github.com/ava-labs/avalanchego/version"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/gas"
 	"github.com/ava-labs/avalanchego/vms/platformvm/api"
 	"github.com/ava-labs/avalanchego/vms/platformvm/block"
 	"github.com/ava-labs/avalanchego/vms/platformvm/config"
 	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
 	"github.com/ava-labs/avalanchego/vms/platformvm/signer"
+	"github.com/ava-labs/avalanchego/vms/platformvm/state"
 	"github.com/ava-labs/avalanchego/vms/platformvm/status"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs/fee"
@@ -252,6 +256,18 @@ func defaultVM(t *testing.T, f fork) (*VM, *txstest.Builder, database.Database,
 			TransformSubnetTxFee:  100 * defaultTxFee,
 			CreateBlockchainTxFee: 100 * defaultTxFee,
 		},
+		DynamicFeeConfig: gas.Config{
+			Weights: gas.Dimensions{
+				gas.Bandwidth: 1,
+				gas.DBRead:    1,
+				gas.DBWrite:   1,
+				gas.Compute:   1,
+			},
+			MaxGasPerBlock:           1_000_000,
+			TargetGasPerBlock:        250_000,
+			MinPrice:                 1,
+			ExcessConversionConstant: 1_000_000,
+		},
 		MinValidatorStake: defaultMinValidatorStake,
 		MaxValidatorStake: defaultMaxValidatorStake,
 		MinDelegatorStake: defaultMinDelegatorStake,
@@ -1491,6 +1507,7 @@ func TestBootstrapPartiallyAccepted(t *testing.T) {
 		consensusCtx.Registerer,
 		set.Of(ctx.NodeID),
 		nil,
+		reputation.NewNoTracker(),
 	)
 	require.NoError(err)
 
@@ -2489,3 +2506,101 @@ func TestPruneMempool(t *testing.T) {
 	_, ok = vm.Builder.Get(baseTxID)
 	require.True(ok)
 }
+
+func TestPrepareBlock(t *testing.T) {
+	require := require.New(t)
+	vm, txBuilder, _, _ := defaultVM(t, latestFork)
+	vm.ctx.Lock.Lock()
+	defer vm.ctx.Lock.Unlock()
+
+	var (
+		startTime = vm.clock.Time().Add(txexecutor.SyncBound).Add(1 * time.Second)
+		endTime   = startTime.Add(defaultMinStakingDuration)
+	)
+	tx, err := txBuilder.NewAddValidatorTx(
+		&txs.Validator{
+			NodeID: ids.GenerateTestNodeID(),
+			Start:  uint64(startTime.Unix()),
+			End:    uint64(endTime.Unix()),
+			Wght:   vm.MinValidatorStake,
+		},
+		&secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{ids.GenerateTestShortID()},
+		},
+		reward.PercentDenominator,
+		[]*secp256k1.PrivateKey{keys[0]},
+	)
+	require.NoError(err)
+
+	preferredID := vm.manager.Preferred()
+	preferred, err := vm.manager.GetBlock(preferredID)
+	require.NoError(err)
+
+	statelessBlk, err := block.NewBanffStandardBlock(
+		preferred.Timestamp(),
+		preferredID,
+		preferred.Height()+1,
+		[]*txs.Tx{tx},
+	)
+	require.NoError(err)
+	blkBytes := statelessBlk.Bytes()
+
+	require.NoError(vm.PrepareBlock(context.Background(), blkBytes))
+
+	// The prepared block is returned when the block is parsed, so its
+	// transactions don't need to be syntactically verified again.
+	parsedBlk, err := vm.ParseBlock(context.Background(), blkBytes)
+	require.NoError(err)
+	require.Equal(statelessBlk.ID(), parsedBlk.ID())
+	parsedTxs := parsedBlk.(*blockexecutor.Block).Txs()
+	require.Len(parsedTxs, 1)
+	require.True(parsedTxs[0].Unsigned.(*txs.AddValidatorTx).SyntacticallyVerified)
+
+	// The prepared block is only returned once.
+	parsedBlk, err = vm.ParseBlock(context.Background(), blkBytes)
+	require.NoError(err)
+	parsedTxs = parsedBlk.(*blockexecutor.Block).Txs()
+	require.False(parsedTxs[0].Unsigned.(*txs.AddValidatorTx).SyntacticallyVerified)
+
+	err = vm.PrepareBlock(context.Background(), []byte{1, 2, 3})
+	require.ErrorIs(err, codec.ErrUnknownVersion)
+}
+
+// uptimeSamplesState records the uptime samples put into the state.
+type uptimeSamplesState struct {
+	state.State
+	samples []*state.UptimeSample
+}
+
+func (s *uptimeSamplesState) PutUptimeSamples(samples []*state.UptimeSample) error {
+	s.samples = append(s.samples, samples...)
+	return nil
+}
+
+func TestSampleUptimes(t *testing.T) {
+	require := require.New(t)
+	vm, _, _, _ := defaultVM(t, latestFork)
+
+	vm.ctx.Lock.Lock()
+	recorder := &uptimeSamplesState{State: vm.state}
+	vm.state = recorder
+	vm.ctx.Lock.Unlock()
+
+	require.NoError(vm.sampleUptimes())
+
+	now := vm.clock.UnixTime()
+	require.Len(recorder.samples, len(genesisNodeIDs))
+	for _, sample := range recorder.samples {
+		require.Contains(genesisNodeIDs, sample.NodeID)
+		require.Equal(constants.PrimaryNetworkID, sample.SubnetID)
+		require.Equal(now, sample.Timestamp)
+		require.False(sample.Connected)
+	}
+
+	// Uptimes aren't sampled until the chain is bootstrapped.
+	vm.bootstrapped.Set(false)
+	recorder.samples = nil
+	require.NoError(vm.sampleUptimes())
+	require.Empty(recorder.samples)
+}
@@ -34,7 +34,7 @@ func setupSigner(t testing.TB) *testSigner {
 	chainID := ids.GenerateTestID()
 
 	s := &testSigner{
-		server:    warp.NewSigner(sk, constants.UnitTestID, chainID),
+		server:    warp.NewSigner(bls.NewLocalSigner(sk), constants.UnitTestID, chainID),
 		sk:        sk,
 		networkID: constants.UnitTestID,
 		chainID:   chainID,
@@ -0,0 +1,29 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package proposervm
+
+import (
+	"context"
+
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/block"
+
+	statelessblock "github.com/ava-labs/avalanchego/vms/proposervm/block"
+)
+
+var _ block.PipelinedChainVM = (*VM)(nil)
+
+// PrepareBlock forwards the inner block of [blkBytes] to the inner VM to be
+// prepared. If [blkBytes] isn't a post fork block, it is assumed to be a pre
+// fork block.
+func (vm *VM) PrepareBlock(ctx context.Context, blkBytes []byte) error {
+	if vm.pipelinedVM == nil {
+		return block.ErrPipelinedVMNotImplemented
+	}
+
+	innerBlkBytes := blkBytes
+	if statelessBlock, err := statelessblock.ParseWithoutVerification(blkBytes); err == nil {
+		innerBlkBytes = statelessBlock.Block()
+	}
+	return vm.pipelinedVM.PrepareBlock(ctx, innerBlkBytes)
+}
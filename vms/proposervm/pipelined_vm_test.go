@@ -0,0 +1,73 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package proposervm
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/block"
+
+	statelessblock "github.com/ava-labs/avalanchego/vms/proposervm/block"
+)
+
+var _ block.PipelinedChainVM = (*pipelinedVM)(nil)
+
+type pipelinedVM struct {
+	*block.TestVM
+
+	prepared [][]byte
+}
+
+func (vm *pipelinedVM) PrepareBlock(_ context.Context, blkBytes []byte) error {
+	vm.prepared = append(vm.prepared, blkBytes)
+	return nil
+}
+
+func TestPrepareBlockNotImplemented(t *testing.T) {
+	vm := New(&block.TestVM{}, Config{})
+	err := vm.PrepareBlock(context.Background(), []byte{1})
+	require.ErrorIs(t, err, block.ErrPipelinedVMNotImplemented)
+}
+
+func TestPrepareBlock(t *testing.T) {
+	require := require.New(t)
+
+	innerVM := &pipelinedVM{
+		TestVM: &block.TestVM{},
+	}
+	vm := New(innerVM, Config{})
+
+	preForkBlkBytes := []byte{1, 2, 3}
+	postForkInnerBlkBytes := []byte{4, 5, 6}
+	postForkBlk, err := statelessblock.BuildUnsigned(
+		ids.GenerateTestID(),
+		time.Unix(0, 0),
+		0,
+		postForkInnerBlkBytes,
+	)
+	require.NoError(err)
+	optionInnerBlkBytes := []byte{7, 8, 9}
+	option, err := statelessblock.BuildOption(
+		postForkBlk.ID(),
+		optionInnerBlkBytes,
+	)
+	require.NoError(err)
+
+	require.NoError(vm.PrepareBlock(context.Background(), preForkBlkBytes))
+	require.NoError(vm.PrepareBlock(context.Background(), postForkBlk.Bytes()))
+	require.NoError(vm.PrepareBlock(context.Background(), option.Bytes()))
+	require.Equal(
+		[][]byte{
+			preForkBlkBytes,
+			postForkInnerBlkBytes,
+			optionInnerBlkBytes,
+		},
+		innerVM.prepared,
+	)
+}
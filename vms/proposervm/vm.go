@@ -66,6 +66,7 @@ type VM struct {
 	blockBuilderVM block.BuildBlockWithContextChainVM
 	batchedVM      block.BatchedChainVM
 	ssVM           block.StateSyncableVM
+	pipelinedVM    block.PipelinedChainVM
 
 	state.State
 
@@ -110,12 +111,14 @@ func New(
 	blockBuilderVM, _ := vm.(block.BuildBlockWithContextChainVM)
 	batchedVM, _ := vm.(block.BatchedChainVM)
 	ssVM, _ := vm.(block.StateSyncableVM)
+	pipelinedVM, _ := vm.(block.PipelinedChainVM)
 	return &VM{
 		ChainVM:        vm,
 		Config:         config,
 		blockBuilderVM: blockBuilderVM,
 		batchedVM:      batchedVM,
 		ssVM:           ssVM,
+		pipelinedVM:    pipelinedVM,
 	}
 }
 
@@ -4,10 +4,13 @@
 package grpcutils
 
 import (
+	"context"
 	"math"
+	"net"
 	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/keepalive"
 )
@@ -102,3 +105,19 @@ func WithChainStreamInterceptor(interceptors ...grpc.StreamClientInterceptor) Di
 		d.opts = append(d.opts, grpc.WithChainStreamInterceptor(interceptors...))
 	}
 }
+
+// WithTransportCredentials replaces the insecure transport credentials set by
+// DefaultDialOptions.
+func WithTransportCredentials(creds credentials.TransportCredentials) DialOption {
+	return func(d *DialOptions) {
+		d.opts = append(d.opts, grpc.WithTransportCredentials(creds))
+	}
+}
+
+// WithContextDialer replaces the dialer used to establish the underlying
+// connection.
+func WithContextDialer(dialer func(context.Context, string) (net.Conn, error)) DialOption {
+	return func(d *DialOptions) {
+		d.opts = append(d.opts, grpc.WithContextDialer(dialer))
+	}
+}
@@ -24,6 +24,7 @@ var (
 	_ block.BuildBlockWithContextChainVM = (*blockVM)(nil)
 	_ block.BatchedChainVM               = (*blockVM)(nil)
 	_ block.StateSyncableVM              = (*blockVM)(nil)
+	_ block.PipelinedChainVM             = (*blockVM)(nil)
 )
 
 type blockVM struct {
@@ -31,6 +32,7 @@ type blockVM struct {
 	buildBlockVM block.BuildBlockWithContextChainVM
 	batchedVM    block.BatchedChainVM
 	ssVM         block.StateSyncableVM
+	pipelinedVM  block.PipelinedChainVM
 	// ChainVM tags
 	initializeTag              string
 	buildBlockTag              string
@@ -57,18 +59,22 @@ type blockVM struct {
 	getLastStateSummaryTag        string
 	parseStateSummaryTag          string
 	getStateSummaryTag            string
-	tracer                        trace.Tracer
+	// PipelinedChainVM tags
+	prepareBlockTag string
+	tracer          trace.Tracer
 }
 
 func NewBlockVM(vm block.ChainVM, name string, tracer trace.Tracer) block.ChainVM {
 	buildBlockVM, _ := vm.(block.BuildBlockWithContextChainVM)
 	batchedVM, _ := vm.(block.BatchedChainVM)
 	ssVM, _ := vm.(block.StateSyncableVM)
+	pipelinedVM, _ := vm.(block.PipelinedChainVM)
 	return &blockVM{
 		ChainVM:                       vm,
 		buildBlockVM:                  buildBlockVM,
 		batchedVM:                     batchedVM,
 		ssVM:                          ssVM,
+		pipelinedVM:                   pipelinedVM,
 		initializeTag:                 name + ".initialize",
 		buildBlockTag:                 name + ".buildBlock",
 		parseBlockTag:                 name + ".parseBlock",
@@ -90,6 +96,7 @@ func NewBlockVM(vm block.ChainVM, name string, tracer trace.Tracer) block.ChainV
 		getLastStateSummaryTag:        name + ".getLastStateSummary",
 		parseStateSummaryTag:          name + ".parseStateSummary",
 		getStateSummaryTag:            name + ".getStateSummary",
+		prepareBlockTag:               name + ".prepareBlock",
 		tracer:                        tracer,
 	}
 }
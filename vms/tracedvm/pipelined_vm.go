@@ -0,0 +1,27 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package tracedvm
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/block"
+
+	oteltrace "go.opentelemetry.io/otel/trace"
+)
+
+func (vm *blockVM) PrepareBlock(ctx context.Context, blkBytes []byte) error {
+	if vm.pipelinedVM == nil {
+		return block.ErrPipelinedVMNotImplemented
+	}
+
+	ctx, span := vm.tracer.Start(ctx, vm.prepareBlockTag, oteltrace.WithAttributes(
+		attribute.Int("blockLen", len(blkBytes)),
+	))
+	defer span.End()
+
+	return vm.pipelinedVM.PrepareBlock(ctx, blkBytes)
+}
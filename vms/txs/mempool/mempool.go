@@ -6,14 +6,19 @@ package mempool
 import (
 	"errors"
 	"fmt"
+	"math/bits"
 	"sync"
 
+	"github.com/google/btree"
+
 	"github.com/ava-labs/avalanchego/cache"
 	"github.com/ava-labs/avalanchego/ids"
-	"github.com/ava-labs/avalanchego/utils/linked"
+	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/utils/setmap"
 	"github.com/ava-labs/avalanchego/utils/units"
+
+	safemath "github.com/ava-labs/avalanchego/utils/math"
 )
 
 const (
@@ -26,6 +31,14 @@ const (
 
 	// maxMempoolSize is the maximum number of bytes allowed in the mempool
 	maxMempoolSize = 64 * units.MiB
+
+	// txsTreeDegree is the degree of the btree ordering the txs by fee rate
+	txsTreeDegree = 32
+
+	// replacementFeeBumpDivisor sets the minimum increase, relative to the
+	// combined fee of the replaced txs, that a replacement tx must pay. A
+	// divisor of 10 requires the fee to be raised by at least 10%.
+	replacementFeeBumpDivisor = 10
 )
 
 var (
@@ -33,6 +46,7 @@ var (
 	ErrTxTooLarge           = errors.New("tx too large")
 	ErrMempoolFull          = errors.New("mempool is full")
 	ErrConflictsWithOtherTx = errors.New("tx conflicts with other tx")
+	ErrReplaced             = errors.New("tx replaced by a conflicting tx paying a higher fee")
 )
 
 type Tx interface {
@@ -45,16 +59,21 @@ type Metrics interface {
 	Update(numTxs, bytesAvailable int)
 }
 
+// FeeFunc returns the fee paid by a tx.
+type FeeFunc[T Tx] func(tx T) (uint64, error)
+
 type Mempool[T Tx] interface {
 	Add(tx T) error
 	Get(txID ids.ID) (T, bool)
 	// Remove [txs] and any conflicts of [txs] from the mempool.
 	Remove(txs ...T)
 
-	// Peek returns the oldest tx in the mempool.
+	// Peek returns the tx paying the highest fee rate in the mempool. If
+	// multiple txs pay the same fee rate, the oldest of them is returned.
 	Peek() (tx T, exists bool)
 
-	// Iterate iterates over the txs until f returns false
+	// Iterate iterates over the txs, in the order they would be returned by
+	// Peek, until f returns false
 	Iterate(f func(tx T) bool)
 
 	// Note: dropped txs are added to droppedTxIDs but are not evicted from
@@ -67,24 +86,76 @@ type Mempool[T Tx] interface {
 	Len() int
 }
 
+// meteredTx is a tx along with the fee it pays.
+type meteredTx[T Tx] struct {
+	tx   T
+	fee  uint64
+	size uint64
+	// index is the number of txs added to the mempool before this tx. It is
+	// used to order txs paying the same fee rate.
+	index uint64
+}
+
+// Less returns true if [t] should be issued before [other]. Txs paying a
+// higher fee per byte are issued first. Txs paying the same fee per byte are
+// issued in the order they were added.
+func (t *meteredTx[T]) Less(other *meteredTx[T]) bool {
+	switch {
+	case t.feeRateGreater(other):
+		return true
+	case other.feeRateGreater(t):
+		return false
+	default:
+		return t.index < other.index
+	}
+}
+
+// feeRateGreater returns true if [t] pays a strictly higher fee per byte than
+// [other].
+func (t *meteredTx[T]) feeRateGreater(other *meteredTx[T]) bool {
+	// Compare t.fee/t.size with other.fee/other.size without losing precision.
+	tHi, tLo := bits.Mul64(t.fee, other.size)
+	otherHi, otherLo := bits.Mul64(other.fee, t.size)
+	return tHi > otherHi || (tHi == otherHi && tLo > otherLo)
+}
+
 type mempool[T Tx] struct {
-	lock           sync.RWMutex
-	unissuedTxs    *linked.Hashmap[ids.ID, T]
+	lock        sync.RWMutex
+	unissuedTxs map[ids.ID]*meteredTx[T]
+	// txsByPriority orders the unissued txs by the order they should be
+	// issued in.
+	txsByPriority  *btree.BTreeG[*meteredTx[T]]
+	numAdded       uint64
 	consumedUTXOs  *setmap.SetMap[ids.ID, ids.ID] // TxID -> Consumed UTXOs
 	bytesAvailable int
 	droppedTxIDs   *cache.LRU[ids.ID, error] // TxID -> Verification error
 
-	metrics Metrics
+	fee        FeeFunc[T]
+	minFeeRate uint64
+	metrics    Metrics
 }
 
+// New returns a mempool that orders txs by the fee per byte reported by
+// [fee]. When the mempool is full, txs paying a lower fee rate are evicted in
+// favor of txs paying a higher fee rate. A tx that conflicts with txs in the
+// mempool replaces them if its fee exceeds the combined fee of the conflicts
+// by at least 10%, and by at least [minFeeRate] per byte of the tx.
+//
+// If [fee] is nil, every tx is considered to pay no fee. Txs are then ordered
+// by insertion and are never evicted or replaced.
 func New[T Tx](
 	metrics Metrics,
+	fee FeeFunc[T],
+	minFeeRate uint64,
 ) *mempool[T] {
 	m := &mempool[T]{
-		unissuedTxs:    linked.NewHashmap[ids.ID, T](),
+		unissuedTxs:    make(map[ids.ID]*meteredTx[T]),
+		txsByPriority:  btree.NewG(txsTreeDegree, (*meteredTx[T]).Less),
 		consumedUTXOs:  setmap.New[ids.ID, ids.ID](),
 		bytesAvailable: maxMempoolSize,
 		droppedTxIDs:   &cache.LRU[ids.ID, error]{Size: droppedTxIDsCacheSize},
+		fee:            fee,
+		minFeeRate:     minFeeRate,
 		metrics:        metrics,
 	}
 	m.updateMetrics()
@@ -93,7 +164,7 @@ func New[T Tx](
 }
 
 func (m *mempool[T]) updateMetrics() {
-	m.metrics.Update(m.unissuedTxs.Len(), m.bytesAvailable)
+	m.metrics.Update(len(m.unissuedTxs), m.bytesAvailable)
 }
 
 func (m *mempool[T]) Add(tx T) error {
@@ -102,7 +173,7 @@ func (m *mempool[T]) Add(tx T) error {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 
-	if _, ok := m.unissuedTxs.Get(txID); ok {
+	if _, ok := m.unissuedTxs[txID]; ok {
 		return fmt.Errorf("%w: %s", ErrDuplicateTx, txID)
 	}
 
@@ -115,7 +186,49 @@ func (m *mempool[T]) Add(tx T) error {
 			MaxTxSize,
 		)
 	}
-	if txSize > m.bytesAvailable {
+
+	var fee uint64
+	if m.fee != nil {
+		var err error
+		fee, err = m.fee(tx)
+		if err != nil {
+			return fmt.Errorf("couldn't calculate fee of %s: %w", txID, err)
+		}
+	}
+	newTx := &meteredTx[T]{
+		tx:    tx,
+		fee:   fee,
+		size:  uint64(txSize),
+		index: m.numAdded,
+	}
+
+	inputs := tx.InputIDs()
+	conflicts, err := m.replaceableConflicts(newTx, inputs)
+	if err != nil {
+		return err
+	}
+
+	// Space freed by the replaced txs is available to the new tx.
+	bytesAvailable := m.bytesAvailable
+	for _, conflict := range conflicts {
+		bytesAvailable += int(conflict.size)
+	}
+
+	// Evict the txs paying the lowest fee rates until there is enough space
+	// for the new tx. Only txs paying a strictly lower fee rate than the new
+	// tx can be evicted.
+	var evicted []*meteredTx[T]
+	m.txsByPriority.Descend(func(lowest *meteredTx[T]) bool {
+		if txSize <= bytesAvailable || !newTx.feeRateGreater(lowest) {
+			return false
+		}
+		if _, isConflict := conflicts[lowest.tx.ID()]; !isConflict {
+			evicted = append(evicted, lowest)
+			bytesAvailable += int(lowest.size)
+		}
+		return true
+	})
+	if txSize > bytesAvailable {
 		return fmt.Errorf("%w: %s size (%d) > available space (%d)",
 			ErrMempoolFull,
 			txID,
@@ -124,13 +237,20 @@ func (m *mempool[T]) Add(tx T) error {
 		)
 	}
 
-	inputs := tx.InputIDs()
-	if m.consumedUTXOs.HasOverlap(inputs) {
-		return fmt.Errorf("%w: %s", ErrConflictsWithOtherTx, txID)
+	for conflictID, conflict := range conflicts {
+		m.remove(conflict)
+		m.droppedTxIDs.Put(conflictID, ErrReplaced)
+	}
+	for _, tx := range evicted {
+		// Evicted txs are not marked as dropped, as they may be re-added
+		// once the mempool has space for them.
+		m.remove(tx)
 	}
 
+	m.numAdded++
 	m.bytesAvailable -= txSize
-	m.unissuedTxs.Put(txID, tx)
+	m.unissuedTxs[txID] = newTx
+	m.txsByPriority.ReplaceOrInsert(newTx)
 	m.updateMetrics()
 
 	// Mark these UTXOs as consumed in the mempool
@@ -141,11 +261,76 @@ func (m *mempool[T]) Add(tx T) error {
 	return nil
 }
 
+// replaceableConflicts returns the txs in the mempool that consume any of
+// [inputs]. An error is returned if [tx] doesn't pay enough to replace the
+// conflicting txs.
+//
+// The fee of [tx] must exceed the combined fee of the conflicts by at least
+// max(10% of their fee, [minFeeRate] * size of [tx]), so that the replacement
+// pays for its own relay and txs can't be repeatedly replaced for a negligible
+// fee increase.
+func (m *mempool[T]) replaceableConflicts(tx *meteredTx[T], inputs set.Set[ids.ID]) (map[ids.ID]*meteredTx[T], error) {
+	if !m.consumedUTXOs.HasOverlap(inputs) {
+		return nil, nil
+	}
+
+	var (
+		conflicts   = make(map[ids.ID]*meteredTx[T])
+		conflictFee uint64
+		err         error
+	)
+	for input := range inputs {
+		conflictID, ok := m.consumedUTXOs.GetKey(input)
+		if !ok {
+			continue
+		}
+		if _, ok := conflicts[conflictID]; ok {
+			continue
+		}
+
+		conflict := m.unissuedTxs[conflictID]
+		conflicts[conflictID] = conflict
+		conflictFee, err = safemath.Add64(conflictFee, conflict.fee)
+		if err != nil {
+			return nil, fmt.Errorf("failed to calculate the fee of the txs conflicting with %s: %w", tx.tx.ID(), err)
+		}
+	}
+
+	minBump := conflictFee / replacementFeeBumpDivisor
+	if conflictFee%replacementFeeBumpDivisor != 0 {
+		minBump++
+	}
+	relayFee, err := safemath.Mul64(m.minFeeRate, tx.size)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate the relay fee of %s: %w", tx.tx.ID(), err)
+	}
+	// The fee must always be strictly higher, even if no bump is required.
+	minBump = max(minBump, relayFee, 1)
+
+	requiredFee, err := safemath.Add64(conflictFee, minBump)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate the replacement fee of %s: %w", tx.tx.ID(), err)
+	}
+	if tx.fee < requiredFee {
+		return nil, fmt.Errorf("%w: %s fee (%d) < required replacement fee (%d)",
+			ErrConflictsWithOtherTx,
+			tx.tx.ID(),
+			tx.fee,
+			requiredFee,
+		)
+	}
+	return conflicts, nil
+}
+
 func (m *mempool[T]) Get(txID ids.ID) (T, bool) {
 	m.lock.RLock()
 	defer m.lock.RUnlock()
 
-	return m.unissuedTxs.Get(txID)
+	tx, ok := m.unissuedTxs[txID]
+	if !ok {
+		return utils.Zero[T](), false
+	}
+	return tx.tx, true
 }
 
 func (m *mempool[T]) Remove(txs ...T) {
@@ -153,43 +338,49 @@ func (m *mempool[T]) Remove(txs ...T) {
 	defer m.lock.Unlock()
 
 	for _, tx := range txs {
-		txID := tx.ID()
 		// If the transaction is in the mempool, remove it.
-		if _, ok := m.consumedUTXOs.DeleteKey(txID); ok {
-			m.unissuedTxs.Delete(txID)
-			m.bytesAvailable += tx.Size()
+		if unissuedTx, ok := m.unissuedTxs[tx.ID()]; ok {
+			m.remove(unissuedTx)
 			continue
 		}
 
 		// If the transaction isn't in the mempool, remove any conflicts it has.
 		inputs := tx.InputIDs()
 		for _, removed := range m.consumedUTXOs.DeleteOverlapping(inputs) {
-			tx, _ := m.unissuedTxs.Get(removed.Key)
-			m.unissuedTxs.Delete(removed.Key)
-			m.bytesAvailable += tx.Size()
+			m.remove(m.unissuedTxs[removed.Key])
 		}
 	}
 	m.updateMetrics()
 }
 
+// remove [tx] from the mempool. The caller is responsible for updating the
+// metrics.
+func (m *mempool[T]) remove(tx *meteredTx[T]) {
+	txID := tx.tx.ID()
+	m.consumedUTXOs.DeleteKey(txID)
+	delete(m.unissuedTxs, txID)
+	m.txsByPriority.Delete(tx)
+	m.bytesAvailable += int(tx.size)
+}
+
 func (m *mempool[T]) Peek() (T, bool) {
 	m.lock.RLock()
 	defer m.lock.RUnlock()
 
-	_, tx, exists := m.unissuedTxs.Oldest()
-	return tx, exists
+	tx, exists := m.txsByPriority.Min()
+	if !exists {
+		return utils.Zero[T](), false
+	}
+	return tx.tx, true
 }
 
 func (m *mempool[T]) Iterate(f func(T) bool) {
 	m.lock.RLock()
 	defer m.lock.RUnlock()
 
-	it := m.unissuedTxs.NewIterator()
-	for it.Next() {
-		if !f(it.Value()) {
-			return
-		}
-	}
+	m.txsByPriority.Ascend(func(tx *meteredTx[T]) bool {
+		return f(tx.tx)
+	})
 }
 
 func (m *mempool[_]) MarkDropped(txID ids.ID, reason error) {
@@ -200,7 +391,7 @@ func (m *mempool[_]) MarkDropped(txID ids.ID, reason error) {
 	m.lock.RLock()
 	defer m.lock.RUnlock()
 
-	if _, ok := m.unissuedTxs.Get(txID); ok {
+	if _, ok := m.unissuedTxs[txID]; ok {
 		return
 	}
 
@@ -216,5 +407,5 @@ func (m *mempool[_]) Len() int {
 	m.lock.RLock()
 	defer m.lock.RUnlock()
 
-	return m.unissuedTxs.Len()
+	return len(m.unissuedTxs)
 }
@@ -5,18 +5,22 @@ package mempool
 
 import (
 	"errors"
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils/set"
+
+	safemath "github.com/ava-labs/avalanchego/utils/math"
 )
 
 var _ Tx = (*dummyTx)(nil)
 
 type dummyTx struct {
 	size     int
+	fee      uint64
 	id       ids.ID
 	inputIDs []ids.ID
 }
@@ -38,7 +42,21 @@ type noMetrics struct{}
 func (*noMetrics) Update(int, int) {}
 
 func newMempool() *mempool[*dummyTx] {
-	return New[*dummyTx](&noMetrics{})
+	return New[*dummyTx](&noMetrics{}, nil, 0)
+}
+
+func newFeeMempool() *mempool[*dummyTx] {
+	return newFeeMempoolWithMinFeeRate(0)
+}
+
+func newFeeMempoolWithMinFeeRate(minFeeRate uint64) *mempool[*dummyTx] {
+	return New[*dummyTx](
+		&noMetrics{},
+		func(tx *dummyTx) (uint64, error) {
+			return tx.fee, nil
+		},
+		minFeeRate,
+	)
 }
 
 func TestAdd(t *testing.T) {
@@ -255,6 +273,227 @@ func TestDropped(t *testing.T) {
 	require.NoError(mempool.GetDropReason(txID))
 }
 
+func TestPeekHighestFeeRate(t *testing.T) {
+	require := require.New(t)
+
+	mempool := newFeeMempool()
+
+	lowFeeTx := newFeeTx(0, 100, 100)  // 1 per byte
+	highFeeTx := newFeeTx(1, 100, 300) // 3 per byte
+	// Pays the highest fee, but the lowest fee rate.
+	largeTx := newFeeTx(2, 1000, 500) // .5 per byte
+	sameFeeTx := newFeeTx(3, 50, 150) // 3 per byte
+
+	require.NoError(mempool.Add(lowFeeTx))
+	require.NoError(mempool.Add(highFeeTx))
+	require.NoError(mempool.Add(largeTx))
+	require.NoError(mempool.Add(sameFeeTx))
+
+	var iteratedTxs []*dummyTx
+	mempool.Iterate(func(tx *dummyTx) bool {
+		iteratedTxs = append(iteratedTxs, tx)
+		return true
+	})
+	// Txs paying the same fee rate are ordered by insertion.
+	require.Equal([]*dummyTx{highFeeTx, sameFeeTx, lowFeeTx, largeTx}, iteratedTxs)
+
+	for _, expectedTx := range iteratedTxs {
+		tx, exists := mempool.Peek()
+		require.True(exists)
+		require.Equal(expectedTx, tx)
+		mempool.Remove(tx)
+	}
+
+	_, exists := mempool.Peek()
+	require.False(exists)
+	require.Equal(maxMempoolSize, mempool.bytesAvailable)
+}
+
+func TestReplacement(t *testing.T) {
+	conflictingTx := func(fee uint64, inputIndices ...uint64) *dummyTx {
+		tx := newFeeTx(0, 100, fee)
+		tx.inputIDs = nil
+		for _, index := range inputIndices {
+			tx.inputIDs = append(tx.inputIDs, ids.Empty.Prefix(index))
+		}
+		return tx
+	}
+
+	tests := []struct {
+		name        string
+		minFeeRate  uint64
+		initialTxs  []*dummyTx
+		tx          *dummyTx
+		expectedErr error
+		expectedLen int
+	}{
+		{
+			name:        "higher fee replaces conflict",
+			initialTxs:  []*dummyTx{conflictingTx(100, 0)},
+			tx:          conflictingTx(110, 0),
+			expectedErr: nil,
+			expectedLen: 1,
+		},
+		{
+			name:        "fee bump below 10% doesn't replace conflict",
+			initialTxs:  []*dummyTx{conflictingTx(100, 0)},
+			tx:          conflictingTx(109, 0),
+			expectedErr: ErrConflictsWithOtherTx,
+			expectedLen: 1,
+		},
+		{
+			name:        "fee bump rounds up",
+			initialTxs:  []*dummyTx{conflictingTx(101, 0)},
+			tx:          conflictingTx(112, 0),
+			expectedErr: nil,
+			expectedLen: 1,
+		},
+		{
+			name:        "fee bump below 10% rounded up doesn't replace conflict",
+			initialTxs:  []*dummyTx{conflictingTx(101, 0)},
+			tx:          conflictingTx(111, 0),
+			expectedErr: ErrConflictsWithOtherTx,
+			expectedLen: 1,
+		},
+		{
+			name:        "zero fee doesn't replace zero fee conflict",
+			initialTxs:  []*dummyTx{conflictingTx(0, 0)},
+			tx:          conflictingTx(0, 0),
+			expectedErr: ErrConflictsWithOtherTx,
+			expectedLen: 1,
+		},
+		{
+			name:        "fee bump must pay the min fee rate",
+			minFeeRate:  1,
+			initialTxs:  []*dummyTx{conflictingTx(100, 0)},
+			tx:          conflictingTx(199, 0),
+			expectedErr: ErrConflictsWithOtherTx,
+			expectedLen: 1,
+		},
+		{
+			name:        "fee bump paying the min fee rate replaces conflict",
+			minFeeRate:  1,
+			initialTxs:  []*dummyTx{conflictingTx(100, 0)},
+			tx:          conflictingTx(200, 0),
+			expectedErr: nil,
+			expectedLen: 1,
+		},
+		{
+			name:        "equal fee doesn't replace conflict",
+			initialTxs:  []*dummyTx{conflictingTx(100, 0)},
+			tx:          conflictingTx(100, 0),
+			expectedErr: ErrConflictsWithOtherTx,
+			expectedLen: 1,
+		},
+		{
+			name:        "lower fee doesn't replace conflict",
+			initialTxs:  []*dummyTx{conflictingTx(100, 0)},
+			tx:          conflictingTx(99, 0),
+			expectedErr: ErrConflictsWithOtherTx,
+			expectedLen: 1,
+		},
+		{
+			name: "higher fee replaces multiple conflicts",
+			initialTxs: []*dummyTx{
+				conflictingTx(100, 0),
+				conflictingTx(100, 1),
+				conflictingTx(100, 2),
+			},
+			tx:          conflictingTx(220, 0, 1),
+			expectedErr: nil,
+			expectedLen: 2,
+		},
+		{
+			name: "fee must exceed the combined fee of the conflicts",
+			initialTxs: []*dummyTx{
+				conflictingTx(100, 0),
+				conflictingTx(100, 1),
+			},
+			tx:          conflictingTx(219, 0, 1),
+			expectedErr: ErrConflictsWithOtherTx,
+			expectedLen: 2,
+		},
+		{
+			name: "combined fee of the conflicts overflows",
+			initialTxs: []*dummyTx{
+				conflictingTx(math.MaxUint64, 0),
+				conflictingTx(math.MaxUint64, 1),
+			},
+			tx:          conflictingTx(math.MaxUint64, 0, 1),
+			expectedErr: safemath.ErrOverflow,
+			expectedLen: 2,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			mempool := newFeeMempoolWithMinFeeRate(test.minFeeRate)
+			for _, tx := range test.initialTxs {
+				require.NoError(mempool.Add(tx))
+			}
+
+			err := mempool.Add(test.tx)
+			require.ErrorIs(err, test.expectedErr)
+			require.Equal(test.expectedLen, mempool.Len())
+
+			_, exists := mempool.Get(test.tx.ID())
+			require.Equal(err == nil, exists)
+			for _, tx := range test.initialTxs {
+				_, exists := mempool.Get(tx.ID())
+				inputIDs := tx.InputIDs()
+				replaced := err == nil && inputIDs.Overlaps(test.tx.InputIDs())
+				require.Equal(!replaced, exists)
+				if replaced {
+					require.ErrorIs(mempool.GetDropReason(tx.ID()), ErrReplaced)
+				}
+			}
+		})
+	}
+}
+
+func TestEvictLowestFeeRate(t *testing.T) {
+	require := require.New(t)
+
+	mempool := newFeeMempool()
+
+	numTxs := maxMempoolSize / MaxTxSize
+	initialTxs := make([]*dummyTx, numTxs)
+	for i := range initialTxs {
+		initialTxs[i] = newFeeTx(uint64(i), MaxTxSize, uint64(i+1))
+		require.NoError(mempool.Add(initialTxs[i]))
+	}
+	lowestFeeTx := initialTxs[0]
+
+	// A tx paying the same fee rate as the lowest paying tx can't be added.
+	err := mempool.Add(newFeeTx(uint64(numTxs), MaxTxSize, 1))
+	require.ErrorIs(err, ErrMempoolFull)
+
+	// A tx paying a higher fee rate evicts the lowest paying txs.
+	tx := newFeeTx(uint64(numTxs), MaxTxSize, 2)
+	require.NoError(mempool.Add(tx))
+	require.Equal(numTxs, mempool.Len())
+	_, exists := mempool.Get(lowestFeeTx.ID())
+	require.False(exists)
+
+	// Txs paying the same fee rate as the new tx are not evicted.
+	err = mempool.Add(newFeeTx(uint64(numTxs+1), MaxTxSize, 2))
+	require.ErrorIs(err, ErrMempoolFull)
+	for _, tx := range initialTxs[1:] {
+		_, exists := mempool.Get(tx.ID())
+		require.True(exists)
+	}
+
+	// Evicted txs may be re-added once there is space for them.
+	require.NoError(mempool.GetDropReason(lowestFeeTx.ID()))
+}
+
+func newFeeTx(index uint64, size int, fee uint64) *dummyTx {
+	tx := newTx(index, size)
+	tx.fee = fee
+	return tx
+}
+
 func newTxs(num int, size int) []*dummyTx {
 	txs := make([]*dummyTx, num)
 	for i := range txs {
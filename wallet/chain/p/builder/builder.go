@@ -9,6 +9,8 @@ import (
 	"fmt"
 	"time"
 
+	"golang.org/x/exp/maps"
+
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/utils/constants"
@@ -19,16 +21,22 @@ import (
 	"github.com/ava-labs/avalanchego/vms/platformvm/signer"
 	"github.com/ava-labs/avalanchego/vms/platformvm/stakeable"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs/fee"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 	"github.com/ava-labs/avalanchego/wallet/subnet/primary/common"
 )
 
+// maxFeeIterations is the maximum number of times a tx will be rebuilt while
+// searching for a fee that covers its complexity.
+const maxFeeIterations = 8
+
 var (
 	ErrNoChangeAddress           = errors.New("no possible change address")
 	ErrUnknownOutputType         = errors.New("unknown output type")
 	ErrUnknownOwnerType          = errors.New("unknown owner type")
 	ErrInsufficientAuthorization = errors.New("insufficient authorization")
 	ErrInsufficientFunds         = errors.New("insufficient funds")
+	ErrFeeNotConverged           = errors.New("fee did not converge")
 
 	_ Builder = (*builder)(nil)
 )
@@ -310,34 +318,45 @@ func (b *builder) NewBaseTx(
 	outputs []*avax.TransferableOutput,
 	options ...common.Option,
 ) (*txs.BaseTx, error) {
-	toBurn := map[ids.ID]uint64{
-		b.context.AVAXAssetID: b.context.BaseTxFee,
-	}
-	for _, out := range outputs {
-		assetID := out.AssetID()
-		amountToBurn, err := math.Add64(toBurn[assetID], out.Out.Amount())
+	var (
+		ops = common.NewOptions(options)
+		tx  *txs.BaseTx
+	)
+	err := b.buildWithFee(b.context.BaseTxFee, func(fee uint64) (txs.UnsignedTx, error) {
+		toBurn := map[ids.ID]uint64{
+			b.context.AVAXAssetID: fee,
+		}
+		for _, out := range outputs {
+			assetID := out.AssetID()
+			amountToBurn, err := math.Add64(toBurn[assetID], out.Out.Amount())
+			if err != nil {
+				return nil, err
+			}
+			toBurn[assetID] = amountToBurn
+		}
+		toStake := map[ids.ID]uint64{}
+
+		inputs, changeOutputs, _, err := b.spend(toBurn, toStake, ops)
 		if err != nil {
 			return nil, err
 		}
-		toBurn[assetID] = amountToBurn
-	}
-	toStake := map[ids.ID]uint64{}
+		allOutputs := make([]*avax.TransferableOutput, 0, len(outputs)+len(changeOutputs))
+		allOutputs = append(allOutputs, outputs...)
+		allOutputs = append(allOutputs, changeOutputs...)
+		avax.SortTransferableOutputs(allOutputs, txs.Codec) // sort the outputs
 
-	ops := common.NewOptions(options)
-	inputs, changeOutputs, _, err := b.spend(toBurn, toStake, ops)
+		tx = &txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    b.context.NetworkID,
+			BlockchainID: constants.PlatformChainID,
+			Ins:          inputs,
+			Outs:         allOutputs,
+			Memo:         ops.Memo(),
+		}}
+		return tx, nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	outputs = append(outputs, changeOutputs...)
-	avax.SortTransferableOutputs(outputs, txs.Codec) // sort the outputs
-
-	tx := &txs.BaseTx{BaseTx: avax.BaseTx{
-		NetworkID:    b.context.NetworkID,
-		BlockchainID: constants.PlatformChainID,
-		Ins:          inputs,
-		Outs:         outputs,
-		Memo:         ops.Memo(),
-	}}
 	return tx, b.initCtx(tx)
 }
 
@@ -347,33 +366,42 @@ func (b *builder) NewAddValidatorTx(
 	shares uint32,
 	options ...common.Option,
 ) (*txs.AddValidatorTx, error) {
-	avaxAssetID := b.context.AVAXAssetID
-	toBurn := map[ids.ID]uint64{
-		avaxAssetID: b.context.AddPrimaryNetworkValidatorFee,
-	}
-	toStake := map[ids.ID]uint64{
-		avaxAssetID: vdr.Wght,
-	}
-	ops := common.NewOptions(options)
-	inputs, baseOutputs, stakeOutputs, err := b.spend(toBurn, toStake, ops)
+	var (
+		avaxAssetID = b.context.AVAXAssetID
+		ops         = common.NewOptions(options)
+		tx          *txs.AddValidatorTx
+	)
+	utils.Sort(rewardsOwner.Addrs)
+	err := b.buildWithFee(b.context.AddPrimaryNetworkValidatorFee, func(fee uint64) (txs.UnsignedTx, error) {
+		toBurn := map[ids.ID]uint64{
+			avaxAssetID: fee,
+		}
+		toStake := map[ids.ID]uint64{
+			avaxAssetID: vdr.Wght,
+		}
+		inputs, baseOutputs, stakeOutputs, err := b.spend(toBurn, toStake, ops)
+		if err != nil {
+			return nil, err
+		}
+
+		tx = &txs.AddValidatorTx{
+			BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+				NetworkID:    b.context.NetworkID,
+				BlockchainID: constants.PlatformChainID,
+				Ins:          inputs,
+				Outs:         baseOutputs,
+				Memo:         ops.Memo(),
+			}},
+			Validator:        *vdr,
+			StakeOuts:        stakeOutputs,
+			RewardsOwner:     rewardsOwner,
+			DelegationShares: shares,
+		}
+		return tx, nil
+	})
 	if err != nil {
 		return nil, err
 	}
-
-	utils.Sort(rewardsOwner.Addrs)
-	tx := &txs.AddValidatorTx{
-		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
-			NetworkID:    b.context.NetworkID,
-			BlockchainID: constants.PlatformChainID,
-			Ins:          inputs,
-			Outs:         baseOutputs,
-			Memo:         ops.Memo(),
-		}},
-		Validator:        *vdr,
-		StakeOuts:        stakeOutputs,
-		RewardsOwner:     rewardsOwner,
-		DelegationShares: shares,
-	}
 	return tx, b.initCtx(tx)
 }
 
@@ -381,32 +409,39 @@ func (b *builder) NewAddSubnetValidatorTx(
 	vdr *txs.SubnetValidator,
 	options ...common.Option,
 ) (*txs.AddSubnetValidatorTx, error) {
-	toBurn := map[ids.ID]uint64{
-		b.context.AVAXAssetID: b.context.AddSubnetValidatorFee,
-	}
-	toStake := map[ids.ID]uint64{}
 	ops := common.NewOptions(options)
-	inputs, outputs, _, err := b.spend(toBurn, toStake, ops)
+	subnetAuth, err := b.authorizeSubnet(vdr.Subnet, ops)
 	if err != nil {
 		return nil, err
 	}
 
-	subnetAuth, err := b.authorizeSubnet(vdr.Subnet, ops)
+	var tx *txs.AddSubnetValidatorTx
+	err = b.buildWithFee(b.context.AddSubnetValidatorFee, func(fee uint64) (txs.UnsignedTx, error) {
+		toBurn := map[ids.ID]uint64{
+			b.context.AVAXAssetID: fee,
+		}
+		toStake := map[ids.ID]uint64{}
+		inputs, outputs, _, err := b.spend(toBurn, toStake, ops)
+		if err != nil {
+			return nil, err
+		}
+
+		tx = &txs.AddSubnetValidatorTx{
+			BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+				NetworkID:    b.context.NetworkID,
+				BlockchainID: constants.PlatformChainID,
+				Ins:          inputs,
+				Outs:         outputs,
+				Memo:         ops.Memo(),
+			}},
+			SubnetValidator: *vdr,
+			SubnetAuth:      subnetAuth,
+		}
+		return tx, nil
+	})
 	if err != nil {
 		return nil, err
 	}
-
-	tx := &txs.AddSubnetValidatorTx{
-		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
-			NetworkID:    b.context.NetworkID,
-			BlockchainID: constants.PlatformChainID,
-			Ins:          inputs,
-			Outs:         outputs,
-			Memo:         ops.Memo(),
-		}},
-		SubnetValidator: *vdr,
-		SubnetAuth:      subnetAuth,
-	}
 	return tx, b.initCtx(tx)
 }
 
@@ -415,33 +450,40 @@ func (b *builder) NewRemoveSubnetValidatorTx(
 	subnetID ids.ID,
 	options ...common.Option,
 ) (*txs.RemoveSubnetValidatorTx, error) {
-	toBurn := map[ids.ID]uint64{
-		b.context.AVAXAssetID: b.context.BaseTxFee,
-	}
-	toStake := map[ids.ID]uint64{}
 	ops := common.NewOptions(options)
-	inputs, outputs, _, err := b.spend(toBurn, toStake, ops)
+	subnetAuth, err := b.authorizeSubnet(subnetID, ops)
 	if err != nil {
 		return nil, err
 	}
 
-	subnetAuth, err := b.authorizeSubnet(subnetID, ops)
+	var tx *txs.RemoveSubnetValidatorTx
+	err = b.buildWithFee(b.context.BaseTxFee, func(fee uint64) (txs.UnsignedTx, error) {
+		toBurn := map[ids.ID]uint64{
+			b.context.AVAXAssetID: fee,
+		}
+		toStake := map[ids.ID]uint64{}
+		inputs, outputs, _, err := b.spend(toBurn, toStake, ops)
+		if err != nil {
+			return nil, err
+		}
+
+		tx = &txs.RemoveSubnetValidatorTx{
+			BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+				NetworkID:    b.context.NetworkID,
+				BlockchainID: constants.PlatformChainID,
+				Ins:          inputs,
+				Outs:         outputs,
+				Memo:         ops.Memo(),
+			}},
+			Subnet:     subnetID,
+			NodeID:     nodeID,
+			SubnetAuth: subnetAuth,
+		}
+		return tx, nil
+	})
 	if err != nil {
 		return nil, err
 	}
-
-	tx := &txs.RemoveSubnetValidatorTx{
-		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
-			NetworkID:    b.context.NetworkID,
-			BlockchainID: constants.PlatformChainID,
-			Ins:          inputs,
-			Outs:         outputs,
-			Memo:         ops.Memo(),
-		}},
-		Subnet:     subnetID,
-		NodeID:     nodeID,
-		SubnetAuth: subnetAuth,
-	}
 	return tx, b.initCtx(tx)
 }
 
@@ -450,32 +492,41 @@ func (b *builder) NewAddDelegatorTx(
 	rewardsOwner *secp256k1fx.OutputOwners,
 	options ...common.Option,
 ) (*txs.AddDelegatorTx, error) {
-	avaxAssetID := b.context.AVAXAssetID
-	toBurn := map[ids.ID]uint64{
-		avaxAssetID: b.context.AddPrimaryNetworkDelegatorFee,
-	}
-	toStake := map[ids.ID]uint64{
-		avaxAssetID: vdr.Wght,
-	}
-	ops := common.NewOptions(options)
-	inputs, baseOutputs, stakeOutputs, err := b.spend(toBurn, toStake, ops)
+	var (
+		avaxAssetID = b.context.AVAXAssetID
+		ops         = common.NewOptions(options)
+		tx          *txs.AddDelegatorTx
+	)
+	utils.Sort(rewardsOwner.Addrs)
+	err := b.buildWithFee(b.context.AddPrimaryNetworkDelegatorFee, func(fee uint64) (txs.UnsignedTx, error) {
+		toBurn := map[ids.ID]uint64{
+			avaxAssetID: fee,
+		}
+		toStake := map[ids.ID]uint64{
+			avaxAssetID: vdr.Wght,
+		}
+		inputs, baseOutputs, stakeOutputs, err := b.spend(toBurn, toStake, ops)
+		if err != nil {
+			return nil, err
+		}
+
+		tx = &txs.AddDelegatorTx{
+			BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+				NetworkID:    b.context.NetworkID,
+				BlockchainID: constants.PlatformChainID,
+				Ins:          inputs,
+				Outs:         baseOutputs,
+				Memo:         ops.Memo(),
+			}},
+			Validator:              *vdr,
+			StakeOuts:              stakeOutputs,
+			DelegationRewardsOwner: rewardsOwner,
+		}
+		return tx, nil
+	})
 	if err != nil {
 		return nil, err
 	}
-
-	utils.Sort(rewardsOwner.Addrs)
-	tx := &txs.AddDelegatorTx{
-		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
-			NetworkID:    b.context.NetworkID,
-			BlockchainID: constants.PlatformChainID,
-			Ins:          inputs,
-			Outs:         baseOutputs,
-			Memo:         ops.Memo(),
-		}},
-		Validator:              *vdr,
-		StakeOuts:              stakeOutputs,
-		DelegationRewardsOwner: rewardsOwner,
-	}
 	return tx, b.initCtx(tx)
 }
 
@@ -487,36 +538,43 @@ func (b *builder) NewCreateChainTx(
 	chainName string,
 	options ...common.Option,
 ) (*txs.CreateChainTx, error) {
-	toBurn := map[ids.ID]uint64{
-		b.context.AVAXAssetID: b.context.CreateBlockchainTxFee,
-	}
-	toStake := map[ids.ID]uint64{}
 	ops := common.NewOptions(options)
-	inputs, outputs, _, err := b.spend(toBurn, toStake, ops)
-	if err != nil {
-		return nil, err
-	}
-
 	subnetAuth, err := b.authorizeSubnet(subnetID, ops)
 	if err != nil {
 		return nil, err
 	}
 
 	utils.Sort(fxIDs)
-	tx := &txs.CreateChainTx{
-		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
-			NetworkID:    b.context.NetworkID,
-			BlockchainID: constants.PlatformChainID,
-			Ins:          inputs,
-			Outs:         outputs,
-			Memo:         ops.Memo(),
-		}},
-		SubnetID:    subnetID,
-		ChainName:   chainName,
-		VMID:        vmID,
-		FxIDs:       fxIDs,
-		GenesisData: genesis,
-		SubnetAuth:  subnetAuth,
+	var tx *txs.CreateChainTx
+	err = b.buildWithFee(b.context.CreateBlockchainTxFee, func(fee uint64) (txs.UnsignedTx, error) {
+		toBurn := map[ids.ID]uint64{
+			b.context.AVAXAssetID: fee,
+		}
+		toStake := map[ids.ID]uint64{}
+		inputs, outputs, _, err := b.spend(toBurn, toStake, ops)
+		if err != nil {
+			return nil, err
+		}
+
+		tx = &txs.CreateChainTx{
+			BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+				NetworkID:    b.context.NetworkID,
+				BlockchainID: constants.PlatformChainID,
+				Ins:          inputs,
+				Outs:         outputs,
+				Memo:         ops.Memo(),
+			}},
+			SubnetID:    subnetID,
+			ChainName:   chainName,
+			VMID:        vmID,
+			FxIDs:       fxIDs,
+			GenesisData: genesis,
+			SubnetAuth:  subnetAuth,
+		}
+		return tx, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 	return tx, b.initCtx(tx)
 }
@@ -525,27 +583,36 @@ func (b *builder) NewCreateSubnetTx(
 	owner *secp256k1fx.OutputOwners,
 	options ...common.Option,
 ) (*txs.CreateSubnetTx, error) {
-	toBurn := map[ids.ID]uint64{
-		b.context.AVAXAssetID: b.context.CreateSubnetTxFee,
-	}
-	toStake := map[ids.ID]uint64{}
-	ops := common.NewOptions(options)
-	inputs, outputs, _, err := b.spend(toBurn, toStake, ops)
+	var (
+		ops = common.NewOptions(options)
+		tx  *txs.CreateSubnetTx
+	)
+	utils.Sort(owner.Addrs)
+	err := b.buildWithFee(b.context.CreateSubnetTxFee, func(fee uint64) (txs.UnsignedTx, error) {
+		toBurn := map[ids.ID]uint64{
+			b.context.AVAXAssetID: fee,
+		}
+		toStake := map[ids.ID]uint64{}
+		inputs, outputs, _, err := b.spend(toBurn, toStake, ops)
+		if err != nil {
+			return nil, err
+		}
+
+		tx = &txs.CreateSubnetTx{
+			BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+				NetworkID:    b.context.NetworkID,
+				BlockchainID: constants.PlatformChainID,
+				Ins:          inputs,
+				Outs:         outputs,
+				Memo:         ops.Memo(),
+			}},
+			Owner: owner,
+		}
+		return tx, nil
+	})
 	if err != nil {
 		return nil, err
 	}
-
-	utils.Sort(owner.Addrs)
-	tx := &txs.CreateSubnetTx{
-		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
-			NetworkID:    b.context.NetworkID,
-			BlockchainID: constants.PlatformChainID,
-			Ins:          inputs,
-			Outs:         outputs,
-			Memo:         ops.Memo(),
-		}},
-		Owner: owner,
-	}
 	return tx, b.initCtx(tx)
 }
 
@@ -554,33 +621,40 @@ func (b *builder) NewTransferSubnetOwnershipTx(
 	owner *secp256k1fx.OutputOwners,
 	options ...common.Option,
 ) (*txs.TransferSubnetOwnershipTx, error) {
-	toBurn := map[ids.ID]uint64{
-		b.context.AVAXAssetID: b.context.BaseTxFee,
-	}
-	toStake := map[ids.ID]uint64{}
 	ops := common.NewOptions(options)
-	inputs, outputs, _, err := b.spend(toBurn, toStake, ops)
-	if err != nil {
-		return nil, err
-	}
-
 	subnetAuth, err := b.authorizeSubnet(subnetID, ops)
 	if err != nil {
 		return nil, err
 	}
 
 	utils.Sort(owner.Addrs)
-	tx := &txs.TransferSubnetOwnershipTx{
-		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
-			NetworkID:    b.context.NetworkID,
-			BlockchainID: constants.PlatformChainID,
-			Ins:          inputs,
-			Outs:         outputs,
-			Memo:         ops.Memo(),
-		}},
-		Subnet:     subnetID,
-		Owner:      owner,
-		SubnetAuth: subnetAuth,
+	var tx *txs.TransferSubnetOwnershipTx
+	err = b.buildWithFee(b.context.BaseTxFee, func(fee uint64) (txs.UnsignedTx, error) {
+		toBurn := map[ids.ID]uint64{
+			b.context.AVAXAssetID: fee,
+		}
+		toStake := map[ids.ID]uint64{}
+		inputs, outputs, _, err := b.spend(toBurn, toStake, ops)
+		if err != nil {
+			return nil, err
+		}
+
+		tx = &txs.TransferSubnetOwnershipTx{
+			BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+				NetworkID:    b.context.NetworkID,
+				BlockchainID: constants.PlatformChainID,
+				Ins:          inputs,
+				Outs:         outputs,
+				Memo:         ops.Memo(),
+			}},
+			Subnet:     subnetID,
+			Owner:      owner,
+			SubnetAuth: subnetAuth,
+		}
+		return tx, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 	return tx, b.initCtx(tx)
 }
@@ -600,7 +674,6 @@ func (b *builder) NewImportTx(
 		addrs           = ops.Addresses(b.addrs)
 		minIssuanceTime = ops.MinIssuanceTime()
 		avaxAssetID     = b.context.AVAXAssetID
-		txFee           = b.context.BaseTxFee
 
 		importedInputs  = make([]*avax.TransferableInput, 0, len(utxos))
 		importedAmounts = make(map[ids.ID]uint64)
@@ -645,49 +718,57 @@ func (b *builder) NewImportTx(
 		)
 	}
 
-	var (
-		inputs       []*avax.TransferableInput
-		outputs      = make([]*avax.TransferableOutput, 0, len(importedAmounts))
-		importedAVAX = importedAmounts[avaxAssetID]
-	)
-	if importedAVAX > txFee {
-		importedAmounts[avaxAssetID] -= txFee
-	} else {
-		if importedAVAX < txFee { // imported amount goes toward paying tx fee
-			toBurn := map[ids.ID]uint64{
-				avaxAssetID: txFee - importedAVAX,
-			}
-			toStake := map[ids.ID]uint64{}
-			var err error
-			inputs, outputs, _, err = b.spend(toBurn, toStake, ops)
-			if err != nil {
-				return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
+	var tx *txs.ImportTx
+	err = b.buildWithFee(b.context.BaseTxFee, func(txFee uint64) (txs.UnsignedTx, error) {
+		var (
+			inputs         []*avax.TransferableInput
+			outputs        = make([]*avax.TransferableOutput, 0, len(importedAmounts))
+			importedAVAX   = importedAmounts[avaxAssetID]
+			amountsToOwner = maps.Clone(importedAmounts)
+		)
+		if importedAVAX > txFee {
+			amountsToOwner[avaxAssetID] -= txFee
+		} else {
+			if importedAVAX < txFee { // imported amount goes toward paying tx fee
+				toBurn := map[ids.ID]uint64{
+					avaxAssetID: txFee - importedAVAX,
+				}
+				toStake := map[ids.ID]uint64{}
+				var err error
+				inputs, outputs, _, err = b.spend(toBurn, toStake, ops)
+				if err != nil {
+					return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
+				}
 			}
+			delete(amountsToOwner, avaxAssetID)
 		}
-		delete(importedAmounts, avaxAssetID)
-	}
 
-	for assetID, amount := range importedAmounts {
-		outputs = append(outputs, &avax.TransferableOutput{
-			Asset: avax.Asset{ID: assetID},
-			Out: &secp256k1fx.TransferOutput{
-				Amt:          amount,
-				OutputOwners: *to,
-			},
-		})
-	}
+		for assetID, amount := range amountsToOwner {
+			outputs = append(outputs, &avax.TransferableOutput{
+				Asset: avax.Asset{ID: assetID},
+				Out: &secp256k1fx.TransferOutput{
+					Amt:          amount,
+					OutputOwners: *to,
+				},
+			})
+		}
 
-	avax.SortTransferableOutputs(outputs, txs.Codec) // sort imported outputs
-	tx := &txs.ImportTx{
-		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
-			NetworkID:    b.context.NetworkID,
-			BlockchainID: constants.PlatformChainID,
-			Ins:          inputs,
-			Outs:         outputs,
-			Memo:         ops.Memo(),
-		}},
-		SourceChain:    sourceChainID,
-		ImportedInputs: importedInputs,
+		avax.SortTransferableOutputs(outputs, txs.Codec) // sort imported outputs
+		tx = &txs.ImportTx{
+			BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+				NetworkID:    b.context.NetworkID,
+				BlockchainID: constants.PlatformChainID,
+				Ins:          inputs,
+				Outs:         outputs,
+				Memo:         ops.Memo(),
+			}},
+			SourceChain:    sourceChainID,
+			ImportedInputs: importedInputs,
+		}
+		return tx, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 	return tx, b.initCtx(tx)
 }
@@ -697,37 +778,47 @@ func (b *builder) NewExportTx(
 	outputs []*avax.TransferableOutput,
 	options ...common.Option,
 ) (*txs.ExportTx, error) {
-	toBurn := map[ids.ID]uint64{
-		b.context.AVAXAssetID: b.context.BaseTxFee,
-	}
-	for _, out := range outputs {
-		assetID := out.AssetID()
-		amountToBurn, err := math.Add64(toBurn[assetID], out.Out.Amount())
+	avax.SortTransferableOutputs(outputs, txs.Codec) // sort exported outputs
+
+	var (
+		ops = common.NewOptions(options)
+		tx  *txs.ExportTx
+	)
+	err := b.buildWithFee(b.context.BaseTxFee, func(fee uint64) (txs.UnsignedTx, error) {
+		toBurn := map[ids.ID]uint64{
+			b.context.AVAXAssetID: fee,
+		}
+		for _, out := range outputs {
+			assetID := out.AssetID()
+			amountToBurn, err := math.Add64(toBurn[assetID], out.Out.Amount())
+			if err != nil {
+				return nil, err
+			}
+			toBurn[assetID] = amountToBurn
+		}
+
+		toStake := map[ids.ID]uint64{}
+		inputs, changeOutputs, _, err := b.spend(toBurn, toStake, ops)
 		if err != nil {
 			return nil, err
 		}
-		toBurn[assetID] = amountToBurn
-	}
 
-	toStake := map[ids.ID]uint64{}
-	ops := common.NewOptions(options)
-	inputs, changeOutputs, _, err := b.spend(toBurn, toStake, ops)
+		tx = &txs.ExportTx{
+			BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+				NetworkID:    b.context.NetworkID,
+				BlockchainID: constants.PlatformChainID,
+				Ins:          inputs,
+				Outs:         changeOutputs,
+				Memo:         ops.Memo(),
+			}},
+			DestinationChain: chainID,
+			ExportedOutputs:  outputs,
+		}
+		return tx, nil
+	})
 	if err != nil {
 		return nil, err
 	}
-
-	avax.SortTransferableOutputs(outputs, txs.Codec) // sort exported outputs
-	tx := &txs.ExportTx{
-		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
-			NetworkID:    b.context.NetworkID,
-			BlockchainID: constants.PlatformChainID,
-			Ins:          inputs,
-			Outs:         changeOutputs,
-			Memo:         ops.Memo(),
-		}},
-		DestinationChain: chainID,
-		ExportedOutputs:  outputs,
-	}
 	return tx, b.initCtx(tx)
 }
 
@@ -748,46 +839,53 @@ func (b *builder) NewTransformSubnetTx(
 	uptimeRequirement uint32,
 	options ...common.Option,
 ) (*txs.TransformSubnetTx, error) {
-	toBurn := map[ids.ID]uint64{
-		b.context.AVAXAssetID: b.context.TransformSubnetTxFee,
-		assetID:               maxSupply - initialSupply,
-	}
-	toStake := map[ids.ID]uint64{}
 	ops := common.NewOptions(options)
-	inputs, outputs, _, err := b.spend(toBurn, toStake, ops)
+	subnetAuth, err := b.authorizeSubnet(subnetID, ops)
 	if err != nil {
 		return nil, err
 	}
 
-	subnetAuth, err := b.authorizeSubnet(subnetID, ops)
+	var tx *txs.TransformSubnetTx
+	err = b.buildWithFee(b.context.TransformSubnetTxFee, func(fee uint64) (txs.UnsignedTx, error) {
+		toBurn := map[ids.ID]uint64{
+			b.context.AVAXAssetID: fee,
+			assetID:               maxSupply - initialSupply,
+		}
+		toStake := map[ids.ID]uint64{}
+		inputs, outputs, _, err := b.spend(toBurn, toStake, ops)
+		if err != nil {
+			return nil, err
+		}
+
+		tx = &txs.TransformSubnetTx{
+			BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+				NetworkID:    b.context.NetworkID,
+				BlockchainID: constants.PlatformChainID,
+				Ins:          inputs,
+				Outs:         outputs,
+				Memo:         ops.Memo(),
+			}},
+			Subnet:                   subnetID,
+			AssetID:                  assetID,
+			InitialSupply:            initialSupply,
+			MaximumSupply:            maxSupply,
+			MinConsumptionRate:       minConsumptionRate,
+			MaxConsumptionRate:       maxConsumptionRate,
+			MinValidatorStake:        minValidatorStake,
+			MaxValidatorStake:        maxValidatorStake,
+			MinStakeDuration:         uint32(minStakeDuration / time.Second),
+			MaxStakeDuration:         uint32(maxStakeDuration / time.Second),
+			MinDelegationFee:         minDelegationFee,
+			MinDelegatorStake:        minDelegatorStake,
+			MaxValidatorWeightFactor: maxValidatorWeightFactor,
+			UptimeRequirement:        uptimeRequirement,
+			SubnetAuth:               subnetAuth,
+		}
+		return tx, nil
+	})
 	if err != nil {
 		return nil, err
 	}
-
-	tx := &txs.TransformSubnetTx{
-		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
-			NetworkID:    b.context.NetworkID,
-			BlockchainID: constants.PlatformChainID,
-			Ins:          inputs,
-			Outs:         outputs,
-			Memo:         ops.Memo(),
-		}},
-		Subnet:                   subnetID,
-		AssetID:                  assetID,
-		InitialSupply:            initialSupply,
-		MaximumSupply:            maxSupply,
-		MinConsumptionRate:       minConsumptionRate,
-		MaxConsumptionRate:       maxConsumptionRate,
-		MinValidatorStake:        minValidatorStake,
-		MaxValidatorStake:        maxValidatorStake,
-		MinStakeDuration:         uint32(minStakeDuration / time.Second),
-		MaxStakeDuration:         uint32(maxStakeDuration / time.Second),
-		MinDelegationFee:         minDelegationFee,
-		MinDelegatorStake:        minDelegatorStake,
-		MaxValidatorWeightFactor: maxValidatorWeightFactor,
-		UptimeRequirement:        uptimeRequirement,
-		SubnetAuth:               subnetAuth,
-	}
 	return tx, b.initCtx(tx)
 }
 
@@ -800,39 +898,50 @@ func (b *builder) NewAddPermissionlessValidatorTx(
 	shares uint32,
 	options ...common.Option,
 ) (*txs.AddPermissionlessValidatorTx, error) {
-	avaxAssetID := b.context.AVAXAssetID
-	toBurn := map[ids.ID]uint64{}
+	var (
+		avaxAssetID = b.context.AVAXAssetID
+		staticFee   = b.context.AddSubnetValidatorFee
+		ops         = common.NewOptions(options)
+		tx          *txs.AddPermissionlessValidatorTx
+	)
 	if vdr.Subnet == constants.PrimaryNetworkID {
-		toBurn[avaxAssetID] = b.context.AddPrimaryNetworkValidatorFee
-	} else {
-		toBurn[avaxAssetID] = b.context.AddSubnetValidatorFee
-	}
-	toStake := map[ids.ID]uint64{
-		assetID: vdr.Wght,
-	}
-	ops := common.NewOptions(options)
-	inputs, baseOutputs, stakeOutputs, err := b.spend(toBurn, toStake, ops)
-	if err != nil {
-		return nil, err
+		staticFee = b.context.AddPrimaryNetworkValidatorFee
 	}
 
 	utils.Sort(validationRewardsOwner.Addrs)
 	utils.Sort(delegationRewardsOwner.Addrs)
-	tx := &txs.AddPermissionlessValidatorTx{
-		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
-			NetworkID:    b.context.NetworkID,
-			BlockchainID: constants.PlatformChainID,
-			Ins:          inputs,
-			Outs:         baseOutputs,
-			Memo:         ops.Memo(),
-		}},
-		Validator:             vdr.Validator,
-		Subnet:                vdr.Subnet,
-		Signer:                signer,
-		StakeOuts:             stakeOutputs,
-		ValidatorRewardsOwner: validationRewardsOwner,
-		DelegatorRewardsOwner: delegationRewardsOwner,
-		DelegationShares:      shares,
+	err := b.buildWithFee(staticFee, func(fee uint64) (txs.UnsignedTx, error) {
+		toBurn := map[ids.ID]uint64{
+			avaxAssetID: fee,
+		}
+		toStake := map[ids.ID]uint64{
+			assetID: vdr.Wght,
+		}
+		inputs, baseOutputs, stakeOutputs, err := b.spend(toBurn, toStake, ops)
+		if err != nil {
+			return nil, err
+		}
+
+		tx = &txs.AddPermissionlessValidatorTx{
+			BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+				NetworkID:    b.context.NetworkID,
+				BlockchainID: constants.PlatformChainID,
+				Ins:          inputs,
+				Outs:         baseOutputs,
+				Memo:         ops.Memo(),
+			}},
+			Validator:             vdr.Validator,
+			Subnet:                vdr.Subnet,
+			Signer:                signer,
+			StakeOuts:             stakeOutputs,
+			ValidatorRewardsOwner: validationRewardsOwner,
+			DelegatorRewardsOwner: delegationRewardsOwner,
+			DelegationShares:      shares,
+		}
+		return tx, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 	return tx, b.initCtx(tx)
 }
@@ -843,35 +952,46 @@ func (b *builder) NewAddPermissionlessDelegatorTx(
 	rewardsOwner *secp256k1fx.OutputOwners,
 	options ...common.Option,
 ) (*txs.AddPermissionlessDelegatorTx, error) {
-	avaxAssetID := b.context.AVAXAssetID
-	toBurn := map[ids.ID]uint64{}
+	var (
+		avaxAssetID = b.context.AVAXAssetID
+		staticFee   = b.context.AddSubnetDelegatorFee
+		ops         = common.NewOptions(options)
+		tx          *txs.AddPermissionlessDelegatorTx
+	)
 	if vdr.Subnet == constants.PrimaryNetworkID {
-		toBurn[avaxAssetID] = b.context.AddPrimaryNetworkDelegatorFee
-	} else {
-		toBurn[avaxAssetID] = b.context.AddSubnetDelegatorFee
-	}
-	toStake := map[ids.ID]uint64{
-		assetID: vdr.Wght,
-	}
-	ops := common.NewOptions(options)
-	inputs, baseOutputs, stakeOutputs, err := b.spend(toBurn, toStake, ops)
-	if err != nil {
-		return nil, err
+		staticFee = b.context.AddPrimaryNetworkDelegatorFee
 	}
 
 	utils.Sort(rewardsOwner.Addrs)
-	tx := &txs.AddPermissionlessDelegatorTx{
-		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
-			NetworkID:    b.context.NetworkID,
-			BlockchainID: constants.PlatformChainID,
-			Ins:          inputs,
-			Outs:         baseOutputs,
-			Memo:         ops.Memo(),
-		}},
-		Validator:              vdr.Validator,
-		Subnet:                 vdr.Subnet,
-		StakeOuts:              stakeOutputs,
-		DelegationRewardsOwner: rewardsOwner,
+	err := b.buildWithFee(staticFee, func(fee uint64) (txs.UnsignedTx, error) {
+		toBurn := map[ids.ID]uint64{
+			avaxAssetID: fee,
+		}
+		toStake := map[ids.ID]uint64{
+			assetID: vdr.Wght,
+		}
+		inputs, baseOutputs, stakeOutputs, err := b.spend(toBurn, toStake, ops)
+		if err != nil {
+			return nil, err
+		}
+
+		tx = &txs.AddPermissionlessDelegatorTx{
+			BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+				NetworkID:    b.context.NetworkID,
+				BlockchainID: constants.PlatformChainID,
+				Ins:          inputs,
+				Outs:         baseOutputs,
+				Memo:         ops.Memo(),
+			}},
+			Validator:              vdr.Validator,
+			Subnet:                 vdr.Subnet,
+			StakeOuts:              stakeOutputs,
+			DelegationRewardsOwner: rewardsOwner,
+		}
+		return tx, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 	return tx, b.initCtx(tx)
 }
@@ -1159,6 +1279,44 @@ func (b *builder) spend(
 	return inputs, changeOutputs, stakeOutputs, nil
 }
 
+// buildWithFee builds an unsigned tx with [build], which is provided the fee
+// that the tx should burn.
+//
+// If dynamic fees are not active, [staticFee] is burned. Otherwise, the fee
+// depends on the complexity of the tx, which in turn depends on the UTXOs that
+// are consumed to pay the fee. So, the tx is rebuilt until the burned fee
+// covers the fee of the resulting tx.
+func (b *builder) buildWithFee(
+	staticFee uint64,
+	build func(fee uint64) (txs.UnsignedTx, error),
+) error {
+	if b.context.GasPrice == 0 {
+		_, err := build(staticFee)
+		return err
+	}
+
+	var (
+		calculator = fee.NewDynamicCalculator(b.context.ComplexityWeights, b.context.GasPrice)
+		txFee      uint64
+	)
+	for i := 0; i < maxFeeIterations; i++ {
+		tx, err := build(txFee)
+		if err != nil {
+			return err
+		}
+
+		requiredFee, err := calculator.CalculateFee(tx)
+		if err != nil {
+			return err
+		}
+		if requiredFee <= txFee {
+			return nil
+		}
+		txFee = requiredFee
+	}
+	return fmt.Errorf("%w after %d iterations", ErrFeeNotConverged, maxFeeIterations)
+}
+
 func (b *builder) authorizeSubnet(subnetID ids.ID, options *common.Options) (*secp256k1fx.Input, error) {
 	ownerIntf, err := b.backend.GetSubnetOwner(options.Context(), subnetID)
 	if err != nil {
@@ -12,6 +12,7 @@ import (
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/vms/avm"
+	"github.com/ava-labs/avalanchego/vms/components/gas"
 )
 
 const Alias = "P"
@@ -27,6 +28,11 @@ type Context struct {
 	AddPrimaryNetworkDelegatorFee uint64
 	AddSubnetValidatorFee         uint64
 	AddSubnetDelegatorFee         uint64
+
+	// ComplexityWeights and GasPrice are used to calculate fees once dynamic
+	// fees are active. If GasPrice is 0, the static fees above are used.
+	ComplexityWeights gas.Dimensions
+	GasPrice          gas.Price
 }
 
 func NewContextFromURI(ctx context.Context, uri string) (*Context, error) {
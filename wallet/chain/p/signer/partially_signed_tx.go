@@ -0,0 +1,433 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package signer
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/crypto/keychain"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/hashing"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+var (
+	_ Backend           = (*partiallySignedTxBackend)(nil)
+	_ keychain.Keychain = addressKeychain{}
+
+	ErrMissingUTXO         = errors.New("missing UTXO")
+	ErrMismatchedTx        = errors.New("mismatched partially signed tx")
+	ErrInvalidSignature    = errors.New("invalid signature")
+	ErrMissingSignatures   = errors.New("missing signatures")
+	errAddressOnlyKeychain = errors.New("keychain only contains addresses")
+)
+
+// PartiallySignedTx is a transaction that requires signatures from keys held by
+// different parties. It contains everything needed to inspect and sign the
+// transaction, so it can be passed between parties that don't have access to a
+// node.
+type PartiallySignedTx struct {
+	// Tx is the transaction along with the signatures gathered so far. Missing
+	// signatures are left empty.
+	Tx *txs.Tx `serialize:"true" json:"tx"`
+	// UTXOs are the UTXOs consumed by the transaction, including imported
+	// UTXOs.
+	UTXOs []*avax.UTXO `serialize:"true" json:"utxos"`
+	// SubnetOwners are the owners of the subnets that must authorize the
+	// transaction.
+	SubnetOwners map[ids.ID]fx.Owner `serialize:"true" json:"subnetOwners"`
+	// Signers are the addresses that must sign each credential of the
+	// transaction.
+	Signers [][]ids.ShortID `serialize:"true" json:"signers"`
+}
+
+// NewPartiallySignedTx returns [utx] without any signatures. [backend] must
+// provide every UTXO consumed by [utx] and the owner of every subnet that must
+// authorize [utx].
+func NewPartiallySignedTx(
+	ctx context.Context,
+	backend Backend,
+	utx txs.UnsignedTx,
+) (*PartiallySignedTx, error) {
+	recorder := &recordingBackend{
+		backend:      backend,
+		subnetOwners: make(map[ids.ID]fx.Owner),
+	}
+	v := visitor{
+		kc:      addressKeychain{},
+		backend: recorder,
+		ctx:     ctx,
+	}
+	if err := utx.Visit(&v); err != nil {
+		return nil, err
+	}
+
+	signers, err := signerAddresses(v.txSigners)
+	if err != nil {
+		return nil, err
+	}
+
+	emptySigners := make([][]keychain.Signer, len(v.txSigners))
+	for credIndex, credSigners := range v.txSigners {
+		emptySigners[credIndex] = make([]keychain.Signer, len(credSigners))
+	}
+
+	// Initialize the credentials without any signatures.
+	tx := &txs.Tx{Unsigned: utx}
+	if err := sign(tx, v.signHash, emptySigners); err != nil {
+		return nil, err
+	}
+	return &PartiallySignedTx{
+		Tx:           tx,
+		UTXOs:        recorder.utxos,
+		SubnetOwners: recorder.subnetOwners,
+		Signers:      signers,
+	}, nil
+}
+
+// ParsePartiallySignedTx parses the bytes returned by
+// [PartiallySignedTx.Bytes].
+func ParsePartiallySignedTx(b []byte) (*PartiallySignedTx, error) {
+	p := &PartiallySignedTx{}
+	if _, err := txs.Codec.Unmarshal(b, p); err != nil {
+		return nil, fmt.Errorf("couldn't parse partially signed tx: %w", err)
+	}
+	if err := p.Tx.Initialize(txs.Codec); err != nil {
+		return nil, err
+	}
+	if _, err := p.credentials(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (p *PartiallySignedTx) Bytes() ([]byte, error) {
+	return txs.Codec.Marshal(txs.CodecVersion, p)
+}
+
+// Sign adds every missing signature that can be provided by [kc].
+func (p *PartiallySignedTx) Sign(ctx context.Context, kc keychain.Keychain) error {
+	backend, err := p.verifyUTXOs(ctx)
+	if err != nil {
+		return err
+	}
+	return New(kc, backend).Sign(ctx, p.Tx)
+}
+
+// Merge adds the signatures gathered by [other] that are missing from [p].
+// [other] must be a partially signed version of the same transaction.
+func (p *PartiallySignedTx) Merge(other *PartiallySignedTx) error {
+	if !bytes.Equal(p.Tx.Unsigned.Bytes(), other.Tx.Unsigned.Bytes()) {
+		return fmt.Errorf("%w: different unsigned txs", ErrMismatchedTx)
+	}
+	if _, err := p.verifyUTXOs(context.Background()); err != nil {
+		return err
+	}
+	if _, err := other.verifyUTXOs(context.Background()); err != nil {
+		return err
+	}
+
+	creds, err := p.credentials()
+	if err != nil {
+		return err
+	}
+	otherCreds, err := other.credentials()
+	if err != nil {
+		return err
+	}
+	if len(creds) != len(otherCreds) {
+		return fmt.Errorf("%w: different number of credentials", ErrMismatchedTx)
+	}
+
+	// Verify every new signature before modifying [p].
+	unsignedHash := hashing.ComputeHash256(p.Tx.Unsigned.Bytes())
+	for credIndex, otherCred := range otherCreds {
+		cred := creds[credIndex]
+		if len(cred.Sigs) != len(otherCred.Sigs) {
+			return fmt.Errorf("%w: different number of signatures", ErrMismatchedTx)
+		}
+		for sigIndex, sig := range otherCred.Sigs {
+			if sig == emptySig || sig == cred.Sigs[sigIndex] {
+				continue
+			}
+			if err := verifySignature(unsignedHash, sig, p.Signers[credIndex][sigIndex]); err != nil {
+				return err
+			}
+		}
+	}
+
+	for credIndex, otherCred := range otherCreds {
+		cred := creds[credIndex]
+		for sigIndex, sig := range otherCred.Sigs {
+			if sig != emptySig {
+				cred.Sigs[sigIndex] = sig
+			}
+		}
+	}
+	return p.Tx.Initialize(txs.Codec)
+}
+
+// MissingSigners returns the addresses whose signatures are still missing.
+func (p *PartiallySignedTx) MissingSigners() set.Set[ids.ShortID] {
+	var missing set.Set[ids.ShortID]
+	creds, err := p.credentials()
+	if err != nil {
+		return missing
+	}
+	for credIndex, cred := range creds {
+		for sigIndex, sig := range cred.Sigs {
+			if sig == emptySig {
+				missing.Add(p.Signers[credIndex][sigIndex])
+			}
+		}
+	}
+	return missing
+}
+
+// Finalize returns the signed transaction once every signature has been
+// gathered.
+func (p *PartiallySignedTx) Finalize() (*txs.Tx, error) {
+	creds, err := p.credentials()
+	if err != nil {
+		return nil, err
+	}
+	if missing := p.MissingSigners(); missing.Len() > 0 {
+		return nil, fmt.Errorf("%w: %s", ErrMissingSignatures, missing.List())
+	}
+
+	unsignedHash := hashing.ComputeHash256(p.Tx.Unsigned.Bytes())
+	for credIndex, cred := range creds {
+		for sigIndex, sig := range cred.Sigs {
+			if err := verifySignature(unsignedHash, sig, p.Signers[credIndex][sigIndex]); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return p.Tx, nil
+}
+
+// verifyUTXOs verifies that every UTXO included in [p] is consumed by the
+// transaction with the same UTXOID, asset, and amount as the input consuming
+// it, and that the UTXOs require the expected signers. It returns a backend
+// that provides the verified UTXOs and subnet owners.
+func (p *PartiallySignedTx) verifyUTXOs(ctx context.Context) (*partiallySignedTxBackend, error) {
+	backend := &partiallySignedTxBackend{
+		utxos:        make(map[ids.ID]*avax.UTXO, len(p.UTXOs)),
+		subnetOwners: p.SubnetOwners,
+	}
+	for _, utxo := range p.UTXOs {
+		utxoID := utxo.InputID()
+		if _, ok := backend.utxos[utxoID]; ok {
+			return nil, fmt.Errorf("%w: duplicate UTXO %s", ErrMismatchedUTXO, utxoID)
+		}
+		backend.utxos[utxoID] = utxo
+	}
+
+	// The visitor rejects any UTXO that doesn't match the input consuming it.
+	recorder := &recordingBackend{
+		backend:      backend,
+		subnetOwners: make(map[ids.ID]fx.Owner),
+	}
+	v := visitor{
+		kc:      addressKeychain{},
+		backend: recorder,
+		ctx:     ctx,
+	}
+	if err := p.Tx.Unsigned.Visit(&v); err != nil {
+		return nil, err
+	}
+
+	var consumed set.Set[ids.ID]
+	for _, utxo := range recorder.utxos {
+		consumed.Add(utxo.InputID())
+	}
+	if consumed.Len() != len(backend.utxos) {
+		return nil, fmt.Errorf("%w: %d UTXOs aren't consumed by the tx",
+			ErrMismatchedUTXO,
+			len(backend.utxos)-consumed.Len(),
+		)
+	}
+
+	signers, err := signerAddresses(v.txSigners)
+	if err != nil {
+		return nil, err
+	}
+	if err := verifySigners(signers, p.Signers); err != nil {
+		return nil, err
+	}
+	return backend, nil
+}
+
+// credentials returns the credentials of the transaction after verifying that
+// they match the expected signers.
+func (p *PartiallySignedTx) credentials() ([]*secp256k1fx.Credential, error) {
+	if len(p.Tx.Creds) != len(p.Signers) {
+		return nil, fmt.Errorf("%w: expected %d credentials but got %d",
+			ErrMismatchedTx,
+			len(p.Signers),
+			len(p.Tx.Creds),
+		)
+	}
+
+	creds := make([]*secp256k1fx.Credential, len(p.Tx.Creds))
+	for credIndex, credIntf := range p.Tx.Creds {
+		cred, ok := credIntf.(*secp256k1fx.Credential)
+		if !ok {
+			return nil, ErrUnknownCredentialType
+		}
+		if len(cred.Sigs) != len(p.Signers[credIndex]) {
+			return nil, fmt.Errorf("%w: expected %d signatures but got %d",
+				ErrMismatchedTx,
+				len(p.Signers[credIndex]),
+				len(cred.Sigs),
+			)
+		}
+		creds[credIndex] = cred
+	}
+	return creds, nil
+}
+
+// signerAddresses returns the addresses of [txSigners]. Every signer must be
+// provided.
+func signerAddresses(txSigners [][]keychain.Signer) ([][]ids.ShortID, error) {
+	signers := make([][]ids.ShortID, len(txSigners))
+	for credIndex, credSigners := range txSigners {
+		signers[credIndex] = make([]ids.ShortID, len(credSigners))
+		for sigIndex, signer := range credSigners {
+			// The address keychain provides a signer for every address, so the
+			// signer is only missing if the UTXO is unknown.
+			if signer == nil {
+				return nil, fmt.Errorf("%w: credential %d", ErrMissingUTXO, credIndex)
+			}
+			signers[credIndex][sigIndex] = signer.Address()
+		}
+	}
+	return signers, nil
+}
+
+func verifySigners(expected, actual [][]ids.ShortID) error {
+	if len(expected) != len(actual) {
+		return fmt.Errorf("%w: expected %d credentials but got %d",
+			ErrMismatchedTx,
+			len(expected),
+			len(actual),
+		)
+	}
+	for credIndex, expectedSigners := range expected {
+		actualSigners := actual[credIndex]
+		if len(expectedSigners) != len(actualSigners) {
+			return fmt.Errorf("%w: expected %d signers but got %d",
+				ErrMismatchedTx,
+				len(expectedSigners),
+				len(actualSigners),
+			)
+		}
+		for sigIndex, signer := range expectedSigners {
+			if signer != actualSigners[sigIndex] {
+				return fmt.Errorf("%w: expected signer %s but got %s",
+					ErrMismatchedTx,
+					signer,
+					actualSigners[sigIndex],
+				)
+			}
+		}
+	}
+	return nil
+}
+
+func verifySignature(unsignedHash []byte, sig [secp256k1.SignatureLen]byte, addr ids.ShortID) error {
+	pk, err := secp256k1.RecoverPublicKeyFromHash(unsignedHash, sig[:])
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidSignature, err)
+	}
+	if pk.Address() != addr {
+		return fmt.Errorf("%w: expected signature from %s but got %s",
+			ErrInvalidSignature,
+			addr,
+			pk.Address(),
+		)
+	}
+	return nil
+}
+
+// recordingBackend records the UTXOs and subnet owners provided by [backend].
+type recordingBackend struct {
+	backend      Backend
+	utxos        []*avax.UTXO
+	subnetOwners map[ids.ID]fx.Owner
+}
+
+func (b *recordingBackend) GetUTXO(ctx context.Context, chainID, utxoID ids.ID) (*avax.UTXO, error) {
+	utxo, err := b.backend.GetUTXO(ctx, chainID, utxoID)
+	if err == nil {
+		b.utxos = append(b.utxos, utxo)
+	}
+	return utxo, err
+}
+
+func (b *recordingBackend) GetSubnetOwner(ctx context.Context, subnetID ids.ID) (fx.Owner, error) {
+	owner, err := b.backend.GetSubnetOwner(ctx, subnetID)
+	if err == nil {
+		b.subnetOwners[subnetID] = owner
+	}
+	return owner, err
+}
+
+// partiallySignedTxBackend provides the UTXOs and subnet owners included in a
+// partially signed tx.
+type partiallySignedTxBackend struct {
+	utxos        map[ids.ID]*avax.UTXO
+	subnetOwners map[ids.ID]fx.Owner
+}
+
+func (b *partiallySignedTxBackend) GetUTXO(_ context.Context, _, utxoID ids.ID) (*avax.UTXO, error) {
+	utxo, ok := b.utxos[utxoID]
+	if !ok {
+		return nil, database.ErrNotFound
+	}
+	return utxo, nil
+}
+
+func (b *partiallySignedTxBackend) GetSubnetOwner(_ context.Context, subnetID ids.ID) (fx.Owner, error) {
+	owner, ok := b.subnetOwners[subnetID]
+	if !ok {
+		return nil, database.ErrNotFound
+	}
+	return owner, nil
+}
+
+// addressKeychain provides a signer for every address. The signers can't sign,
+// they are only used to find the addresses that must sign a transaction.
+type addressKeychain struct{}
+
+func (addressKeychain) Get(addr ids.ShortID) (keychain.Signer, bool) {
+	return addressSigner(addr), true
+}
+
+func (addressKeychain) Addresses() set.Set[ids.ShortID] {
+	return nil
+}
+
+type addressSigner ids.ShortID
+
+func (addressSigner) SignHash([]byte) ([]byte, error) {
+	return nil, errAddressOnlyKeychain
+}
+
+func (addressSigner) Sign([]byte) ([]byte, error) {
+	return nil, errAddressOnlyKeychain
+}
+
+func (s addressSigner) Address() ids.ShortID {
+	return ids.ShortID(s)
+}
@@ -0,0 +1,307 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package signer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+type testBackend struct {
+	utxos        map[ids.ID]*avax.UTXO
+	subnetOwners map[ids.ID]fx.Owner
+}
+
+func (b *testBackend) GetUTXO(_ context.Context, _, utxoID ids.ID) (*avax.UTXO, error) {
+	utxo, ok := b.utxos[utxoID]
+	if !ok {
+		return nil, database.ErrNotFound
+	}
+	return utxo, nil
+}
+
+func (b *testBackend) GetSubnetOwner(_ context.Context, subnetID ids.ID) (fx.Owner, error) {
+	owner, ok := b.subnetOwners[subnetID]
+	if !ok {
+		return nil, database.ErrNotFound
+	}
+	return owner, nil
+}
+
+func newTestKeys(t *testing.T, num int) []*secp256k1.PrivateKey {
+	keys := make([]*secp256k1.PrivateKey, num)
+	for i := range keys {
+		var err error
+		keys[i], err = secp256k1.NewPrivateKey()
+		require.NoError(t, err)
+	}
+	return keys
+}
+
+func TestPartiallySignedTx(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		ctx         = context.Background()
+		avaxAssetID = ids.GenerateTestID()
+		subnetID    = ids.GenerateTestID()
+		keys        = newTestKeys(t, 2)
+		multisig    = secp256k1fx.OutputOwners{
+			Threshold: 2,
+			Addrs: []ids.ShortID{
+				keys[0].Address(),
+				keys[1].Address(),
+			},
+		}
+		utxo = &avax.UTXO{
+			UTXOID: avax.UTXOID{TxID: ids.GenerateTestID()},
+			Asset:  avax.Asset{ID: avaxAssetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt:          100,
+				OutputOwners: multisig,
+			},
+		}
+		backend = &testBackend{
+			utxos: map[ids.ID]*avax.UTXO{
+				utxo.InputID(): utxo,
+			},
+			subnetOwners: map[ids.ID]fx.Owner{
+				subnetID: &multisig,
+			},
+		}
+		utx = &txs.RemoveSubnetValidatorTx{
+			BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+				NetworkID:    constants.UnitTestID,
+				BlockchainID: constants.PlatformChainID,
+				Ins: []*avax.TransferableInput{{
+					UTXOID: utxo.UTXOID,
+					Asset:  utxo.Asset,
+					In: &secp256k1fx.TransferInput{
+						Amt: 100,
+						Input: secp256k1fx.Input{
+							SigIndices: []uint32{0, 1},
+						},
+					},
+				}},
+			}},
+			NodeID: ids.GenerateTestNodeID(),
+			Subnet: subnetID,
+			SubnetAuth: &secp256k1fx.Input{
+				SigIndices: []uint32{1},
+			},
+		}
+	)
+
+	pst, err := NewPartiallySignedTx(ctx, backend, utx)
+	require.NoError(err)
+	require.Equal([]*avax.UTXO{utxo}, pst.UTXOs)
+	require.Equal(
+		[][]ids.ShortID{
+			{keys[0].Address(), keys[1].Address()},
+			{keys[1].Address()},
+		},
+		pst.Signers,
+	)
+	require.Equal(set.Of(keys[0].Address(), keys[1].Address()), pst.MissingSigners())
+
+	_, err = pst.Finalize()
+	require.ErrorIs(err, ErrMissingSignatures)
+
+	pstBytes, err := pst.Bytes()
+	require.NoError(err)
+
+	// Each party signs its own copy of the tx without access to the backend.
+	signedPSTs := make([]*PartiallySignedTx, len(keys))
+	for i, key := range keys {
+		signedPSTs[i], err = ParsePartiallySignedTx(pstBytes)
+		require.NoError(err)
+		require.NoError(signedPSTs[i].Sign(ctx, secp256k1fx.NewKeychain(key)))
+		require.Equal(set.Of(keys[1-i].Address()), signedPSTs[i].MissingSigners())
+	}
+
+	require.NoError(pst.Merge(signedPSTs[0]))
+	require.Equal(set.Of(keys[1].Address()), pst.MissingSigners())
+	require.NoError(pst.Merge(signedPSTs[1]))
+	require.Empty(pst.MissingSigners())
+
+	tx, err := pst.Finalize()
+	require.NoError(err)
+
+	// The finalized tx must be identical to a tx signed by a single party
+	// holding every key.
+	expectedTx, err := SignUnsigned(ctx, New(secp256k1fx.NewKeychain(keys...), backend), utx)
+	require.NoError(err)
+	require.Equal(expectedTx.Bytes(), tx.Bytes())
+
+	parsedTx, err := txs.Parse(txs.Codec, tx.Bytes())
+	require.NoError(err)
+	require.Equal(tx.ID(), parsedTx.ID())
+}
+
+func TestPartiallySignedTxMergeInvalidSignature(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		ctx         = context.Background()
+		avaxAssetID = ids.GenerateTestID()
+		keys        = newTestKeys(t, 2)
+		utxo        = &avax.UTXO{
+			UTXOID: avax.UTXOID{TxID: ids.GenerateTestID()},
+			Asset:  avax.Asset{ID: avaxAssetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: 100,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{keys[0].Address()},
+				},
+			},
+		}
+		backend = &testBackend{
+			utxos: map[ids.ID]*avax.UTXO{
+				utxo.InputID(): utxo,
+			},
+		}
+		utx = &txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    constants.UnitTestID,
+			BlockchainID: constants.PlatformChainID,
+			Ins: []*avax.TransferableInput{{
+				UTXOID: utxo.UTXOID,
+				Asset:  utxo.Asset,
+				In: &secp256k1fx.TransferInput{
+					Amt: 100,
+					Input: secp256k1fx.Input{
+						SigIndices: []uint32{0},
+					},
+				},
+			}},
+		}}
+	)
+
+	pst, err := NewPartiallySignedTx(ctx, backend, utx)
+	require.NoError(err)
+
+	// Sign with a key that isn't the required signer.
+	otherPST, err := NewPartiallySignedTx(ctx, backend, utx)
+	require.NoError(err)
+	sig, err := keys[1].Sign(otherPST.Tx.Unsigned.Bytes())
+	require.NoError(err)
+	copy(otherPST.Tx.Creds[0].(*secp256k1fx.Credential).Sigs[0][:], sig)
+
+	err = pst.Merge(otherPST)
+	require.ErrorIs(err, ErrInvalidSignature)
+	require.Equal(set.Of(keys[0].Address()), pst.MissingSigners())
+
+	// Txs spending unknown UTXOs can't be partially signed.
+	_, err = NewPartiallySignedTx(ctx, &testBackend{}, utx)
+	require.ErrorIs(err, ErrMissingUTXO)
+}
+
+func TestPartiallySignedTxMismatchedUTXO(t *testing.T) {
+	var (
+		ctx         = context.Background()
+		avaxAssetID = ids.GenerateTestID()
+		keys        = newTestKeys(t, 1)
+		owners      = secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{keys[0].Address()},
+		}
+		utxo = &avax.UTXO{
+			UTXOID: avax.UTXOID{TxID: ids.GenerateTestID()},
+			Asset:  avax.Asset{ID: avaxAssetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt:          100,
+				OutputOwners: owners,
+			},
+		}
+		backend = &testBackend{
+			utxos: map[ids.ID]*avax.UTXO{
+				utxo.InputID(): utxo,
+			},
+		}
+		utx = &txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    constants.UnitTestID,
+			BlockchainID: constants.PlatformChainID,
+			Ins: []*avax.TransferableInput{{
+				UTXOID: utxo.UTXOID,
+				Asset:  utxo.Asset,
+				In: &secp256k1fx.TransferInput{
+					Amt: 100,
+					Input: secp256k1fx.Input{
+						SigIndices: []uint32{0},
+					},
+				},
+			}},
+		}}
+	)
+
+	tests := []struct {
+		name  string
+		utxos []*avax.UTXO
+	}{
+		{
+			name: "wrong asset",
+			utxos: []*avax.UTXO{{
+				UTXOID: utxo.UTXOID,
+				Asset:  avax.Asset{ID: ids.GenerateTestID()},
+				Out:    utxo.Out,
+			}},
+		},
+		{
+			name: "wrong amount",
+			utxos: []*avax.UTXO{{
+				UTXOID: utxo.UTXOID,
+				Asset:  utxo.Asset,
+				Out: &secp256k1fx.TransferOutput{
+					Amt:          1000,
+					OutputOwners: owners,
+				},
+			}},
+		},
+		{
+			name: "unconsumed UTXO",
+			utxos: []*avax.UTXO{
+				utxo,
+				{
+					UTXOID: avax.UTXOID{TxID: ids.GenerateTestID()},
+					Asset:  utxo.Asset,
+					Out:    utxo.Out,
+				},
+			},
+		},
+		{
+			name:  "duplicate UTXO",
+			utxos: []*avax.UTXO{utxo, utxo},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			pst, err := NewPartiallySignedTx(ctx, backend, utx)
+			require.NoError(err)
+			otherPST, err := NewPartiallySignedTx(ctx, backend, utx)
+			require.NoError(err)
+
+			otherPST.UTXOs = test.utxos
+			err = otherPST.Sign(ctx, secp256k1fx.NewKeychain(keys...))
+			require.ErrorIs(err, ErrMismatchedUTXO)
+			require.Equal(set.Of(keys[0].Address()), otherPST.MissingSigners())
+
+			err = pst.Merge(otherPST)
+			require.ErrorIs(err, ErrMismatchedUTXO)
+		})
+	}
+}
@@ -27,6 +27,7 @@ var (
 	ErrUnsupportedTxType     = errors.New("unsupported tx type")
 	ErrUnknownInputType      = errors.New("unknown input type")
 	ErrUnknownOutputType     = errors.New("unknown output type")
+	ErrMismatchedUTXO        = errors.New("UTXO doesn't match the input consuming it")
 	ErrInvalidUTXOSigIndex   = errors.New("invalid UTXO signature index")
 	ErrUnknownSubnetAuthType = errors.New("unknown subnet auth type")
 	ErrUnknownOwnerType      = errors.New("unknown owner type")
@@ -35,12 +36,15 @@ var (
 	emptySig [secp256k1.SignatureLen]byte
 )
 
-// visitor handles signing transactions for the signer
+// visitor finds the signers of a transaction for the signer
 type visitor struct {
 	kc      keychain.Keychain
 	backend Backend
 	ctx     context.Context
-	tx      *txs.Tx
+
+	// outputs of visitor execution
+	signHash  bool
+	txSigners [][]keychain.Signer
 }
 
 func (*visitor) AdvanceTimeTx(*txs.AdvanceTimeTx) error {
@@ -56,7 +60,7 @@ func (s *visitor) BaseTx(tx *txs.BaseTx) error {
 	if err != nil {
 		return err
 	}
-	return sign(s.tx, false, txSigners)
+	return s.setSigners(false, txSigners)
 }
 
 func (s *visitor) AddValidatorTx(tx *txs.AddValidatorTx) error {
@@ -64,7 +68,7 @@ func (s *visitor) AddValidatorTx(tx *txs.AddValidatorTx) error {
 	if err != nil {
 		return err
 	}
-	return sign(s.tx, false, txSigners)
+	return s.setSigners(false, txSigners)
 }
 
 func (s *visitor) AddSubnetValidatorTx(tx *txs.AddSubnetValidatorTx) error {
@@ -77,7 +81,7 @@ func (s *visitor) AddSubnetValidatorTx(tx *txs.AddSubnetValidatorTx) error {
 		return err
 	}
 	txSigners = append(txSigners, subnetAuthSigners)
-	return sign(s.tx, false, txSigners)
+	return s.setSigners(false, txSigners)
 }
 
 func (s *visitor) AddDelegatorTx(tx *txs.AddDelegatorTx) error {
@@ -85,7 +89,7 @@ func (s *visitor) AddDelegatorTx(tx *txs.AddDelegatorTx) error {
 	if err != nil {
 		return err
 	}
-	return sign(s.tx, false, txSigners)
+	return s.setSigners(false, txSigners)
 }
 
 func (s *visitor) CreateChainTx(tx *txs.CreateChainTx) error {
@@ -98,7 +102,7 @@ func (s *visitor) CreateChainTx(tx *txs.CreateChainTx) error {
 		return err
 	}
 	txSigners = append(txSigners, subnetAuthSigners)
-	return sign(s.tx, false, txSigners)
+	return s.setSigners(false, txSigners)
 }
 
 func (s *visitor) CreateSubnetTx(tx *txs.CreateSubnetTx) error {
@@ -106,7 +110,7 @@ func (s *visitor) CreateSubnetTx(tx *txs.CreateSubnetTx) error {
 	if err != nil {
 		return err
 	}
-	return sign(s.tx, false, txSigners)
+	return s.setSigners(false, txSigners)
 }
 
 func (s *visitor) ImportTx(tx *txs.ImportTx) error {
@@ -119,7 +123,7 @@ func (s *visitor) ImportTx(tx *txs.ImportTx) error {
 		return err
 	}
 	txSigners = append(txSigners, txImportSigners...)
-	return sign(s.tx, false, txSigners)
+	return s.setSigners(false, txSigners)
 }
 
 func (s *visitor) ExportTx(tx *txs.ExportTx) error {
@@ -127,7 +131,7 @@ func (s *visitor) ExportTx(tx *txs.ExportTx) error {
 	if err != nil {
 		return err
 	}
-	return sign(s.tx, false, txSigners)
+	return s.setSigners(false, txSigners)
 }
 
 func (s *visitor) RemoveSubnetValidatorTx(tx *txs.RemoveSubnetValidatorTx) error {
@@ -140,7 +144,7 @@ func (s *visitor) RemoveSubnetValidatorTx(tx *txs.RemoveSubnetValidatorTx) error
 		return err
 	}
 	txSigners = append(txSigners, subnetAuthSigners)
-	return sign(s.tx, true, txSigners)
+	return s.setSigners(true, txSigners)
 }
 
 func (s *visitor) TransferSubnetOwnershipTx(tx *txs.TransferSubnetOwnershipTx) error {
@@ -153,7 +157,7 @@ func (s *visitor) TransferSubnetOwnershipTx(tx *txs.TransferSubnetOwnershipTx) e
 		return err
 	}
 	txSigners = append(txSigners, subnetAuthSigners)
-	return sign(s.tx, true, txSigners)
+	return s.setSigners(true, txSigners)
 }
 
 func (s *visitor) TransformSubnetTx(tx *txs.TransformSubnetTx) error {
@@ -166,7 +170,7 @@ func (s *visitor) TransformSubnetTx(tx *txs.TransformSubnetTx) error {
 		return err
 	}
 	txSigners = append(txSigners, subnetAuthSigners)
-	return sign(s.tx, true, txSigners)
+	return s.setSigners(true, txSigners)
 }
 
 func (s *visitor) AddPermissionlessValidatorTx(tx *txs.AddPermissionlessValidatorTx) error {
@@ -174,7 +178,7 @@ func (s *visitor) AddPermissionlessValidatorTx(tx *txs.AddPermissionlessValidato
 	if err != nil {
 		return err
 	}
-	return sign(s.tx, true, txSigners)
+	return s.setSigners(true, txSigners)
 }
 
 func (s *visitor) AddPermissionlessDelegatorTx(tx *txs.AddPermissionlessDelegatorTx) error {
@@ -182,7 +186,13 @@ func (s *visitor) AddPermissionlessDelegatorTx(tx *txs.AddPermissionlessDelegato
 	if err != nil {
 		return err
 	}
-	return sign(s.tx, true, txSigners)
+	return s.setSigners(true, txSigners)
+}
+
+func (s *visitor) setSigners(signHash bool, txSigners [][]keychain.Signer) error {
+	s.signHash = signHash
+	s.txSigners = txSigners
+	return nil
 }
 
 func (s *visitor) getSigners(sourceChainID ids.ID, ins []*avax.TransferableInput) ([][]keychain.Signer, error) {
@@ -221,6 +231,9 @@ func (s *visitor) getSigners(sourceChainID ids.ID, ins []*avax.TransferableInput
 		if !ok {
 			return nil, ErrUnknownOutputType
 		}
+		if utxo.InputID() != utxoID || utxo.AssetID() != transferInput.AssetID() || out.Amt != input.Amt {
+			return nil, fmt.Errorf("%w: input %d", ErrMismatchedUTXO, credIndex)
+		}
 
 		for sigIndex, addrIndex := range input.SigIndices {
 			if addrIndex >= uint32(len(out.Addrs)) {
@@ -0,0 +1,210 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package signer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/avm/txs"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/ava-labs/avalanchego/wallet/chain/x/builder"
+)
+
+type testBackend map[ids.ID]*avax.UTXO
+
+func (b testBackend) GetUTXO(_ context.Context, _, utxoID ids.ID) (*avax.UTXO, error) {
+	utxo, ok := b[utxoID]
+	if !ok {
+		return nil, database.ErrNotFound
+	}
+	return utxo, nil
+}
+
+func TestPartiallySignedTx(t *testing.T) {
+	require := require.New(t)
+
+	ctx := context.Background()
+	keys := make([]*secp256k1.PrivateKey, 3)
+	for i := range keys {
+		var err error
+		keys[i], err = secp256k1.NewPrivateKey()
+		require.NoError(err)
+	}
+
+	// The UTXO is owned by a 2-of-3 multisig.
+	utxo := &avax.UTXO{
+		UTXOID: avax.UTXOID{TxID: ids.GenerateTestID()},
+		Asset:  avax.Asset{ID: ids.GenerateTestID()},
+		Out: &secp256k1fx.TransferOutput{
+			Amt: 100,
+			OutputOwners: secp256k1fx.OutputOwners{
+				Threshold: 2,
+				Addrs: []ids.ShortID{
+					keys[0].Address(),
+					keys[1].Address(),
+					keys[2].Address(),
+				},
+			},
+		},
+	}
+	backend := testBackend{
+		utxo.InputID(): utxo,
+	}
+	utx := &txs.BaseTx{BaseTx: avax.BaseTx{
+		NetworkID:    constants.UnitTestID,
+		BlockchainID: ids.GenerateTestID(),
+		Ins: []*avax.TransferableInput{{
+			UTXOID: utxo.UTXOID,
+			Asset:  utxo.Asset,
+			In: &secp256k1fx.TransferInput{
+				Amt: 100,
+				Input: secp256k1fx.Input{
+					SigIndices: []uint32{0, 2},
+				},
+			},
+		}},
+	}}
+
+	pst, err := NewPartiallySignedTx(ctx, backend, utx)
+	require.NoError(err)
+	require.Equal([][]ids.ShortID{{keys[0].Address(), keys[2].Address()}}, pst.Signers)
+
+	pstBytes, err := pst.Bytes()
+	require.NoError(err)
+
+	// Signing with a key that isn't required doesn't add any signature.
+	require.NoError(pst.Sign(ctx, secp256k1fx.NewKeychain(keys[1])))
+	require.Equal(set.Of(keys[0].Address(), keys[2].Address()), pst.MissingSigners())
+
+	otherPST, err := ParsePartiallySignedTx(pstBytes)
+	require.NoError(err)
+	require.NoError(otherPST.Sign(ctx, secp256k1fx.NewKeychain(keys[2])))
+
+	require.NoError(pst.Sign(ctx, secp256k1fx.NewKeychain(keys[0])))
+	_, err = pst.Finalize()
+	require.ErrorIs(err, ErrMissingSignatures)
+
+	require.NoError(pst.Merge(otherPST))
+	tx, err := pst.Finalize()
+	require.NoError(err)
+
+	expectedTx, err := SignUnsigned(ctx, New(secp256k1fx.NewKeychain(keys...), backend), utx)
+	require.NoError(err)
+	require.Equal(expectedTx.Bytes(), tx.Bytes())
+
+	parsedTx, err := builder.Parser.ParseTx(tx.Bytes())
+	require.NoError(err)
+	require.Equal(tx.ID(), parsedTx.ID())
+
+	// Txs must be merged with partially signed versions of the same tx.
+	differentUTX := *utx
+	differentUTX.Memo = []byte{1}
+	differentPST, err := NewPartiallySignedTx(ctx, backend, &differentUTX)
+	require.NoError(err)
+	err = pst.Merge(differentPST)
+	require.ErrorIs(err, ErrMismatchedTx)
+}
+
+func TestPartiallySignedTxMismatchedUTXO(t *testing.T) {
+	ctx := context.Background()
+	key, err := secp256k1.NewPrivateKey()
+	require.NoError(t, err)
+
+	owners := secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{key.Address()},
+	}
+	utxo := &avax.UTXO{
+		UTXOID: avax.UTXOID{TxID: ids.GenerateTestID()},
+		Asset:  avax.Asset{ID: ids.GenerateTestID()},
+		Out: &secp256k1fx.TransferOutput{
+			Amt:          100,
+			OutputOwners: owners,
+		},
+	}
+	backend := testBackend{
+		utxo.InputID(): utxo,
+	}
+	utx := &txs.BaseTx{BaseTx: avax.BaseTx{
+		NetworkID:    constants.UnitTestID,
+		BlockchainID: ids.GenerateTestID(),
+		Ins: []*avax.TransferableInput{{
+			UTXOID: utxo.UTXOID,
+			Asset:  utxo.Asset,
+			In: &secp256k1fx.TransferInput{
+				Amt: 100,
+				Input: secp256k1fx.Input{
+					SigIndices: []uint32{0},
+				},
+			},
+		}},
+	}}
+
+	tests := []struct {
+		name  string
+		utxos []*avax.UTXO
+	}{
+		{
+			name: "wrong asset",
+			utxos: []*avax.UTXO{{
+				UTXOID: utxo.UTXOID,
+				Asset:  avax.Asset{ID: ids.GenerateTestID()},
+				Out:    utxo.Out,
+			}},
+		},
+		{
+			name: "wrong amount",
+			utxos: []*avax.UTXO{{
+				UTXOID: utxo.UTXOID,
+				Asset:  utxo.Asset,
+				Out: &secp256k1fx.TransferOutput{
+					Amt:          1000,
+					OutputOwners: owners,
+				},
+			}},
+		},
+		{
+			name: "unconsumed UTXO",
+			utxos: []*avax.UTXO{
+				utxo,
+				{
+					UTXOID: avax.UTXOID{TxID: ids.GenerateTestID()},
+					Asset:  utxo.Asset,
+					Out:    utxo.Out,
+				},
+			},
+		},
+		{
+			name:  "duplicate UTXO",
+			utxos: []*avax.UTXO{utxo, utxo},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			pst, err := NewPartiallySignedTx(ctx, backend, utx)
+			require.NoError(err)
+			otherPST, err := NewPartiallySignedTx(ctx, backend, utx)
+			require.NoError(err)
+
+			otherPST.UTXOs = test.utxos
+			err = otherPST.Sign(ctx, secp256k1fx.NewKeychain(key))
+			require.ErrorIs(err, ErrMismatchedUTXO)
+			require.Equal(set.Of(key.Address()), otherPST.MissingSigners())
+
+			err = pst.Merge(otherPST)
+			require.ErrorIs(err, ErrMismatchedUTXO)
+		})
+	}
+}
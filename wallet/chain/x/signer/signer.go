@@ -43,12 +43,15 @@ func New(kc keychain.Keychain, backend Backend) Signer {
 }
 
 func (s *signer) Sign(ctx context.Context, tx *txs.Tx) error {
-	return tx.Unsigned.Visit(&visitor{
+	v := visitor{
 		kc:      s.kc,
 		backend: s.backend,
 		ctx:     ctx,
-		tx:      tx,
-	})
+	}
+	if err := tx.Unsigned.Visit(&v); err != nil {
+		return err
+	}
+	return sign(tx, v.txCreds, v.txSigners)
 }
 
 func SignUnsigned(
@@ -30,17 +30,21 @@ var (
 	ErrInvalidNumUTXOsInOp   = errors.New("invalid number of UTXOs in operation")
 	ErrUnknownCredentialType = errors.New("unknown credential type")
 	ErrUnknownOutputType     = errors.New("unknown output type")
+	ErrMismatchedUTXO        = errors.New("UTXO doesn't match the input consuming it")
 	ErrInvalidUTXOSigIndex   = errors.New("invalid UTXO signature index")
 
 	emptySig [secp256k1.SignatureLen]byte
 )
 
-// visitor handles signing transactions for the signer
+// visitor finds the signers of a transaction for the signer
 type visitor struct {
 	kc      keychain.Keychain
 	backend Backend
 	ctx     context.Context
-	tx      *txs.Tx
+
+	// outputs of visitor execution
+	txCreds   []verify.Verifiable
+	txSigners [][]keychain.Signer
 }
 
 func (s *visitor) BaseTx(tx *txs.BaseTx) error {
@@ -48,7 +52,7 @@ func (s *visitor) BaseTx(tx *txs.BaseTx) error {
 	if err != nil {
 		return err
 	}
-	return sign(s.tx, txCreds, txSigners)
+	return s.setSigners(txCreds, txSigners)
 }
 
 func (s *visitor) CreateAssetTx(tx *txs.CreateAssetTx) error {
@@ -56,7 +60,7 @@ func (s *visitor) CreateAssetTx(tx *txs.CreateAssetTx) error {
 	if err != nil {
 		return err
 	}
-	return sign(s.tx, txCreds, txSigners)
+	return s.setSigners(txCreds, txSigners)
 }
 
 func (s *visitor) OperationTx(tx *txs.OperationTx) error {
@@ -70,7 +74,7 @@ func (s *visitor) OperationTx(tx *txs.OperationTx) error {
 	}
 	txCreds = append(txCreds, txOpsCreds...)
 	txSigners = append(txSigners, txOpsSigners...)
-	return sign(s.tx, txCreds, txSigners)
+	return s.setSigners(txCreds, txSigners)
 }
 
 func (s *visitor) ImportTx(tx *txs.ImportTx) error {
@@ -84,7 +88,7 @@ func (s *visitor) ImportTx(tx *txs.ImportTx) error {
 	}
 	txCreds = append(txCreds, txImportCreds...)
 	txSigners = append(txSigners, txImportSigners...)
-	return sign(s.tx, txCreds, txSigners)
+	return s.setSigners(txCreds, txSigners)
 }
 
 func (s *visitor) ExportTx(tx *txs.ExportTx) error {
@@ -92,7 +96,13 @@ func (s *visitor) ExportTx(tx *txs.ExportTx) error {
 	if err != nil {
 		return err
 	}
-	return sign(s.tx, txCreds, txSigners)
+	return s.setSigners(txCreds, txSigners)
+}
+
+func (s *visitor) setSigners(txCreds []verify.Verifiable, txSigners [][]keychain.Signer) error {
+	s.txCreds = txCreds
+	s.txSigners = txSigners
+	return nil
 }
 
 func (s *visitor) getSigners(ctx context.Context, sourceChainID ids.ID, ins []*avax.TransferableInput) ([]verify.Verifiable, [][]keychain.Signer, error) {
@@ -123,6 +133,9 @@ func (s *visitor) getSigners(ctx context.Context, sourceChainID ids.ID, ins []*a
 		if !ok {
 			return nil, nil, ErrUnknownOutputType
 		}
+		if utxo.InputID() != utxoID || utxo.AssetID() != transferInput.AssetID() || out.Amt != input.Amt {
+			return nil, nil, fmt.Errorf("%w: input %d", ErrMismatchedUTXO, credIndex)
+		}
 
 		for sigIndex, addrIndex := range input.SigIndices {
 			if addrIndex >= uint32(len(out.Addrs)) {
@@ -183,6 +196,9 @@ func (s *visitor) getOpsSigners(ctx context.Context, sourceChainID ids.ID, ops [
 		if err != nil {
 			return nil, nil, err
 		}
+		if utxo.InputID() != utxoID || utxo.AssetID() != op.AssetID() {
+			return nil, nil, fmt.Errorf("%w: operation %d", ErrMismatchedUTXO, credIndex)
+		}
 
 		var addrs []ids.ShortID
 		switch out := utxo.Out.(type) {
@@ -5,6 +5,7 @@ package primary
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/ava-labs/coreth/ethclient"
@@ -84,6 +85,22 @@ func FetchState(
 		return nil, err
 	}
 
+	// Nodes that predate dynamic fees don't serve the fee APIs. In that case
+	// the static fees are used.
+	feeConfig, err := pClient.GetFeeConfig(ctx)
+	switch {
+	case errors.Is(err, rpc.ErrMethodNotFound):
+	case err != nil:
+		return nil, err
+	default:
+		_, gasPrice, _, err := pClient.GetFeeState(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pCTX.ComplexityWeights = feeConfig.Weights
+		pCTX.GasPrice = gasPrice
+	}
+
 	xCTX, err := x.NewContextFromClients(ctx, infoClient, xClient)
 	if err != nil {
 		return nil, err
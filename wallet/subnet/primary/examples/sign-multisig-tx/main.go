@@ -0,0 +1,155 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/ava-labs/avalanchego/genesis"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/utils/units"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/ava-labs/avalanchego/wallet/chain/p"
+	"github.com/ava-labs/avalanchego/wallet/chain/p/builder"
+	"github.com/ava-labs/avalanchego/wallet/chain/p/signer"
+	"github.com/ava-labs/avalanchego/wallet/subnet/primary"
+	"github.com/ava-labs/avalanchego/wallet/subnet/primary/common"
+)
+
+func main() {
+	key := genesis.EWOQKey
+	uri := primary.LocalAPIURI
+	kc := secp256k1fx.NewKeychain(key)
+
+	ctx := context.Background()
+
+	// Generate the keys of the two parties that jointly own the multisig. In
+	// practice, each key would be held by a different party on a different
+	// machine.
+	cosignerKeys := make([]*secp256k1.PrivateKey, 2)
+	for i := range cosignerKeys {
+		var err error
+		cosignerKeys[i], err = secp256k1.NewPrivateKey()
+		if err != nil {
+			log.Fatalf("failed to generate key: %s\n", err)
+		}
+	}
+	multisigAddrs := []ids.ShortID{
+		cosignerKeys[0].Address(),
+		cosignerKeys[1].Address(),
+	}
+	utils.Sort(multisigAddrs)
+	multisigOwner := secp256k1fx.OutputOwners{
+		Threshold: 2,
+		Addrs:     multisigAddrs,
+	}
+
+	// MakeWallet fetches the available UTXOs owned by [kc] on the network that
+	// [uri] is hosting.
+	walletSyncStartTime := time.Now()
+	wallet, err := primary.MakeWallet(ctx, &primary.WalletConfig{
+		URI:          uri,
+		AVAXKeychain: kc,
+		EthKeychain:  kc,
+	})
+	if err != nil {
+		log.Fatalf("failed to initialize wallet: %s\n", err)
+	}
+	log.Printf("synced wallet in %s\n", time.Since(walletSyncStartTime))
+
+	// Get the P-chain wallet
+	pWallet := wallet.P()
+	avaxAssetID := pWallet.Builder().Context().AVAXAssetID
+
+	// Fund the multisig.
+	fundStartTime := time.Now()
+	fundTx, err := pWallet.IssueBaseTx([]*avax.TransferableOutput{{
+		Asset: avax.Asset{ID: avaxAssetID},
+		Out: &secp256k1fx.TransferOutput{
+			Amt:          units.Avax,
+			OutputOwners: multisigOwner,
+		},
+	}})
+	if err != nil {
+		log.Fatalf("failed to issue funding transaction: %s\n", err)
+	}
+	log.Printf("funded multisig with %s in %s\n", fundTx.ID(), time.Since(fundStartTime))
+
+	// Build a transaction spending the multisig UTXO. Building the transaction
+	// only requires the addresses of the multisig, not its keys.
+	multisigAddrSet := set.Of(multisigAddrs...)
+	state, err := primary.FetchState(ctx, uri, multisigAddrSet)
+	if err != nil {
+		log.Fatalf("failed to fetch multisig state: %s\n", err)
+	}
+	multisigUTXOs := common.NewChainUTXOs(constants.PlatformChainID, state.UTXOs)
+	multisigBackend := p.NewBackend(state.PCTX, multisigUTXOs, nil)
+	multisigBuilder := builder.New(multisigAddrSet, state.PCTX, multisigBackend)
+
+	utx, err := multisigBuilder.NewBaseTx(
+		[]*avax.TransferableOutput{{
+			Asset: avax.Asset{ID: avaxAssetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: units.Avax / 2,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs: []ids.ShortID{
+						key.Address(),
+					},
+				},
+			},
+		}},
+		common.WithChangeOwner(&multisigOwner),
+	)
+	if err != nil {
+		log.Fatalf("failed to build transaction: %s\n", err)
+	}
+
+	pst, err := signer.NewPartiallySignedTx(ctx, multisigBackend, utx)
+	if err != nil {
+		log.Fatalf("failed to create partially signed transaction: %s\n", err)
+	}
+	pstBytes, err := pst.Bytes()
+	if err != nil {
+		log.Fatalf("failed to serialize partially signed transaction: %s\n", err)
+	}
+
+	// Each party parses the partially signed transaction, inspects it, and
+	// signs it with their own key. The signed copies are sent back to be
+	// merged.
+	for _, cosignerKey := range cosignerKeys {
+		cosignerPST, err := signer.ParsePartiallySignedTx(pstBytes)
+		if err != nil {
+			log.Fatalf("failed to parse partially signed transaction: %s\n", err)
+		}
+		if err := cosignerPST.Sign(ctx, secp256k1fx.NewKeychain(cosignerKey)); err != nil {
+			log.Fatalf("failed to sign partially signed transaction: %s\n", err)
+		}
+		if err := pst.Merge(cosignerPST); err != nil {
+			log.Fatalf("failed to merge partially signed transaction: %s\n", err)
+		}
+		log.Printf("merged signature of %s, missing signatures from %s\n",
+			cosignerKey.Address(),
+			pst.MissingSigners().List(),
+		)
+	}
+
+	tx, err := pst.Finalize()
+	if err != nil {
+		log.Fatalf("failed to finalize transaction: %s\n", err)
+	}
+
+	issueStartTime := time.Now()
+	if err := pWallet.IssueTx(tx); err != nil {
+		log.Fatalf("failed to issue multisig transaction: %s\n", err)
+	}
+	log.Printf("issued multisig transaction %s in %s\n", tx.ID(), time.Since(issueStartTime))
+}
@@ -0,0 +1,108 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package archivedb
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/ava-labs/avalanchego/database"
+)
+
+var _ database.Iterator = (*iterator)(nil)
+
+// NewIteratorWithStartAndPrefix returns an iterator over the keys of length
+// [keyLen] that are >= [start] and have the prefix [prefix]. Each key is
+// reported with its value at the height of the reader. Keys that did not exist
+// at the height of the reader are skipped.
+//
+// Because keys are stored prefixed by their length, only keys of a single
+// length can be iterated over at once.
+func (r *Reader) NewIteratorWithStartAndPrefix(start, prefix []byte, keyLen int) database.Iterator {
+	return &iterator{
+		it: r.db.db.NewIteratorWithStartAndPrefix(
+			newDBKeyPrefix(start, keyLen),
+			newDBKeyPrefix(prefix, keyLen),
+		),
+		height: r.height,
+	}
+}
+
+type iterator struct {
+	it     database.Iterator
+	height uint64
+
+	err   error
+	key   []byte
+	value []byte
+}
+
+func (i *iterator) Next() bool {
+	if i.err != nil {
+		return false
+	}
+
+	for i.it.Next() {
+		dbKey := i.it.Key()
+		// The height metadata key shares its prefix with the single byte
+		// user keys.
+		if bytes.Equal(dbKey, heightKey) {
+			continue
+		}
+
+		key, height, err := parseDBKeyFromUser(dbKey)
+		if err != nil {
+			i.err = err
+			break
+		}
+
+		// Entries of a key are sorted by descending height, so all of the
+		// entries after the first one at or below the requested height can
+		// be skipped.
+		if height > i.height || bytes.Equal(key, i.key) {
+			continue
+		}
+
+		// Keys may be reused by the underlying iterator, so they are copied.
+		i.key = bytes.Clone(key)
+		value, exists := parseDBValue(i.it.Value())
+		if !exists {
+			continue
+		}
+		i.value = bytes.Clone(value)
+		return true
+	}
+
+	i.key = nil
+	i.value = nil
+	return false
+}
+
+func (i *iterator) Error() error {
+	if i.err != nil {
+		return i.err
+	}
+	return i.it.Error()
+}
+
+func (i *iterator) Key() []byte {
+	return i.key
+}
+
+func (i *iterator) Value() []byte {
+	return i.value
+}
+
+func (i *iterator) Release() {
+	i.it.Release()
+}
+
+// newDBKeyPrefix returns the database prefix of all the keys of length
+// [keyLen] that start with [prefix].
+func newDBKeyPrefix(prefix []byte, keyLen int) []byte {
+	dbPrefix := make([]byte, binary.MaxVarintLen64+len(prefix))
+	offset := binary.PutUvarint(dbPrefix, uint64(keyLen))
+	offset += copy(dbPrefix[offset:], prefix)
+	return dbPrefix[:offset]
+}
@@ -0,0 +1,125 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package archivedb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database/memdb"
+)
+
+func TestIterator(t *testing.T) {
+	db := New(memdb.New())
+
+	batch := db.NewBatch(1)
+	require.NoError(t, batch.Put([]byte("a1"), []byte("a1@1")))
+	require.NoError(t, batch.Put([]byte("a2"), []byte("a2@1")))
+	require.NoError(t, batch.Put([]byte("b1"), []byte("b1@1")))
+	require.NoError(t, batch.Put([]byte("a"), []byte("a@1")))
+	require.NoError(t, batch.Write())
+
+	batch = db.NewBatch(2)
+	require.NoError(t, batch.Put([]byte("a1"), []byte("a1@2")))
+	require.NoError(t, batch.Delete([]byte("a2")))
+	require.NoError(t, batch.Put([]byte("a3"), []byte("a3@2")))
+	require.NoError(t, batch.Write())
+
+	batch = db.NewBatch(3)
+	require.NoError(t, batch.Put([]byte("a2"), []byte("a2@3")))
+	require.NoError(t, batch.Write())
+
+	tests := []struct {
+		name     string
+		height   uint64
+		start    []byte
+		prefix   []byte
+		keyLen   int
+		expected map[string]string
+	}{
+		{
+			name:     "before first height",
+			height:   0,
+			prefix:   []byte("a"),
+			keyLen:   2,
+			expected: map[string]string{},
+		},
+		{
+			name:   "first height",
+			height: 1,
+			prefix: []byte("a"),
+			keyLen: 2,
+			expected: map[string]string{
+				"a1": "a1@1",
+				"a2": "a2@1",
+			},
+		},
+		{
+			name:   "deleted key is skipped",
+			height: 2,
+			prefix: []byte("a"),
+			keyLen: 2,
+			expected: map[string]string{
+				"a1": "a1@2",
+				"a3": "a3@2",
+			},
+		},
+		{
+			name:   "deleted key is re-added",
+			height: 3,
+			prefix: []byte("a"),
+			keyLen: 2,
+			expected: map[string]string{
+				"a1": "a1@2",
+				"a2": "a2@3",
+				"a3": "a3@2",
+			},
+		},
+		{
+			name:   "future height",
+			height: 100,
+			start:  []byte("a2"),
+			prefix: []byte("a"),
+			keyLen: 2,
+			expected: map[string]string{
+				"a2": "a2@3",
+				"a3": "a3@2",
+			},
+		},
+		{
+			name:   "all keys of length",
+			height: 1,
+			keyLen: 2,
+			expected: map[string]string{
+				"a1": "a1@1",
+				"a2": "a2@1",
+				"b1": "b1@1",
+			},
+		},
+		{
+			name:   "single byte keys",
+			height: 3,
+			keyLen: 1,
+			expected: map[string]string{
+				"a": "a@1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+
+			it := db.Open(tt.height).NewIteratorWithStartAndPrefix(tt.start, tt.prefix, tt.keyLen)
+			defer it.Release()
+
+			got := map[string]string{}
+			for it.Next() {
+				got[string(it.Key())] = string(it.Value())
+			}
+			require.NoError(it.Error())
+			require.Equal(tt.expected, got)
+		})
+	}
+}
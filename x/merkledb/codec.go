@@ -11,6 +11,7 @@ import (
 	"math"
 	"math/bits"
 	"slices"
+	"time"
 
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils/maybe"
@@ -122,6 +123,31 @@ func encodeKey(key Key) []byte {
 	return w.b
 }
 
+// encodeChangeSummary returns the byte representation of [changes], which were
+// recorded at [timestamp].
+func encodeChangeSummary(timestamp time.Time, changes *changeSummary) []byte {
+	w := codecWriter{}
+	w.Uvarint(uint64(timestamp.UnixNano()))
+	w.ID(changes.rootID)
+	w.Node(changes.rootChange.before.Value())
+	w.Node(changes.rootChange.after.Value())
+
+	w.Uvarint(uint64(len(changes.nodes)))
+	for key, nodeChange := range changes.nodes {
+		w.Key(key)
+		w.Node(nodeChange.before)
+		w.Node(nodeChange.after)
+	}
+
+	w.Uvarint(uint64(len(changes.values)))
+	for key, valueChange := range changes.values {
+		w.Key(key)
+		w.MaybeBytes(valueChange.before)
+		w.MaybeBytes(valueChange.after)
+	}
+	return w.b
+}
+
 type codecWriter struct {
 	b []byte
 }
@@ -160,6 +186,16 @@ func (w *codecWriter) Key(v Key) {
 	w.b = append(w.b, v.Bytes()...)
 }
 
+// Node writes [v] along with its key. [v] may be nil.
+func (w *codecWriter) Node(v *node) {
+	hasNode := v != nil
+	w.Bool(hasNode)
+	if hasNode {
+		w.Key(v.key)
+		w.Bytes(v.bytes())
+	}
+}
+
 // Assumes [n] is non-nil.
 func decodeDBNode(b []byte, n *dbNode) error {
 	r := codecReader{
@@ -232,6 +268,97 @@ func decodeKey(b []byte) (Key, error) {
 	return key, nil
 }
 
+// decodeChangeSummary parses the changes encoded by [encodeChangeSummary].
+// Returns the time the changes were recorded at.
+func decodeChangeSummary(hasher Hasher, b []byte) (time.Time, *changeSummary, error) {
+	r := codecReader{
+		b:    b,
+		copy: true,
+	}
+
+	timestamp, err := r.Uvarint()
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+	if timestamp > math.MaxInt64 {
+		return time.Time{}, nil, errIntOverflow
+	}
+
+	changes := &changeSummary{}
+	changes.rootID, err = r.ID()
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+	rootBefore, err := r.Node(hasher)
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+	rootAfter, err := r.Node(hasher)
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+	if rootBefore != nil {
+		changes.rootChange.before = maybe.Some(rootBefore)
+	}
+	if rootAfter != nil {
+		changes.rootChange.after = maybe.Some(rootAfter)
+	}
+
+	numNodes, err := r.Uvarint()
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+	// Bound the preallocation by the remaining bytes to avoid large
+	// allocations.
+	changes.nodes = make(map[Key]*change[*node], min(numNodes, uint64(len(r.b))))
+	for i := uint64(0); i < numNodes; i++ {
+		key, err := r.Key()
+		if err != nil {
+			return time.Time{}, nil, err
+		}
+		before, err := r.Node(hasher)
+		if err != nil {
+			return time.Time{}, nil, err
+		}
+		after, err := r.Node(hasher)
+		if err != nil {
+			return time.Time{}, nil, err
+		}
+		changes.nodes[key] = &change[*node]{
+			before: before,
+			after:  after,
+		}
+	}
+
+	numValues, err := r.Uvarint()
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+	changes.values = make(map[Key]*change[maybe.Maybe[[]byte]], min(numValues, uint64(len(r.b))))
+	for i := uint64(0); i < numValues; i++ {
+		key, err := r.Key()
+		if err != nil {
+			return time.Time{}, nil, err
+		}
+		before, err := r.MaybeBytes()
+		if err != nil {
+			return time.Time{}, nil, err
+		}
+		after, err := r.MaybeBytes()
+		if err != nil {
+			return time.Time{}, nil, err
+		}
+		changes.values[key] = &change[maybe.Maybe[[]byte]]{
+			before: before,
+			after:  after,
+		}
+	}
+	if len(r.b) != 0 {
+		return time.Time{}, nil, errExtraSpace
+	}
+	return time.Unix(0, int64(timestamp)), changes, nil
+}
+
 type codecReader struct {
 	b []byte
 	// copy is used to flag to the reader if it is required to copy references
@@ -339,3 +466,21 @@ func (r *codecReader) Key() (Key, error) {
 	r.b = r.b[byteLen:]
 	return result, nil
 }
+
+// Node reads a node written by [codecWriter.Node]. Returns nil if no node was
+// written.
+func (r *codecReader) Node(hasher Hasher) (*node, error) {
+	if hasNode, err := r.Bool(); err != nil || !hasNode {
+		return nil, err
+	}
+
+	key, err := r.Key()
+	if err != nil {
+		return nil, err
+	}
+	nodeBytes, err := r.Bytes()
+	if err != nil {
+		return nil, err
+	}
+	return parseNode(hasher, key, nodeBytes)
+}
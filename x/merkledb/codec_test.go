@@ -10,6 +10,7 @@ import (
 	"math/rand"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -669,3 +670,52 @@ func Benchmark_EncodeUint(b *testing.B) {
 		})
 	}
 }
+
+func TestChangeSummaryRoundTrip(t *testing.T) {
+	require := require.New(t)
+
+	root := newNode(ToKey([]byte{1}))
+	root.setValue(DefaultHasher, maybe.Some([]byte("root")))
+	child := newNode(ToKey([]byte{1, 2}))
+	child.setValue(DefaultHasher, maybe.Some([]byte("child")))
+	root.addChild(child, 8)
+
+	expectedTimestamp := time.Unix(1, 2)
+	expected := &changeSummary{
+		rootID: ids.GenerateTestID(),
+		rootChange: change[maybe.Maybe[*node]]{
+			after: maybe.Some(root),
+		},
+		nodes: map[Key]*change[*node]{
+			root.key: {
+				after: root,
+			},
+			child.key: {
+				before: newNode(child.key),
+				after:  child,
+			},
+		},
+		values: map[Key]*change[maybe.Maybe[[]byte]]{
+			root.key: {
+				after: maybe.Some([]byte("root")),
+			},
+			child.key: {
+				before: maybe.Some([]byte{}),
+				after:  maybe.Some([]byte("child")),
+			},
+		},
+	}
+	expected.nodes[child.key].before.setValueDigest(DefaultHasher)
+
+	b := encodeChangeSummary(expectedTimestamp, expected)
+	timestamp, changes, err := decodeChangeSummary(DefaultHasher, b)
+	require.NoError(err)
+	require.Equal(expectedTimestamp, timestamp)
+	require.Equal(expected, changes)
+
+	_, _, err = decodeChangeSummary(DefaultHasher, b[:len(b)-1])
+	require.ErrorIs(err, io.ErrUnexpectedEOF)
+
+	_, _, err = decodeChangeSummary(DefaultHasher, append(b, 0))
+	require.ErrorIs(err, errExtraSpace)
+}
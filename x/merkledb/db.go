@@ -11,15 +11,18 @@ import (
 	"runtime"
 	"slices"
 	"sync"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
 	"golang.org/x/exp/maps"
 
 	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/trace"
 	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/utils/maybe"
 	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/utils/units"
@@ -38,11 +41,13 @@ const (
 )
 
 var (
-	_ MerkleDB = (*merkleDB)(nil)
+	_ MerkleDB      = (*merkleDB)(nil)
+	_ HistoryPruner = (*merkleDB)(nil)
 
 	metadataPrefix         = []byte{0}
 	valueNodePrefix        = []byte{1}
 	intermediateNodePrefix = []byte{2}
+	historyPrefix          = []byte{3}
 
 	// cleanShutdownKey is used to flag that the database did (or did not)
 	// previously shutdown correctly.
@@ -129,6 +134,27 @@ type RangeProofer interface {
 	CommitRangeProof(ctx context.Context, start, end maybe.Maybe[[]byte], proof *RangeProof) error
 }
 
+type HistoricalViewer interface {
+	// NewViewAtRoot returns a read-only view of the trie as it was when its
+	// root was [rootID]. The view supports reads, iteration and proofs, and
+	// new views can be created on top of it, but it can't be committed.
+	// Committing it returns ErrCommitHistoricalView.
+	// Returns ErrInsufficientHistory if [rootID] is no longer in the history.
+	// The returned view is invalidated once changes are committed to the
+	// database.
+	NewViewAtRoot(ctx context.Context, rootID ids.ID) (Trie, error)
+}
+
+// HistoryPruner is implemented by the databases returned by [New]. It isn't
+// part of [MerkleDB], so callers that need it must type assert.
+type HistoryPruner interface {
+	// PruneHistory removes the oldest changes from the history until at most
+	// [maxLength] changes remain, along with any changes that are no longer
+	// retained by the configured history limits.
+	// The change resulting in the current root is never removed.
+	PruneHistory(ctx context.Context, maxLength int) error
+}
+
 type Clearer interface {
 	// Deletes all key/value pairs from the database
 	// and clears the change history.
@@ -156,6 +182,7 @@ type MerkleDB interface {
 	ProofGetter
 	ChangeProofer
 	RangeProofer
+	HistoricalViewer
 	Prefetcher
 }
 
@@ -177,6 +204,20 @@ type Config struct {
 	// The number of changes to the database that we store in memory in order to
 	// serve change proofs.
 	HistoryLength uint
+	// If true, the changes in the history are also written to disk so that
+	// change proofs can be served after a restart.
+	PersistHistory bool
+	// The maximum age of the changes in the history.
+	// If 0, changes aren't removed from the history based on their age.
+	HistoryMaxAge time.Duration
+	// The maximum number of bytes used by the encoded changes in the history.
+	// If 0, changes aren't removed from the history based on their size.
+	HistoryMaxBytes uint
+	// How often changes older than [HistoryMaxAge] are removed from the
+	// history. Otherwise, old changes are only removed when new changes are
+	// committed or when PruneHistory is called.
+	// If 0, or if [HistoryMaxAge] is 0, the history isn't pruned periodically.
+	HistoryPruneFrequency time.Duration
 	// The number of bytes used to cache nodes with values.
 	ValueNodeCacheSize uint
 	// The number of bytes used to cache nodes without values.
@@ -192,6 +233,10 @@ type Config struct {
 	Reg        prometheus.Registerer
 	TraceLevel TraceLevel
 	Tracer     trace.Tracer
+	// Log reports errors encountered while pruning the history in the
+	// background.
+	// If nil, nothing is logged.
+	Log logging.Logger
 }
 
 // merkleDB can only be edited by committing changes from a view.
@@ -215,11 +260,16 @@ type merkleDB struct {
 	// Stores change lists. Used to serve change proofs and construct
 	// historical views of the trie.
 	history *trieHistory
+	// Persists [history]. Nil if the history isn't persisted.
+	historyDB *historyDB
 
 	// True iff the db has been closed.
 	closed bool
+	// Closed when the db is closed to stop periodically pruning the history.
+	closing chan struct{}
 
 	metrics metrics
+	log     logging.Logger
 
 	debugTracer trace.Tracer
 	infoTracer  trace.Tracer
@@ -276,9 +326,20 @@ func newDatabase(
 	// reduce memory allocations.
 	bufferPool := utils.NewBytesPool()
 
+	log := config.Log
+	if log == nil {
+		log = logging.NoLog{}
+	}
+
+	history := newTrieHistory(int(config.HistoryLength))
+	history.maxHistoryAge = config.HistoryMaxAge
+	history.maxHistoryBytes = int(config.HistoryMaxBytes)
+
 	trieDB := &merkleDB{
 		metrics: metrics,
+		log:     log,
 		baseDB:  db,
+		closing: make(chan struct{}),
 		intermediateNodeDB: newIntermediateNodeDB(
 			db,
 			bufferPool,
@@ -296,7 +357,7 @@ func newDatabase(
 			int(config.ValueNodeCacheSize),
 			hasher,
 		),
-		history:          newTrieHistory(int(config.HistoryLength)),
+		history:          history,
 		debugTracer:      getTracerIfEnabled(config.TraceLevel, DebugTrace, config.Tracer),
 		infoTracer:       getTracerIfEnabled(config.TraceLevel, InfoTrace, config.Tracer),
 		childViews:       make([]*view, 0, defaultPreallocationSize),
@@ -325,19 +386,52 @@ func newDatabase(
 		}
 	}
 
-	// add current root to history (has no changes)
-	trieDB.history.record(&changeSummary{
-		rootID: trieDB.rootID,
-		rootChange: change[maybe.Maybe[*node]]{
-			after: trieDB.root,
-		},
-		values: map[Key]*change[maybe.Maybe[[]byte]]{},
-		nodes:  map[Key]*change[*node]{},
-	})
+	// The history is only persisted after the root has been initialized, so
+	// that the changes made by [rebuild] aren't persisted.
+	historyDB := newHistoryDB(db, hasher)
+	loadedHistory := false
+	if config.PersistHistory {
+		loadedHistory, err = historyDB.Load(trieDB.history, trieDB.rootID)
+		if err != nil {
+			return nil, err
+		}
+		trieDB.historyDB = historyDB
+	} else if err := historyDB.Clear(); err != nil {
+		// Remove any history persisted by a previous run, as it won't be kept
+		// up to date.
+		return nil, err
+	}
+
+	batch := trieDB.baseDB.NewBatch()
+	if loadedHistory {
+		trieDB.metrics.HistoryChanged(trieDB.history.history.Len(), trieDB.history.totalBytes, 0)
+	} else {
+		// add current root to history (has no changes)
+		err := trieDB.recordHistory(batch, &changeSummary{
+			rootID: trieDB.rootID,
+			rootChange: change[maybe.Maybe[*node]]{
+				after: trieDB.root,
+			},
+			values: map[Key]*change[maybe.Maybe[[]byte]]{},
+			nodes:  map[Key]*change[*node]{},
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	// mark that the db has not yet been cleanly closed
-	err = trieDB.baseDB.Put(cleanShutdownKey, didNotHaveCleanShutdown)
-	return trieDB, err
+	if err := batch.Put(cleanShutdownKey, didNotHaveCleanShutdown); err != nil {
+		return nil, err
+	}
+	if err := batch.Write(); err != nil {
+		return nil, err
+	}
+
+	if config.HistoryMaxAge > 0 && config.HistoryPruneFrequency > 0 {
+		go trieDB.pruneHistoryPeriodically(config.HistoryPruneFrequency)
+	}
+	return trieDB, nil
 }
 
 // Deletes every intermediate node and rebuilds them by re-adding every key/value.
@@ -482,6 +576,7 @@ func (db *merkleDB) Close() error {
 	db.invalidateChildrenExcept(nil)
 
 	db.closed = true
+	close(db.closing)
 	db.valueNodeDB.Close()
 	// Flush intermediary nodes to disk.
 	if err := db.intermediateNodeDB.Flush(); err != nil {
@@ -704,6 +799,47 @@ func (db *merkleDB) GetRangeProofAtRoot(
 	return getRangeProof(historicalTrie, start, end, maxLength)
 }
 
+func (db *merkleDB) NewViewAtRoot(ctx context.Context, rootID ids.ID) (Trie, error) {
+	// ensure the db doesn't change while creating the view
+	db.commitLock.RLock()
+	defer db.commitLock.RUnlock()
+
+	_, span := db.infoTracer.Start(ctx, "MerkleDB.NewViewAtRoot")
+	defer span.End()
+
+	if db.closed {
+		return nil, database.ErrClosed
+	}
+
+	var (
+		historicalView *view
+		err            error
+	)
+	if rootID == db.getMerkleRoot() {
+		historicalView, err = newView(db, db, ViewChanges{})
+	} else {
+		var changeHistory *changeSummary
+		changeHistory, err = db.history.getChangesToGetToRoot(rootID, maybe.Nothing[[]byte](), maybe.Nothing[[]byte]())
+		if err != nil {
+			return nil, err
+		}
+		changeHistory.rootID = rootID
+		historicalView, err = newViewWithChanges(db, changeHistory)
+	}
+	if err != nil {
+		return nil, err
+	}
+	historicalView.historical = true
+
+	// Track the view so that it's invalidated when the next changes are
+	// committed, as its changes are relative to the current state of the db.
+	db.lock.Lock()
+	defer db.lock.Unlock()
+
+	db.childViews = append(db.childViews, historicalView)
+	return historicalView, nil
+}
+
 func (db *merkleDB) GetChangeProof(
 	ctx context.Context,
 	startRootID ids.ID,
@@ -962,6 +1098,8 @@ func (db *merkleDB) commitView(ctx context.Context, trieToCommit *view) error {
 		return ErrInvalid
 	case trieToCommit.committed:
 		return ErrCommitted
+	case trieToCommit.historical:
+		return ErrCommitHistoricalView
 	case trieToCommit.db != trieToCommit.getParentTrie():
 		return ErrParentNotDatabase
 	}
@@ -988,11 +1126,15 @@ func (db *merkleDB) commitView(ctx context.Context, trieToCommit *view) error {
 		return err
 	}
 
-	if err := db.commitValueChanges(ctx, valueNodeBatch); err != nil {
+	// Persisted changes are written atomically with the value nodes, so that
+	// the persisted history always results in the persisted values.
+	if err := db.recordHistory(valueNodeBatch, changes); err != nil {
 		return err
 	}
 
-	db.history.record(changes)
+	if err := db.commitValueChanges(ctx, valueNodeBatch); err != nil {
+		return err
+	}
 
 	// Update root in database.
 	db.root = changes.rootChange.after
@@ -1365,13 +1507,97 @@ func (db *merkleDB) Clear() error {
 	db.rootID = ids.Empty
 
 	// Clear history
-	db.history = newTrieHistory(db.history.maxHistoryLen)
-	db.history.record(&changeSummary{
+	db.history.reset()
+	if db.historyDB != nil {
+		if err := db.historyDB.Clear(); err != nil {
+			return err
+		}
+	}
+	batch := db.baseDB.NewBatch()
+	err := db.recordHistory(batch, &changeSummary{
 		rootID: db.rootID,
 		values: map[Key]*change[maybe.Maybe[[]byte]]{},
 		nodes:  map[Key]*change[*node]{},
 	})
-	return nil
+	if err != nil {
+		return err
+	}
+	return batch.Write()
+}
+
+// pruneHistoryPeriodically removes changes that are older than the maximum
+// age of the history every [frequency] until the db is closed.
+func (db *merkleDB) pruneHistoryPeriodically(frequency time.Duration) {
+	ticker := time.NewTicker(frequency)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			// The changes are removed from memory even if removing them
+			// from disk fails. Any changes left on disk are removed the next
+			// time the history is loaded.
+			if err := db.PruneHistory(context.Background(), db.history.maxHistoryLen); err != nil {
+				db.log.Warn("failed to prune history",
+					zap.Error(err),
+				)
+			}
+		case <-db.closing:
+			return
+		}
+	}
+}
+
+func (db *merkleDB) PruneHistory(ctx context.Context, maxLength int) error {
+	// ensure the history isn't modified or read while it's being pruned
+	db.commitLock.Lock()
+	defer db.commitLock.Unlock()
+
+	_, span := db.infoTracer.Start(ctx, "MerkleDB.PruneHistory")
+	defer span.End()
+
+	if db.closed {
+		return database.ErrClosed
+	}
+
+	removed := db.history.prune(maxLength)
+	db.metrics.HistoryChanged(db.history.history.Len(), db.history.totalBytes, len(removed))
+	if db.historyDB == nil || len(removed) == 0 {
+		return nil
+	}
+
+	batch := db.baseDB.NewBatch()
+	if err := db.historyDB.Delete(batch, removed); err != nil {
+		return err
+	}
+	return batch.Write()
+}
+
+// recordHistory adds [changes] to the history. If the history is persisted,
+// [changes] are written to [batch] along with the removal of any changes that
+// are no longer retained.
+//
+// Assumes [db.lock] is held or the db isn't accessible by other goroutines.
+func (db *merkleDB) recordHistory(batch database.KeyValueWriterDeleter, changes *changeSummary) error {
+	var (
+		timestamp    = db.history.clock.Time()
+		insertNumber = db.history.nextInsertNumber
+		changesBytes []byte
+	)
+	if db.historyDB != nil || db.history.maxHistoryBytes > 0 {
+		changesBytes = encodeChangeSummary(timestamp, changes)
+	}
+
+	removed := db.history.recordAt(changes, timestamp, len(changesBytes))
+	db.metrics.HistoryChanged(db.history.history.Len(), db.history.totalBytes, len(removed))
+	if db.historyDB == nil || db.history.maxHistoryLen == 0 {
+		return nil
+	}
+
+	if err := db.historyDB.Put(batch, insertNumber, changesBytes); err != nil {
+		return err
+	}
+	return db.historyDB.Delete(batch, removed)
 }
 
 func (db *merkleDB) getTokenSize() int {
@@ -7,12 +7,14 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/utils/buffer"
 	"github.com/ava-labs/avalanchego/utils/maybe"
 	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/utils/timer/mockable"
 )
 
 var (
@@ -33,8 +35,21 @@ type trieHistory struct {
 	// Contains at most [maxHistoryLen] values.
 	history buffer.Deque[*changeSummaryAndInsertNumber]
 
+	// Maximum age of the changes in [history].
+	// If 0, changes aren't removed based on their age.
+	maxHistoryAge time.Duration
+
+	// Maximum total size, in bytes, of the changes in [history].
+	// If 0, changes aren't removed based on their size.
+	maxHistoryBytes int
+
+	// Sum of the sizes of the changes in [history].
+	totalBytes int
+
 	// Each change is tagged with this monotonic increasing number.
 	nextInsertNumber uint64
+
+	clock mockable.Clock
 }
 
 // Tracks the beginning and ending state of a value.
@@ -50,6 +65,12 @@ type changeSummaryAndInsertNumber struct {
 	// Another changeSummaryAndInsertNumber with a greater
 	// [insertNumber] means that change was after this one.
 	insertNumber uint64
+	// The time the change was recorded.
+	timestamp time.Time
+	// The encoded size of the change in bytes.
+	// Only populated if the size is needed to enforce the size limit of the
+	// history or if the history is persisted.
+	size int
 }
 
 // Tracks all the node and value changes that resulted in the rootID.
@@ -286,34 +307,79 @@ func (th *trieHistory) getChangesToGetToRoot(rootID ids.ID, start maybe.Maybe[[]
 	return combinedChanges, nil
 }
 
-// record the provided set of changes in the history
-func (th *trieHistory) record(changes *changeSummary) {
+// record the provided set of changes in the history.
+// Returns the changes that were removed from the history to make room for
+// [changes].
+func (th *trieHistory) record(changes *changeSummary) []*changeSummaryAndInsertNumber {
+	return th.recordAt(changes, th.clock.Time(), 0)
+}
+
+// recordAt records the provided set of changes in the history as having been
+// made at [timestamp] and having an encoded size of [size] bytes.
+// Returns the changes that were removed from the history to make room for
+// [changes].
+func (th *trieHistory) recordAt(changes *changeSummary, timestamp time.Time, size int) []*changeSummaryAndInsertNumber {
 	// we aren't recording history so noop
 	if th.maxHistoryLen == 0 {
-		return
-	}
-
-	if th.history.Len() == th.maxHistoryLen {
-		// This change causes us to go over our lookback limit.
-		// Remove the oldest set of changes.
-		oldestEntry, _ := th.history.PopLeft()
-
-		latestChange := th.lastChanges[oldestEntry.rootID]
-		if latestChange == oldestEntry {
-			// The removed change was the most recent resulting in this root ID.
-			delete(th.lastChanges, oldestEntry.rootID)
-		}
+		return nil
 	}
 
 	changesAndIndex := &changeSummaryAndInsertNumber{
 		changeSummary: changes,
 		insertNumber:  th.nextInsertNumber,
+		timestamp:     timestamp,
+		size:          size,
 	}
 	th.nextInsertNumber++
 
 	// Add [changes] to the sorted change list.
 	_ = th.history.PushRight(changesAndIndex)
+	th.totalBytes += size
 
 	// Mark that this is the most recent change resulting in [changes.rootID].
 	th.lastChanges[changes.rootID] = changesAndIndex
+
+	// This change may cause us to go over our lookback limits.
+	return th.prune(th.maxHistoryLen)
+}
+
+// prune removes the oldest changes from the history until at most [maxLen]
+// changes remain and the remaining changes are within the age and size limits
+// of the history.
+// The most recent change is never removed, as it results in the current root.
+// Returns the removed changes.
+func (th *trieHistory) prune(maxLen int) []*changeSummaryAndInsertNumber {
+	var (
+		removed      []*changeSummaryAndInsertNumber
+		minTimestamp = th.clock.Time().Add(-th.maxHistoryAge)
+	)
+	for th.history.Len() > 1 {
+		oldestEntry, _ := th.history.PeekLeft()
+		var (
+			tooMany  = th.history.Len() > maxLen
+			tooOld   = th.maxHistoryAge > 0 && oldestEntry.timestamp.Before(minTimestamp)
+			tooLarge = th.maxHistoryBytes > 0 && th.totalBytes > th.maxHistoryBytes
+		)
+		if !tooMany && !tooOld && !tooLarge {
+			break
+		}
+
+		_, _ = th.history.PopLeft()
+		th.totalBytes -= oldestEntry.size
+
+		latestChange := th.lastChanges[oldestEntry.rootID]
+		if latestChange == oldestEntry {
+			// The removed change was the most recent resulting in this root ID.
+			delete(th.lastChanges, oldestEntry.rootID)
+		}
+		removed = append(removed, oldestEntry)
+	}
+	return removed
+}
+
+// reset removes all the changes from the history while keeping its limits.
+func (th *trieHistory) reset() {
+	th.history = buffer.NewUnboundedDeque[*changeSummaryAndInsertNumber](th.maxHistoryLen)
+	th.lastChanges = make(map[ids.ID]*changeSummaryAndInsertNumber)
+	th.totalBytes = 0
 }
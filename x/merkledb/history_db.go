@@ -0,0 +1,123 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package merkledb
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+var errInvalidHistoryKeyLen = errors.New("invalid history key length")
+
+// historyDB persists the change history of the trie so that change proofs can
+// still be served after a restart.
+type historyDB struct {
+	// The underlying storage.
+	// Keys written to [baseDB] are prefixed with [historyPrefix].
+	baseDB database.Database
+
+	hasher Hasher
+}
+
+func newHistoryDB(db database.Database, hasher Hasher) *historyDB {
+	return &historyDB{
+		baseDB: db,
+		hasher: hasher,
+	}
+}
+
+// Returns the key of the change with [insertNumber]. Keys are ordered by
+// insertion.
+func historyKey(insertNumber uint64) []byte {
+	key := make([]byte, len(historyPrefix)+wrappers.LongLen)
+	copy(key, historyPrefix)
+	binary.BigEndian.PutUint64(key[len(historyPrefix):], insertNumber)
+	return key
+}
+
+// Put writes the encoded change with [insertNumber] to [batch].
+func (*historyDB) Put(batch database.KeyValueWriter, insertNumber uint64, changesBytes []byte) error {
+	return batch.Put(historyKey(insertNumber), changesBytes)
+}
+
+// Delete writes the removal of the [removed] changes to [batch].
+func (*historyDB) Delete(batch database.KeyValueDeleter, removed []*changeSummaryAndInsertNumber) error {
+	for _, change := range removed {
+		if err := batch.Delete(historyKey(change.insertNumber)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Load replaces the changes in [history] with the persisted changes.
+//
+// Returns false if the persisted changes don't result in [rootID], in which
+// case the persisted changes are removed and [history] is left empty.
+func (db *historyDB) Load(history *trieHistory, rootID ids.ID) (bool, error) {
+	history.reset()
+
+	removed, contiguous, err := db.loadChanges(history)
+	if err != nil {
+		return false, err
+	}
+
+	// If the database was modified without persisting the history, such as by
+	// a previous run of the database with history persistence disabled, the
+	// persisted changes can't be used.
+	newestChange, ok := history.history.PeekRight()
+	if !contiguous || !ok || newestChange.rootID != rootID {
+		history.reset()
+		return false, db.Clear()
+	}
+
+	// The persisted changes may no longer be retained if the retention policy
+	// was changed.
+	batch := db.baseDB.NewBatch()
+	if err := db.Delete(batch, removed); err != nil {
+		return false, err
+	}
+	return true, batch.Write()
+}
+
+// loadChanges records the persisted changes in [history].
+// Returns the changes that were removed from [history] due to its limits and
+// false if the persisted changes aren't contiguous.
+func (db *historyDB) loadChanges(history *trieHistory) ([]*changeSummaryAndInsertNumber, bool, error) {
+	it := db.baseDB.NewIteratorWithPrefix(historyPrefix)
+	defer it.Release()
+
+	var removed []*changeSummaryAndInsertNumber
+	for it.Next() {
+		key := it.Key()
+		if len(key) != len(historyPrefix)+wrappers.LongLen {
+			return nil, false, fmt.Errorf("%w: %d", errInvalidHistoryKeyLen, len(key))
+		}
+		insertNumber := binary.BigEndian.Uint64(key[len(historyPrefix):])
+		if history.history.Len() > 0 && insertNumber != history.nextInsertNumber {
+			// Changes must be contiguous to be able to revert them.
+			return nil, false, nil
+		}
+
+		changesBytes := it.Value()
+		timestamp, changes, err := decodeChangeSummary(db.hasher, changesBytes)
+		if err != nil {
+			return nil, false, err
+		}
+
+		history.nextInsertNumber = insertNumber
+		removed = append(removed, history.recordAt(changes, timestamp, len(changesBytes))...)
+	}
+	return removed, true, it.Error()
+}
+
+// Clear removes all the persisted changes.
+func (db *historyDB) Clear() error {
+	return database.ClearPrefix(db.baseDB, historyPrefix, clearBatchSize)
+}
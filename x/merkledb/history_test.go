@@ -4,13 +4,17 @@
 package merkledb
 
 import (
+	"bytes"
 	"context"
 	"math/rand"
 	"testing"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/maps"
 
+	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/database/memdb"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils/maybe"
@@ -777,3 +781,300 @@ func TestHistoryGetChangesToRoot(t *testing.T) {
 		})
 	}
 }
+
+func TestNewViewAtRoot(t *testing.T) {
+	require := require.New(t)
+
+	db, err := newDB(
+		context.Background(),
+		memdb.New(),
+		newDefaultConfig(),
+	)
+	require.NoError(err)
+
+	// Record the expected state of the trie after each commit.
+	var (
+		rootIDs      []ids.ID
+		expectedKVs  []map[string][]byte
+		currentState = map[string][]byte{}
+	)
+	for i := 0; i < 5; i++ {
+		batch := db.NewBatch()
+		for j := 0; j < 10; j++ {
+			key := []byte{byte(j)}
+			value := []byte{byte(i), byte(j)}
+			if (i+j)%3 == 0 {
+				require.NoError(batch.Delete(key))
+				delete(currentState, string(key))
+				continue
+			}
+			require.NoError(batch.Put(key, value))
+			currentState[string(key)] = value
+		}
+		require.NoError(batch.Write())
+
+		rootID, err := db.GetMerkleRoot(context.Background())
+		require.NoError(err)
+		rootIDs = append(rootIDs, rootID)
+		expectedKVs = append(expectedKVs, maps.Clone(currentState))
+	}
+
+	for i, rootID := range rootIDs {
+		historicalTrie, err := db.NewViewAtRoot(context.Background(), rootID)
+		require.NoError(err)
+
+		gotRootID, err := historicalTrie.GetMerkleRoot(context.Background())
+		require.NoError(err)
+		require.Equal(rootID, gotRootID)
+
+		expected := expectedKVs[i]
+		for j := 0; j < 10; j++ {
+			key := []byte{byte(j)}
+			value, err := historicalTrie.GetValue(context.Background(), key)
+			if expectedValue, ok := expected[string(key)]; ok {
+				require.NoError(err)
+				require.Equal(expectedValue, value)
+			} else {
+				require.ErrorIs(err, database.ErrNotFound)
+			}
+
+			proof, err := historicalTrie.GetProof(context.Background(), key)
+			require.NoError(err)
+			require.NoError(proof.Verify(context.Background(), rootID, db.tokenSize, db.hasher))
+		}
+
+		it := historicalTrie.NewIterator()
+		got := map[string][]byte{}
+		var lastKey []byte
+		for it.Next() {
+			if lastKey != nil {
+				require.Negative(bytes.Compare(lastKey, it.Key()))
+			}
+			lastKey = it.Key()
+			got[string(it.Key())] = it.Value()
+		}
+		require.NoError(it.Error())
+		it.Release()
+		require.Equal(expected, got)
+
+		start := maybe.Some([]byte{2})
+		end := maybe.Some([]byte{7})
+		rangeProof, err := historicalTrie.GetRangeProof(context.Background(), start, end, 3)
+		require.NoError(err)
+		require.NoError(rangeProof.Verify(context.Background(), start, end, rootID, db.tokenSize, db.hasher))
+	}
+
+	// Views can be built on top of the historical trie, but they can't be
+	// committed.
+	historicalTrie, err := db.NewViewAtRoot(context.Background(), rootIDs[0])
+	require.NoError(err)
+	view, err := historicalTrie.NewView(context.Background(), ViewChanges{
+		BatchOps: []database.BatchOp{
+			{Key: []byte{0}, Value: []byte{0}},
+		},
+	})
+	require.NoError(err)
+	value, err := view.GetValue(context.Background(), []byte{0})
+	require.NoError(err)
+	require.Equal([]byte{0}, value)
+	require.ErrorIs(view.CommitToDB(context.Background()), ErrParentNotDatabase)
+
+	// The historical trie itself can't be committed either.
+	currentRootID, err := db.GetMerkleRoot(context.Background())
+	require.NoError(err)
+	for _, rootID := range []ids.ID{rootIDs[0], currentRootID} {
+		historicalTrie, err := db.NewViewAtRoot(context.Background(), rootID)
+		require.NoError(err)
+		historicalView, ok := historicalTrie.(View)
+		require.True(ok)
+		err = historicalView.CommitToDB(context.Background())
+		require.ErrorIs(err, ErrCommitHistoricalView)
+
+		gotRootID, err := db.GetMerkleRoot(context.Background())
+		require.NoError(err)
+		require.Equal(currentRootID, gotRootID)
+	}
+
+	// Committing changes invalidates the historical trie.
+	require.NoError(db.Put([]byte{0}, []byte{0}))
+	_, err = historicalTrie.GetValue(context.Background(), []byte{1})
+	require.ErrorIs(err, ErrInvalid)
+
+	_, err = db.NewViewAtRoot(context.Background(), ids.GenerateTestID())
+	require.ErrorIs(err, ErrInsufficientHistory)
+}
+
+func TestHistoryPrune(t *testing.T) {
+	require := require.New(t)
+
+	th := newTrieHistory(5)
+	th.maxHistoryAge = time.Minute
+	th.maxHistoryBytes = 10
+
+	now := time.Now()
+	th.clock.Set(now)
+
+	changes := make([]*changeSummary, 4)
+	for i := range changes {
+		changes[i] = &changeSummary{
+			rootID: ids.GenerateTestID(),
+		}
+	}
+
+	// Changes older than [maxHistoryAge] are removed.
+	require.Empty(th.recordAt(changes[0], now.Add(-2*time.Minute), 1))
+	removed := th.recordAt(changes[1], now, 1)
+	require.Len(removed, 1)
+	require.Equal(changes[0].rootID, removed[0].rootID)
+	require.NotContains(th.lastChanges, changes[0].rootID)
+
+	// Changes are removed until the history is within [maxHistoryBytes].
+	require.Empty(th.recordAt(changes[2], now, 5))
+	removed = th.recordAt(changes[3], now, 5)
+	require.Len(removed, 1)
+	require.Equal(changes[1].rootID, removed[0].rootID)
+	require.Equal(10, th.totalBytes)
+
+	// The most recent change is always kept.
+	removed = th.prune(0)
+	require.Len(removed, 1)
+	require.Equal(changes[2].rootID, removed[0].rootID)
+	require.Equal(1, th.history.Len())
+	require.Contains(th.lastChanges, changes[3].rootID)
+
+	th.clock.Set(now.Add(time.Hour))
+	require.Empty(th.prune(th.maxHistoryLen))
+}
+
+func TestHistoryPersisted(t *testing.T) {
+	require := require.New(t)
+
+	baseDB := memdb.New()
+	config := newDefaultConfig()
+	config.PersistHistory = true
+
+	db, err := newDB(context.Background(), baseDB, config)
+	require.NoError(err)
+
+	startRootID := db.getMerkleRoot()
+	var rootIDs []ids.ID
+	for i := 0; i < 5; i++ {
+		require.NoError(db.Put([]byte{byte(i)}, []byte{byte(i)}))
+		rootIDs = append(rootIDs, db.getMerkleRoot())
+	}
+	endRootID := rootIDs[len(rootIDs)-1]
+	require.NoError(db.Close())
+
+	// The history is still available after a restart.
+	config.Reg = prometheus.NewRegistry()
+	db, err = newDB(context.Background(), baseDB, config)
+	require.NoError(err)
+	require.Equal(endRootID, db.getMerkleRoot())
+	require.Equal(len(rootIDs)+1, db.history.history.Len())
+
+	changeProof, err := db.GetChangeProof(context.Background(), startRootID, endRootID, maybe.Nothing[[]byte](), maybe.Nothing[[]byte](), 10)
+	require.NoError(err)
+	require.Len(changeProof.KeyChanges, len(rootIDs))
+
+	historicalTrie, err := db.NewViewAtRoot(context.Background(), rootIDs[1])
+	require.NoError(err)
+	value, err := historicalTrie.GetValue(context.Background(), []byte{1})
+	require.NoError(err)
+	require.Equal([]byte{1}, value)
+	_, err = historicalTrie.GetValue(context.Background(), []byte{2})
+	require.ErrorIs(err, database.ErrNotFound)
+
+	// Pruning the history removes the changes from disk.
+	require.NoError(db.PruneHistory(context.Background(), 2))
+	require.NoError(db.Close())
+
+	config.Reg = prometheus.NewRegistry()
+	db, err = newDB(context.Background(), baseDB, config)
+	require.NoError(err)
+	require.Equal(2, db.history.history.Len())
+	_, err = db.NewViewAtRoot(context.Background(), rootIDs[len(rootIDs)-2])
+	require.NoError(err)
+	_, err = db.NewViewAtRoot(context.Background(), rootIDs[0])
+	require.ErrorIs(err, ErrInsufficientHistory)
+
+	// Changes made while the history isn't persisted remove the persisted
+	// history.
+	require.NoError(db.Close())
+	config.Reg = prometheus.NewRegistry()
+	config.PersistHistory = false
+	db, err = newDB(context.Background(), baseDB, config)
+	require.NoError(err)
+	require.NoError(db.Put([]byte{5}, []byte{5}))
+	require.NoError(db.Close())
+
+	config.Reg = prometheus.NewRegistry()
+	config.PersistHistory = true
+	db, err = newDB(context.Background(), baseDB, config)
+	require.NoError(err)
+	require.Equal(1, db.history.history.Len())
+	_, err = db.NewViewAtRoot(context.Background(), endRootID)
+	require.ErrorIs(err, ErrInsufficientHistory)
+}
+
+func TestHistoryPersistedUncleanShutdown(t *testing.T) {
+	require := require.New(t)
+
+	baseDB := memdb.New()
+	config := newDefaultConfig()
+	config.PersistHistory = true
+
+	db, err := newDB(context.Background(), baseDB, config)
+	require.NoError(err)
+
+	startRootID := db.getMerkleRoot()
+	for i := 0; i < 5; i++ {
+		require.NoError(db.Put([]byte{byte(i)}, []byte{byte(i)}))
+	}
+	endRootID := db.getMerkleRoot()
+
+	// Reopen the database without closing it, which causes the trie to be
+	// rebuilt.
+	config.Reg = prometheus.NewRegistry()
+	db, err = newDB(context.Background(), baseDB, config)
+	require.NoError(err)
+	require.Equal(endRootID, db.getMerkleRoot())
+
+	_, err = db.GetChangeProof(context.Background(), startRootID, endRootID, maybe.Nothing[[]byte](), maybe.Nothing[[]byte](), 10)
+	require.NoError(err)
+}
+
+func TestHistoryPrunedPeriodically(t *testing.T) {
+	require := require.New(t)
+
+	config := newDefaultConfig()
+	config.PersistHistory = true
+	config.HistoryMaxAge = 50 * time.Millisecond
+	config.HistoryPruneFrequency = 10 * time.Millisecond
+
+	baseDB := memdb.New()
+	db, err := newDB(context.Background(), baseDB, config)
+	require.NoError(err)
+
+	require.NoError(db.Put([]byte{0}, []byte{0}))
+	require.NoError(db.Put([]byte{1}, []byte{1}))
+
+	// Without any new commits, the changes older than [HistoryMaxAge] are
+	// removed from memory and from disk. The most recent change is kept.
+	require.Eventually(func() bool {
+		db.commitLock.RLock()
+		defer db.commitLock.RUnlock()
+
+		return db.history.history.Len() == 1
+	}, time.Second, 10*time.Millisecond)
+
+	numPersistedChanges := 0
+	it := baseDB.NewIteratorWithPrefix(historyPrefix)
+	for it.Next() {
+		numPersistedChanges++
+	}
+	it.Release()
+	require.NoError(it.Error())
+	require.Equal(1, numPersistedChanges)
+
+	require.NoError(db.Close())
+}
@@ -86,12 +86,16 @@ type metrics interface {
 	ViewChangesValueMiss()
 	ViewChangesNodeHit()
 	ViewChangesNodeMiss()
+	HistoryChanged(length, bytes, numPruned int)
 }
 
 type prometheusMetrics struct {
-	hashes prometheus.Counter
-	io     *prometheus.CounterVec
-	lookup *prometheus.CounterVec
+	hashes        prometheus.Counter
+	io            *prometheus.CounterVec
+	lookup        *prometheus.CounterVec
+	historyLength prometheus.Gauge
+	historyBytes  prometheus.Gauge
+	historyPruned prometheus.Counter
 }
 
 func newMetrics(namespace string, reg prometheus.Registerer) (metrics, error) {
@@ -115,11 +119,29 @@ func newMetrics(namespace string, reg prometheus.Registerer) (metrics, error) {
 			Name:      "lookup",
 			Help:      "cumulative number of in-memory lookups performed",
 		}, lookupLabels),
+		historyLength: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "history_length",
+			Help:      "number of changes in the history",
+		}),
+		historyBytes: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "history_bytes",
+			Help:      "encoded size of the changes in the history, if the history is persisted or has a size limit",
+		}),
+		historyPruned: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "history_pruned",
+			Help:      "cumulative number of changes removed from the history",
+		}),
 	}
 	err := utils.Err(
 		reg.Register(m.hashes),
 		reg.Register(m.io),
 		reg.Register(m.lookup),
+		reg.Register(m.historyLength),
+		reg.Register(m.historyBytes),
+		reg.Register(m.historyPruned),
 	)
 	return &m, err
 }
@@ -168,6 +190,12 @@ func (m *prometheusMetrics) ViewChangesNodeMiss() {
 	m.lookup.With(viewChangesNodeMissLabels).Inc()
 }
 
+func (m *prometheusMetrics) HistoryChanged(length, bytes, numPruned int) {
+	m.historyLength.Set(float64(length))
+	m.historyBytes.Set(float64(bytes))
+	m.historyPruned.Add(float64(numPruned))
+}
+
 type mockMetrics struct {
 	lock                      sync.Mutex
 	hashCount                 int64
@@ -181,6 +209,9 @@ type mockMetrics struct {
 	viewChangesValueMiss      int64
 	viewChangesNodeHit        int64
 	viewChangesNodeMiss       int64
+	historyLength             int64
+	historyBytes              int64
+	historyPruned             int64
 }
 
 func (m *mockMetrics) HashCalculated() {
@@ -259,3 +290,12 @@ func (m *mockMetrics) ViewChangesNodeMiss() {
 
 	m.viewChangesNodeMiss++
 }
+
+func (m *mockMetrics) HistoryChanged(length, bytes, numPruned int) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.historyLength = int64(length)
+	m.historyBytes = int64(bytes)
+	m.historyPruned += int64(numPruned)
+}
@@ -3,7 +3,7 @@
 //
 // Generated by this command:
 //
-//	mockgen -source=x/merkledb/db.go -destination=x/merkledb/mock_db.go -package=merkledb -exclude_interfaces=ChangeProofer,RangeProofer,Clearer,Prefetcher
+//	mockgen -source=x/merkledb/db.go -destination=x/merkledb/mock_db.go -package=merkledb -exclude_interfaces=ChangeProofer,RangeProofer,Clearer,Prefetcher,HistoricalViewer,HistoryPruner
 //
 
 // Package merkledb is a generated GoMock package.
@@ -361,6 +361,21 @@ func (mr *MockMerkleDBMockRecorder) NewView(ctx, changes any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewView", reflect.TypeOf((*MockMerkleDB)(nil).NewView), ctx, changes)
 }
 
+// NewViewAtRoot mocks base method.
+func (m *MockMerkleDB) NewViewAtRoot(ctx context.Context, rootID ids.ID) (Trie, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewViewAtRoot", ctx, rootID)
+	ret0, _ := ret[0].(Trie)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewViewAtRoot indicates an expected call of NewViewAtRoot.
+func (mr *MockMerkleDBMockRecorder) NewViewAtRoot(ctx, rootID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewViewAtRoot", reflect.TypeOf((*MockMerkleDB)(nil).NewViewAtRoot), ctx, rootID)
+}
+
 // PrefetchPath mocks base method.
 func (m *MockMerkleDB) PrefetchPath(key []byte) error {
 	m.ctrl.T.Helper()
@@ -36,6 +36,7 @@ var (
 	ErrStartAfterEnd          = errors.New("start key > end key")
 	ErrNoChanges              = errors.New("no changes provided")
 	ErrParentNotDatabase      = errors.New("parent trie is not database")
+	ErrCommitHistoricalView   = errors.New("cannot commit a view of a historical root")
 	ErrNodesAlreadyCalculated = errors.New("cannot modify the trie after the node changes have been calculated")
 )
 
@@ -99,6 +100,10 @@ type view struct {
 	root maybe.Maybe[*node]
 
 	tokenSize int
+
+	// If true, this view is of a historical root of the db and can't be
+	// committed.
+	historical bool
 }
 
 // NewView returns a new view on top of this view where the passed changes
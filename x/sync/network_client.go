@@ -16,6 +16,7 @@ import (
 
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/network/p2p"
+	"github.com/ava-labs/avalanchego/network/reputation"
 	"github.com/ava-labs/avalanchego/snow/engine/common"
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/utils/set"
@@ -103,6 +104,7 @@ func NewNetworkClient(
 		registerer,
 		set.Of(myNodeID),
 		minVersion,
+		reputation.NewNoTracker(),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create peer tracker: %w", err)